@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"crypto/rsa"
 	"fmt"
 	"net/http"
 	"os"
@@ -20,6 +21,10 @@ import (
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/application"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/config"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/outbox"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/payment"
+	outboxinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/outbox"
+	paymentinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/payment"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/postgres"
 	redisinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/redis"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/logger"
@@ -40,9 +45,10 @@ import (
 
 // @BasePath /api/v1
 
-// @securityDefinitions.apikey ApiKeyAuth
+// @securityDefinitions.apikey BearerAuth
 // @in header
-// @name X-User-ID
+// @name Authorization
+// @description "Bearer <JWT>" 形式。ローカル開発で AUTH_ALLOW_USER_ID_HEADER=true の場合は X-User-ID ヘッダーも使用可能
 
 func main() {
 	cfg := config.Load()
@@ -81,9 +87,13 @@ func main() {
 	}
 	var lockManager *redisinfra.LockManager
 	var seatCache *redisinfra.SeatCache
+	var waitingRoom *redisinfra.WaitingRoom
+	var rateLimiter *redisinfra.RateLimiter
 	if redisClient != nil {
 		lockManager = redisinfra.NewLockManager(redisClient)
 		seatCache = redisinfra.NewSeatCache(redisClient)
+		waitingRoom = redisinfra.NewWaitingRoom(redisClient)
+		rateLimiter = redisinfra.NewRateLimiter(redisClient)
 		defer redisClient.Close()
 		logger.Info("Redis接続成功")
 	}
@@ -92,19 +102,95 @@ func main() {
 	eventRepo := postgres.NewEventRepository(db)
 	seatRepo := postgres.NewSeatRepository(db)
 	reservationRepo := postgres.NewReservationRepository(db)
+	priceCategoryRepo := postgres.NewPriceCategoryRepository(db)
+	waitlistRepo := postgres.NewWaitlistRepository(db)
+	paymentRepo := postgres.NewPaymentRepository(db)
+	outboxRepo := postgres.NewOutboxRepository(db)
+	cancellationRepo := postgres.NewEventCancellationRepository(db)
+	auditRepo := postgres.NewAuditRepository(db)
 
 	// Transaction Manager
 	txManager := postgres.NewTxManager(db)
 
+	// Payment Gateway
+	var paymentGateway payment.Gateway
+	switch cfg.Payment.Provider {
+	case paymentinfra.FakeProviderName:
+		paymentGateway = paymentinfra.NewFakeProvider(cfg.Payment.WebhookSecret, cfg.Payment.Timeout)
+		logger.Info("フェイク決済プロバイダーを使用")
+	case "none":
+		logger.Warn("決済が無効です（予約は決済なしで確定されます）")
+	default:
+		logger.Fatal("未対応の決済プロバイダー", zap.String("provider", cfg.Payment.Provider))
+	}
+	// 署名を検証できない Webhook を受け付けると決済状態を外部から書き換えられる
+	if paymentGateway != nil && cfg.Payment.WebhookSecret == "" {
+		if paymentGateway.Name() != paymentinfra.FakeProviderName {
+			logger.Fatal("PAYMENT_WEBHOOK_SECRET が設定されていません", zap.String("provider", paymentGateway.Name()))
+		}
+		logger.Warn("PAYMENT_WEBHOOK_SECRET が未設定です（フェイク決済プロバイダーのみ許可）")
+	}
+
+	// Outbox Sink
+	var outboxSink outbox.Sink
+	switch cfg.Outbox.Sink {
+	case outboxinfra.StdoutSinkName:
+		outboxSink = outboxinfra.NewStdoutSink(os.Stdout)
+	case outboxinfra.WebhookSinkName:
+		if cfg.Outbox.WebhookURL == "" {
+			logger.Fatal("OUTBOX_WEBHOOK_URL が設定されていません")
+		}
+		outboxSink = outboxinfra.NewWebhookSink(cfg.Outbox.WebhookURL, cfg.Outbox.WebhookSecret, cfg.Outbox.WebhookTimeout)
+	case outboxinfra.RedisStreamSinkName:
+		if redisClient == nil {
+			logger.Fatal("Redisに接続できないためドメインイベントを配信できません")
+		}
+		outboxSink = outboxinfra.NewRedisStreamSink(redisClient, cfg.Outbox.RedisStream)
+	case "none":
+		logger.Warn("ドメインイベントの配信が無効です")
+	default:
+		logger.Fatal("未対応のドメインイベント配信先", zap.String("sink", cfg.Outbox.Sink))
+	}
+	if outboxSink != nil {
+		logger.Info("ドメインイベントの配信先", zap.String("sink", outboxSink.Name()))
+	}
+
 	// Services
-	eventService := application.NewEventService(eventRepo)
-	seatService := application.NewSeatService(seatRepo, eventRepo, seatCache)
-	reservationService := application.NewReservationService(txManager, reservationRepo, seatRepo, eventRepo, lockManager, seatCache)
+	eventService := application.NewEventService(eventRepo, seatRepo, reservationRepo)
+	seatService := application.NewSeatService(seatRepo, eventRepo, priceCategoryRepo, seatCache)
+	reservationOpts := []application.ReservationOption{
+		application.WithPriceCategoryRepository(priceCategoryRepo),
+		application.WithWaitlistRepository(waitlistRepo),
+	}
+	if waitingRoom != nil {
+		reservationOpts = append(reservationOpts, application.WithWaitingRoom(waitingRoom))
+	}
+	if paymentGateway != nil {
+		reservationOpts = append(reservationOpts, application.WithPayment(paymentGateway, paymentRepo))
+	}
+	if outboxSink != nil {
+		reservationOpts = append(reservationOpts, application.WithOutbox(outboxRepo))
+	}
+	reservationService := application.NewReservationService(txManager, reservationRepo, seatRepo, eventRepo, lockManager, seatCache,
+		reservationOpts...)
+	var cancellationOpts []application.EventCancellationOption
+	if outboxSink != nil {
+		cancellationOpts = append(cancellationOpts, application.WithCancellationOutbox(outboxRepo))
+	}
+	cancellationService := application.NewEventCancellationService(txManager, eventRepo, cancellationRepo, reservationRepo, seatRepo, seatCache,
+		cfg.EventCancellation.BatchSize, cancellationOpts...)
+	priceCategoryService := application.NewPriceCategoryService(priceCategoryRepo, eventRepo)
+	waitlistService := application.NewWaitlistService(waitlistRepo, eventRepo, seatRepo)
+	auditService := application.NewAuditService(auditRepo)
 
 	// Handlers
 	eventHandler := handler.NewEventHandler(eventService)
+	cancellationHandler := handler.NewEventCancellationHandler(cancellationService)
 	seatHandler := handler.NewSeatHandler(seatService)
 	reservationHandler := handler.NewReservationHandler(reservationService)
+	priceCategoryHandler := handler.NewPriceCategoryHandler(priceCategoryService)
+	waitlistHandler := handler.NewWaitlistHandler(waitlistService)
+	auditHandler := handler.NewAuditHandler(auditService)
 	healthHandler := handler.NewHealthHandler()
 
 	// Prometheusメトリクス初期化
@@ -113,6 +199,12 @@ func main() {
 	e := echo.New()
 	e.Validator = api.NewValidator()
 	e.HTTPErrorHandler = api.CustomHTTPErrorHandler
+	// IPごとのレート制限をクライアントが X-Forwarded-For で回避できないよう、信頼するプロキシを経由した場合だけヘッダーを使う
+	ipExtractor, err := middleware.NewIPExtractor(cfg.Server.TrustedProxies)
+	if err != nil {
+		logger.Fatal("信頼するプロキシの設定エラー", zap.Error(err))
+	}
+	e.IPExtractor = ipExtractor
 	middleware.SetupMiddleware(e)
 
 	// Prometheusミドルウェア追加
@@ -127,15 +219,58 @@ func main() {
 	// Swagger UI
 	e.GET("/swagger/*", echoSwagger.WrapHandler)
 
-	api := e.Group("/api/v1")
+	// 認証（Bearerトークンの subject をユーザーIDとして使用）
+	authConfig, err := newAuthConfig(&cfg.Auth)
+	if err != nil {
+		logger.Fatal("認証設定エラー", zap.Error(err))
+	}
+	if authConfig.AllowUserIDHeader {
+		logger.Warn("X-User-ID ヘッダーによる認証が有効です（ローカル開発用）")
+		if authConfig.AllowUserRolesHeader {
+			logger.Warn("X-User-Roles ヘッダーによる役割の指定が有効です（ローカル開発用。誰でも管理者を名乗れるため本番では無効にしてください）")
+		}
+	} else if cfg.Auth.AllowUserRolesHeader {
+		logger.Warn("AUTH_ALLOW_USER_ROLES_HEADER は AUTH_ALLOW_USER_ID_HEADER が無効なため無視されます")
+	}
+
+	api := e.Group("/api/v1", middleware.JWTAuth(authConfig))
+
+	// レート制限（Redis必須。ユーザーID・IPごとに複数インスタンスで上限を共有）
+	var reservationLimit []echo.MiddlewareFunc
+	switch {
+	case !cfg.RateLimit.Enabled:
+		logger.Warn("レート制限が無効です")
+	case rateLimiter == nil:
+		logger.Warn("Redisに接続できないためレート制限が無効です")
+	default:
+		api.Use(middleware.RateLimit(rateLimiter, middleware.RateLimitRule{
+			Name: "api", Limit: cfg.RateLimit.Requests, Window: cfg.RateLimit.Window,
+		}, appMetrics))
+		reservationLimit = append(reservationLimit, middleware.RateLimit(rateLimiter, middleware.RateLimitRule{
+			Name: "reservation", Limit: cfg.RateLimit.ReservationRequests, Window: cfg.RateLimit.ReservationWindow,
+		}, appMetrics))
+	}
+
 	api.GET("/health", healthHandler.Check)
 
 	// Events
+	// strict モードでは If-Match のない更新（PUT / PATCH）を 428 で拒否する（取得した ETag を送らない上書きを防ぐ）
+	var ifMatch []echo.MiddlewareFunc
+	if cfg.Server.RequireIfMatch {
+		ifMatch = append(ifMatch, middleware.RequireIfMatch())
+	}
 	api.POST("/events", eventHandler.Create)
 	api.GET("/events", eventHandler.List)
+	api.GET("/events/search", eventHandler.Search)
 	api.GET("/events/:id", eventHandler.GetByID)
-	api.PUT("/events/:id", eventHandler.Update)
+	api.PUT("/events/:id", eventHandler.Update, ifMatch...)
+	api.PATCH("/events/:id", eventHandler.Patch, ifMatch...)
 	api.DELETE("/events/:id", eventHandler.Delete)
+	api.POST("/events/:id/publish", eventHandler.Publish)
+	api.POST("/events/:id/open", eventHandler.Open)
+	api.POST("/events/:id/close", eventHandler.Close)
+	api.POST("/events/:id/cancel", cancellationHandler.Cancel)
+	api.GET("/events/:id/cancellation", cancellationHandler.GetProgress)
 
 	// Seats
 	api.GET("/events/:event_id/seats", seatHandler.GetByEvent)
@@ -144,22 +279,84 @@ func main() {
 	api.GET("/events/:event_id/seats/available/count", seatHandler.CountAvailable)
 	api.GET("/seats/:id", seatHandler.GetByID)
 
+	// Price Categories
+	api.GET("/events/:event_id/price-categories", priceCategoryHandler.List)
+	api.POST("/events/:event_id/price-categories", priceCategoryHandler.Create)
+	api.GET("/events/:event_id/price-categories/:id", priceCategoryHandler.GetByID)
+	api.PUT("/events/:event_id/price-categories/:id", priceCategoryHandler.Update)
+	api.DELETE("/events/:event_id/price-categories/:id", priceCategoryHandler.Delete)
+
+	// Waitlist
+	api.POST("/events/:event_id/waitlist", waitlistHandler.Join)
+	api.GET("/events/:event_id/waitlist/:id", waitlistHandler.GetByID)
+	api.DELETE("/events/:event_id/waitlist/:id", waitlistHandler.Leave)
+
+	// Waiting Room（Redis必須）
+	var queueService *application.QueueService
+	if waitingRoom != nil {
+		// 複数インスタンスで動かしても入場許可のペースが AdmitBatchSize 人 / AdmitInterval を超えないようにする
+		queueService = application.NewQueueService(waitingRoom, eventRepo, cfg.WaitingRoom.AdmitBatchSize, cfg.WaitingRoom.AdmissionTTL,
+			application.WithAdmitLock(lockManager, cfg.WaitingRoom.AdmitInterval))
+		queueHandler := handler.NewQueueHandler(queueService)
+		api.POST("/events/:event_id/queue", queueHandler.Join)
+		api.GET("/events/:event_id/queue/:token", queueHandler.GetByToken)
+	}
+
+	// Payments
+	if paymentGateway != nil {
+		paymentHandler := handler.NewPaymentHandler(application.NewPaymentService(paymentGateway, paymentRepo, reservationRepo))
+		api.GET("/reservations/:id/payment", paymentHandler.GetByReservation)
+		api.POST("/payments/webhook", paymentHandler.Webhook)
+	}
+
 	// Reservations
-	api.POST("/reservations", reservationHandler.Create)
+	api.POST("/reservations", reservationHandler.Create, reservationLimit...)
+	api.POST("/reservations/best-available", reservationHandler.BestAvailable, reservationLimit...)
 	api.GET("/reservations", reservationHandler.GetUserReservations)
+	api.GET("/events/:event_id/reservations", reservationHandler.GetEventReservations)
 	api.GET("/reservations/:id", reservationHandler.GetByID)
 	api.POST("/reservations/:id/confirm", reservationHandler.Confirm)
+	api.POST("/reservations/:id/extend", reservationHandler.Extend)
 	api.POST("/reservations/:id/cancel", reservationHandler.Cancel)
+	api.POST("/reservations/:id/refund", reservationHandler.Refund)
+	api.POST("/reservations/:id/seats/remove", reservationHandler.RemoveSeats)
+
+	// Audit
+	api.GET("/audit", auditHandler.List)
 
 	// 期限切れ予約クリーナーを開始
 	ctx, cancel := context.WithCancel(context.Background())
 	cleaner := worker.NewExpiredReservationCleaner(
 		reservationService,
-		1*time.Minute,  // 1分ごとにチェック
-		15*time.Minute, // 15分以上経過した予約をキャンセル
+		1*time.Minute, // 1分ごとにチェック
+		0,             // 有効期限（expires_at）を過ぎた予約をキャンセル
 	)
 	go cleaner.Start(ctx)
 
+	// 待合室アドミッターを開始
+	var admitter *worker.WaitingRoomAdmitter
+	if queueService != nil {
+		admitter = worker.NewWaitingRoomAdmitter(queueService, cfg.WaitingRoom.AdmitInterval)
+		go admitter.Start(ctx)
+	}
+
+	// アウトボックスリレーを開始
+	var relay *worker.OutboxRelay
+	if outboxSink != nil {
+		// 複数インスタンスで同時に配信しないよう、Redisがあればロックで排他する
+		var relayLock redisinfra.LockManagerInterface
+		if lockManager != nil {
+			relayLock = lockManager
+		}
+		outboxService := application.NewOutboxService(outboxRepo, outboxSink, relayLock, cfg.Outbox.BatchSize)
+		relay = worker.NewOutboxRelay(outboxService, cfg.Outbox.RelayInterval)
+		go relay.Start(ctx)
+	}
+
+	// イベント中止ワーカーを開始（停止前に中断した中止処理も再開する）
+	cancellationProcessor := worker.NewEventCancellationProcessor(cancellationService, cfg.EventCancellation.Interval)
+	go cancellationProcessor.Start(ctx)
+
 	go func() {
 		addr := fmt.Sprintf(":%s", cfg.Server.Port)
 		logger.Info("サーバー起動", zap.String("addr", addr))
@@ -177,6 +374,13 @@ func main() {
 	// ワーカーを停止
 	cancel()
 	cleaner.Stop()
+	if admitter != nil {
+		admitter.Stop()
+	}
+	if relay != nil {
+		relay.Stop()
+	}
+	cancellationProcessor.Stop()
 	logger.Info("バックグラウンドワーカー停止完了")
 
 	// サーバーをシャットダウン
@@ -188,3 +392,35 @@ func main() {
 	}
 	logger.Info("サーバーが正常にシャットダウンしました")
 }
+
+// newAuthConfig は設定ファイルの鍵を読み込んでJWT認証の設定を作成する
+func newAuthConfig(cfg *config.AuthConfig) (middleware.AuthConfig, error) {
+	authConfig := middleware.AuthConfig{
+		HMACSecret:           []byte(cfg.JWTSecret),
+		RSAPublicKeys:        make(map[string]*rsa.PublicKey),
+		Issuer:               cfg.JWTIssuer,
+		Audience:             cfg.JWTAudience,
+		AllowUserIDHeader:    cfg.AllowUserIDHeader,
+		AllowUserRolesHeader: cfg.AllowUserIDHeader && cfg.AllowUserRolesHeader,
+	}
+	if cfg.JWTPublicKeyFile != "" {
+		key, err := middleware.LoadRSAPublicKeyFile(cfg.JWTPublicKeyFile)
+		if err != nil {
+			return authConfig, fmt.Errorf("公開鍵の読み込みに失敗: %w", err)
+		}
+		authConfig.RSAPublicKeys[""] = key
+	}
+	if cfg.JWKSFile != "" {
+		keys, err := middleware.LoadJWKSFile(cfg.JWKSFile)
+		if err != nil {
+			return authConfig, fmt.Errorf("JWKSの読み込みに失敗: %w", err)
+		}
+		for kid, key := range keys {
+			authConfig.RSAPublicKeys[kid] = key
+		}
+	}
+	if len(authConfig.HMACSecret) == 0 && len(authConfig.RSAPublicKeys) == 0 && !authConfig.AllowUserIDHeader {
+		return authConfig, fmt.Errorf("検証用の鍵が設定されていません（AUTH_JWT_SECRET / AUTH_JWT_PUBLIC_KEY_FILE / AUTH_JWKS_FILE）")
+	}
+	return authConfig, nil
+}
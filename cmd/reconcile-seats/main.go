@@ -0,0 +1,70 @@
+// reconcile-seats はイベントの総座席数（total_seats）と作成済みの座席数のずれを報告し、
+// -fix 指定時は総座席数を作成済みの座席数に合わせる
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"go.uber.org/zap"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/application"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/config"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/audit"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/postgres"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/logger"
+)
+
+// auditActor は修正を監査ログに記録する際の実行者
+const auditActor = "reconcile-seats"
+
+func main() {
+	fix := flag.Bool("fix", false, "総座席数を作成済みの座席数に合わせる（座席が未作成のイベントは対象外）")
+	flag.Parse()
+
+	cfg := config.Load()
+
+	env := os.Getenv("APP_ENV")
+	if env == "" {
+		env = "development"
+	}
+	log := logger.NewLogger(env)
+	logger.Set(log)
+	defer logger.Sync()
+
+	db, err := postgres.NewConnection(&cfg.Database)
+	if err != nil {
+		logger.Fatal("DB接続エラー", zap.Error(err))
+	}
+	defer db.Close()
+
+	seatService := application.NewSeatService(postgres.NewSeatRepository(db), postgres.NewEventRepository(db), nil, nil)
+
+	ctx := audit.WithActor(context.Background(), auditActor)
+	results, err := seatService.ReconcileTotalSeats(ctx, *fix)
+	if err != nil {
+		logger.Fatal("座席数の突き合わせに失敗", zap.Error(err))
+	}
+
+	if len(results) == 0 {
+		fmt.Println("総座席数と作成済みの座席数のずれはありません")
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "EVENT_ID\tNAME\tTOTAL_SEATS\tSEATS\tEXCESS\tFIXED")
+	fixed := 0
+	for _, r := range results {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%+d\t%t\n", r.EventID, r.EventName, r.TotalSeats, r.SeatCount, r.Excess(), r.Fixed)
+		if r.Fixed {
+			fixed++
+		}
+	}
+	w.Flush()
+	fmt.Printf("\nずれのあるイベント: %d件 / 修正: %d件\n", len(results), fixed)
+	if !*fix {
+		fmt.Println("-fix を指定すると総座席数を作成済みの座席数に合わせます")
+	}
+}
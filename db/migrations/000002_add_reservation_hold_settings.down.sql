@@ -0,0 +1,7 @@
+ALTER TABLE reservations
+    DROP COLUMN IF EXISTS extension_count;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS max_hold_extensions,
+    DROP COLUMN IF EXISTS max_hold_duration_seconds,
+    DROP COLUMN IF EXISTS hold_duration_seconds;
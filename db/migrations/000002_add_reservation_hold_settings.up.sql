@@ -0,0 +1,9 @@
+-- イベントごとの仮押さえ設定
+ALTER TABLE events
+    ADD COLUMN hold_duration_seconds INTEGER NOT NULL DEFAULT 900,
+    ADD COLUMN max_hold_duration_seconds INTEGER NOT NULL DEFAULT 1800,
+    ADD COLUMN max_hold_extensions INTEGER NOT NULL DEFAULT 2;
+
+-- 予約ごとの仮押さえ延長回数
+ALTER TABLE reservations
+    ADD COLUMN extension_count INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_seats_price_category;
+
+ALTER TABLE seats
+    DROP COLUMN IF EXISTS price_category_id;
+
+DROP TABLE IF EXISTS price_categories;
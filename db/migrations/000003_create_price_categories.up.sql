@@ -0,0 +1,17 @@
+-- price_categories テーブル（イベントごとの価格カテゴリ）
+CREATE TABLE price_categories (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    currency CHAR(3) NOT NULL DEFAULT 'JPY',
+    amount INTEGER NOT NULL CHECK (amount >= 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(event_id, name)
+);
+
+-- 座席から価格カテゴリへの参照（割り当て済みカテゴリは削除不可）
+ALTER TABLE seats
+    ADD COLUMN price_category_id UUID REFERENCES price_categories(id) ON DELETE RESTRICT;
+
+CREATE INDEX idx_seats_price_category ON seats(price_category_id);
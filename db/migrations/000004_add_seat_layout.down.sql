@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_seats_layout;
+
+ALTER TABLE seats
+    DROP COLUMN IF EXISTS obstructed_view,
+    DROP COLUMN IF EXISTS accessible,
+    DROP COLUMN IF EXISTS pos_y,
+    DROP COLUMN IF EXISTS pos_x,
+    DROP COLUMN IF EXISTS seat_index,
+    DROP COLUMN IF EXISTS row_label,
+    DROP COLUMN IF EXISTS section;
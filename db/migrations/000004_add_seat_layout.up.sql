@@ -0,0 +1,11 @@
+-- 会場レイアウト（セクション・列・座席番号・座標・属性）
+ALTER TABLE seats
+    ADD COLUMN section VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN row_label VARCHAR(20) NOT NULL DEFAULT '',
+    ADD COLUMN seat_index INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN pos_x DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN pos_y DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN accessible BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN obstructed_view BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX idx_seats_layout ON seats(event_id, section, row_label, seat_index);
@@ -0,0 +1,18 @@
+-- waitlist_entries テーブル（売り切れイベントの順番待ち）
+CREATE TABLE waitlist_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'waiting',
+    reservation_id UUID REFERENCES reservations(id) ON DELETE SET NULL,
+    offered_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 同一ユーザーは1イベントにつき1件まで待機・オファー中にできる
+CREATE UNIQUE INDEX idx_waitlist_active_user ON waitlist_entries(event_id, user_id)
+    WHERE status IN ('waiting', 'offered');
+CREATE INDEX idx_waitlist_event_waiting ON waitlist_entries(event_id, created_at) WHERE status = 'waiting';
+CREATE INDEX idx_waitlist_reservation ON waitlist_entries(reservation_id);
@@ -0,0 +1,3 @@
+-- イベントごとの待合室（仮想キュー）設定
+ALTER TABLE events
+    ADD COLUMN waiting_room_enabled BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,18 @@
+-- payments テーブル（予約の決済。確定の試行ごとに1行）
+CREATE TABLE payments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reservation_id UUID NOT NULL REFERENCES reservations(id) ON DELETE CASCADE,
+    provider VARCHAR(50) NOT NULL,
+    provider_payment_id VARCHAR(255),
+    amount INTEGER NOT NULL CHECK (amount >= 0),
+    currency VARCHAR(3) NOT NULL DEFAULT 'JPY',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    failure_reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_payments_reservation ON payments(reservation_id, created_at);
+-- Webhook のプロバイダー決済IDから引くため（オーソリ失敗時は NULL）
+CREATE UNIQUE INDEX idx_payments_provider_payment ON payments(provider, provider_payment_id)
+    WHERE provider_payment_id IS NOT NULL;
@@ -0,0 +1,11 @@
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS refunded_amount;
+
+ALTER TABLE reservations
+    DROP COLUMN IF EXISTS refunded_at,
+    DROP COLUMN IF EXISTS refunded_amount;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS refund_cutoff_seconds,
+    DROP COLUMN IF EXISTS partial_refund_percent,
+    DROP COLUMN IF EXISTS full_refund_before_seconds;
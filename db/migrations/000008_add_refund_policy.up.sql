@@ -0,0 +1,14 @@
+-- イベントごとの返金ポリシー（開始の何秒前まで全額・部分返金するか）
+ALTER TABLE events
+    ADD COLUMN full_refund_before_seconds INTEGER NOT NULL DEFAULT 604800,
+    ADD COLUMN partial_refund_percent INTEGER NOT NULL DEFAULT 50 CHECK (partial_refund_percent BETWEEN 0 AND 100),
+    ADD COLUMN refund_cutoff_seconds INTEGER NOT NULL DEFAULT 86400;
+
+-- 予約の返金額
+ALTER TABLE reservations
+    ADD COLUMN refunded_amount INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN refunded_at TIMESTAMP;
+
+-- 決済の返金済み金額（一部返金に対応）
+ALTER TABLE payments
+    ADD COLUMN refunded_amount INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,17 @@
+-- outbox_messages テーブル（トランザクショナルアウトボックス）
+-- 業務データと同じトランザクションで記録し、リレーワーカーが id 順に配信する
+CREATE TABLE outbox_messages (
+    id BIGSERIAL PRIMARY KEY,
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMP
+);
+
+-- 未配信メッセージの取得用
+CREATE INDEX idx_outbox_messages_unpublished ON outbox_messages(id) WHERE published_at IS NULL;
+CREATE INDEX idx_outbox_messages_aggregate ON outbox_messages(aggregate_type, aggregate_id, id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_reservations_event;
+DROP INDEX IF EXISTS idx_events_organizer_id;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS organizer_id;
@@ -0,0 +1,8 @@
+-- イベントを作成した主催者（既存のイベントは NULL のまま管理者だけが管理できる）
+ALTER TABLE events
+    ADD COLUMN organizer_id VARCHAR(255);
+
+CREATE INDEX idx_events_organizer_id ON events(organizer_id);
+
+-- 主催者向けのイベント別予約一覧
+CREATE INDEX idx_reservations_event ON reservations(event_id, created_at DESC);
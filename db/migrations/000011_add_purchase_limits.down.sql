@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_reservations_event_user;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS max_seats_per_user,
+    DROP COLUMN IF EXISTS max_seats_per_reservation;
@@ -0,0 +1,7 @@
+-- イベントごとの購入枚数の上限（0 は無制限）
+ALTER TABLE events
+    ADD COLUMN max_seats_per_reservation INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN max_seats_per_user INTEGER NOT NULL DEFAULT 0;
+
+-- ユーザーごとの保持座席数の集計用
+CREATE INDEX idx_reservations_event_user ON reservations(event_id, user_id) WHERE status IN ('pending', 'confirmed');
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_events_venue;
+DROP INDEX IF EXISTS idx_events_start_at;
+DROP INDEX IF EXISTS idx_reservations_user_created_id;
+DROP INDEX IF EXISTS idx_events_created_id;
@@ -0,0 +1,7 @@
+-- 一覧のキーセットページネーション（created_at, id の降順）
+CREATE INDEX idx_events_created_id ON events(created_at DESC, id DESC);
+CREATE INDEX idx_reservations_user_created_id ON reservations(user_id, created_at DESC, id DESC);
+
+-- イベント一覧の絞り込み
+CREATE INDEX idx_events_start_at ON events(start_at);
+CREATE INDEX idx_events_venue ON events(venue);
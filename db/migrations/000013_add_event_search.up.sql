@@ -0,0 +1,18 @@
+-- イベントの全文検索
+-- 日本語は空白で区切られないため tsvector では語に分割できない。部分一致・あいまい検索用に pg_trgm のインデックスを併用する
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- 検索対象のテキスト（名前・会場・説明）
+ALTER TABLE events ADD COLUMN search_text TEXT GENERATED ALWAYS AS (
+    name || ' ' || COALESCE(venue, '') || ' ' || COALESCE(description, '')
+) STORED;
+
+-- 名前 > 会場 > 説明 の順に重み付けした tsvector
+ALTER TABLE events ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', name), 'A') ||
+    setweight(to_tsvector('simple', COALESCE(venue, '')), 'B') ||
+    setweight(to_tsvector('simple', COALESCE(description, '')), 'C')
+) STORED;
+
+CREATE INDEX idx_events_search_vector ON events USING GIN (search_vector);
+CREATE INDEX idx_events_search_text_trgm ON events USING GIN (search_text gin_trgm_ops);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_events_status;
+ALTER TABLE events
+    DROP COLUMN IF EXISTS sales_end_at,
+    DROP COLUMN IF EXISTS sales_start_at,
+    DROP COLUMN IF EXISTS status;
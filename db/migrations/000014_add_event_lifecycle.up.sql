@@ -0,0 +1,9 @@
+-- イベントのライフサイクル（draft → published → on_sale → closed、どの状態からも cancelled）と販売期間
+-- 既存のイベントはこれまでどおり予約を受け付けるよう on_sale にし、新しいイベントは下書きから始める
+ALTER TABLE events
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'on_sale',
+    ADD COLUMN sales_start_at TIMESTAMP,
+    ADD COLUMN sales_end_at TIMESTAMP;
+ALTER TABLE events ALTER COLUMN status SET DEFAULT 'draft';
+
+CREATE INDEX idx_events_status ON events(status);
@@ -0,0 +1,16 @@
+-- event_cancellations テーブル（イベント中止に伴う予約の取り消し・座席の解放の進捗。イベントごとに1行）
+CREATE TABLE event_cancellations (
+    event_id UUID PRIMARY KEY REFERENCES events(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'running',
+    total_reservations INTEGER NOT NULL DEFAULT 0,
+    processed_reservations INTEGER NOT NULL DEFAULT 0,
+    cancelled_reservations INTEGER NOT NULL DEFAULT 0,
+    refund_pending_reservations INTEGER NOT NULL DEFAULT 0,
+    released_seats INTEGER NOT NULL DEFAULT 0,
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP
+);
+
+-- 再開対象（未完了）の中止処理を引くため
+CREATE INDEX idx_event_cancellations_running ON event_cancellations(started_at) WHERE status = 'running';
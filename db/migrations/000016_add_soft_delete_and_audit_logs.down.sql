@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS audit_logs_append_only ON audit_logs;
+DROP FUNCTION IF EXISTS reject_audit_log_modification();
+DROP TABLE IF EXISTS audit_logs;
+ALTER TABLE seats DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE events DROP COLUMN IF EXISTS deleted_at;
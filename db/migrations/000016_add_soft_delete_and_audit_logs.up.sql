@@ -0,0 +1,30 @@
+-- events / seats の論理削除（削除後も予約や監査ログから参照できるよう行を残す）
+ALTER TABLE events ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE seats ADD COLUMN deleted_at TIMESTAMP;
+
+-- audit_logs テーブル（イベント・座席・予約の変更履歴。変更と同じトランザクションで記録する）
+-- created_at は同じトランザクション内の変更も順序がわかるよう clock_timestamp() にする
+CREATE TABLE audit_logs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id VARCHAR(255) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    actor_id VARCHAR(255) NOT NULL,
+    before_data JSONB,
+    after_data JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT clock_timestamp()
+);
+
+-- エンティティごとの履歴を新しい順に引くため
+CREATE INDEX idx_audit_logs_entity ON audit_logs(entity_type, entity_id, created_at DESC, id DESC);
+
+-- 追記のみ（記録後の更新・削除を禁止する）
+CREATE FUNCTION reject_audit_log_modification() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_logs は追記のみです';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_logs_append_only
+    BEFORE UPDATE OR DELETE ON audit_logs
+    FOR EACH ROW EXECUTE FUNCTION reject_audit_log_modification();
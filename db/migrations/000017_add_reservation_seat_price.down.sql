@@ -0,0 +1 @@
+ALTER TABLE reservation_seats DROP COLUMN IF EXISTS price;
@@ -0,0 +1,12 @@
+-- reservation_seats.price: 予約時点の座席の価格（部分キャンセルで外した座席の分だけを返金する）
+ALTER TABLE reservation_seats ADD COLUMN price INTEGER;
+
+-- 既存の予約は現在の価格（価格カテゴリが割り当てられた座席はカテゴリの金額）で埋める
+UPDATE reservation_seats rs
+SET price = COALESCE(pc.amount, s.price)
+FROM seats s
+LEFT JOIN price_categories pc ON pc.id = s.price_category_id
+WHERE s.id = rs.seat_id;
+
+ALTER TABLE reservation_seats ALTER COLUMN price SET NOT NULL;
+ALTER TABLE reservation_seats ADD CONSTRAINT reservation_seats_price_check CHECK (price >= 0);
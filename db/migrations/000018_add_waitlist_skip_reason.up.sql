@@ -0,0 +1,2 @@
+-- waitlist_entries.skip_reason: オファーを作成できず順番を飛ばした（status = 'skipped'）理由
+ALTER TABLE waitlist_entries ADD COLUMN skip_reason TEXT;
@@ -3,6 +3,7 @@ package e2e
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/labstack/echo/v4"
@@ -13,16 +14,25 @@ import (
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/application"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/config"
+	paymentinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/payment"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/postgres"
 	redisinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/redis"
 )
 
 var (
-	testServer  *TestServer
-	testDB      *sqlx.DB
-	redisClient *redis.Client
+	testServer      *TestServer
+	testDB          *sqlx.DB
+	redisClient     *redis.Client
+	paymentProvider *paymentinfra.FakeProvider
+	// cancellationService はイベントの中止処理をワーカーを待たずに進めるために使う
+	cancellationService *application.EventCancellationService
+	// seatService は総座席数の突き合わせをコマンドを介さずに実行するために使う
+	seatService *application.SeatService
 )
 
+// testJWTSecret はE2EテストでBearerトークンの署名に使う共有シークレット
+const testJWTSecret = "e2e-jwt-secret"
+
 // TestMain はE2Eテストのエントリポイント
 // パッケージ全体で1回だけサーバーを起動することで高速化
 func TestMain(m *testing.M) {
@@ -48,45 +58,102 @@ func TestMain(m *testing.M) {
 	// サービス初期化
 	lockManager := redisinfra.NewLockManager(redisClient)
 	seatCache := redisinfra.NewSeatCache(redisClient)
+	waitingRoom := redisinfra.NewWaitingRoom(redisClient)
+	paymentProvider = paymentinfra.NewFakeProvider(cfg.Payment.WebhookSecret, 200*time.Millisecond)
 
 	eventRepo := postgres.NewEventRepository(db)
 	seatRepo := postgres.NewSeatRepository(db)
 	reservationRepo := postgres.NewReservationRepository(db)
+	priceCategoryRepo := postgres.NewPriceCategoryRepository(db)
+	waitlistRepo := postgres.NewWaitlistRepository(db)
+	paymentRepo := postgres.NewPaymentRepository(db)
+	cancellationRepo := postgres.NewEventCancellationRepository(db)
 	txManager := postgres.NewTxManager(db)
 
-	eventService := application.NewEventService(eventRepo)
-	seatService := application.NewSeatService(seatRepo, eventRepo, seatCache)
-	reservationService := application.NewReservationService(txManager, reservationRepo, seatRepo, eventRepo, lockManager, seatCache)
+	eventService := application.NewEventService(eventRepo, seatRepo, reservationRepo)
+	seatService = application.NewSeatService(seatRepo, eventRepo, priceCategoryRepo, seatCache)
+	reservationService := application.NewReservationService(txManager, reservationRepo, seatRepo, eventRepo, lockManager, seatCache,
+		application.WithPriceCategoryRepository(priceCategoryRepo),
+		application.WithWaitlistRepository(waitlistRepo),
+		application.WithWaitingRoom(waitingRoom),
+		application.WithPayment(paymentProvider, paymentRepo),
+	)
+	cancellationService = application.NewEventCancellationService(txManager, eventRepo, cancellationRepo, reservationRepo, seatRepo, seatCache, 2)
+	priceCategoryService := application.NewPriceCategoryService(priceCategoryRepo, eventRepo)
+	waitlistService := application.NewWaitlistService(waitlistRepo, eventRepo, seatRepo)
+	queueService := application.NewQueueService(waitingRoom, eventRepo, cfg.WaitingRoom.AdmitBatchSize, cfg.WaitingRoom.AdmissionTTL)
 
 	eventHandler := handler.NewEventHandler(eventService)
+	cancellationHandler := handler.NewEventCancellationHandler(cancellationService)
 	seatHandler := handler.NewSeatHandler(seatService)
 	reservationHandler := handler.NewReservationHandler(reservationService)
+	priceCategoryHandler := handler.NewPriceCategoryHandler(priceCategoryService)
+	waitlistHandler := handler.NewWaitlistHandler(waitlistService)
+	queueHandler := handler.NewQueueHandler(queueService)
+	auditHandler := handler.NewAuditHandler(application.NewAuditService(postgres.NewAuditRepository(db)))
+	paymentHandler := handler.NewPaymentHandler(application.NewPaymentService(paymentProvider, paymentRepo, reservationRepo))
 	healthHandler := handler.NewHealthHandler()
 
 	// Echo セットアップ
 	e := echo.New()
 	e.Validator = api.NewValidator()
+	e.HTTPErrorHandler = api.CustomHTTPErrorHandler
 	middleware.SetupMiddleware(e)
 
 	e.GET("/health", healthHandler.Check)
 
-	v1 := e.Group("/api/v1")
+	// 既存のシナリオは X-User-ID・X-User-Roles で認証する（互換モード）
+	v1 := e.Group("/api/v1", middleware.JWTAuth(middleware.AuthConfig{
+		HMACSecret:           []byte(testJWTSecret),
+		AllowUserIDHeader:    true,
+		AllowUserRolesHeader: true,
+	}))
 	v1.POST("/events", eventHandler.Create)
 	v1.GET("/events", eventHandler.List)
+	v1.GET("/events/search", eventHandler.Search)
 	v1.GET("/events/:id", eventHandler.GetByID)
 	v1.PUT("/events/:id", eventHandler.Update)
+	v1.PATCH("/events/:id", eventHandler.Patch)
 	v1.DELETE("/events/:id", eventHandler.Delete)
+	v1.POST("/events/:id/publish", eventHandler.Publish)
+	v1.POST("/events/:id/open", eventHandler.Open)
+	v1.POST("/events/:id/close", eventHandler.Close)
+	v1.POST("/events/:id/cancel", cancellationHandler.Cancel)
+	v1.GET("/events/:id/cancellation", cancellationHandler.GetProgress)
 
 	v1.GET("/events/:event_id/seats", seatHandler.GetByEvent)
 	v1.POST("/events/:event_id/seats", seatHandler.Create)
 	v1.POST("/events/:event_id/seats/bulk", seatHandler.CreateBulk)
 	v1.GET("/events/:event_id/seats/available/count", seatHandler.CountAvailable)
 
+	v1.GET("/events/:event_id/price-categories", priceCategoryHandler.List)
+	v1.POST("/events/:event_id/price-categories", priceCategoryHandler.Create)
+	v1.GET("/events/:event_id/price-categories/:id", priceCategoryHandler.GetByID)
+	v1.PUT("/events/:event_id/price-categories/:id", priceCategoryHandler.Update)
+	v1.DELETE("/events/:event_id/price-categories/:id", priceCategoryHandler.Delete)
+
+	v1.POST("/events/:event_id/waitlist", waitlistHandler.Join)
+	v1.GET("/events/:event_id/waitlist/:id", waitlistHandler.GetByID)
+	v1.DELETE("/events/:event_id/waitlist/:id", waitlistHandler.Leave)
+
+	v1.POST("/events/:event_id/queue", queueHandler.Join)
+	v1.GET("/events/:event_id/queue/:token", queueHandler.GetByToken)
+
 	v1.POST("/reservations", reservationHandler.Create)
+	v1.POST("/reservations/best-available", reservationHandler.BestAvailable)
 	v1.GET("/reservations", reservationHandler.GetUserReservations)
+	v1.GET("/events/:event_id/reservations", reservationHandler.GetEventReservations)
 	v1.GET("/reservations/:id", reservationHandler.GetByID)
 	v1.POST("/reservations/:id/confirm", reservationHandler.Confirm)
+	v1.POST("/reservations/:id/extend", reservationHandler.Extend)
 	v1.POST("/reservations/:id/cancel", reservationHandler.Cancel)
+	v1.POST("/reservations/:id/refund", reservationHandler.Refund)
+	v1.POST("/reservations/:id/seats/remove", reservationHandler.RemoveSeats)
+	v1.GET("/reservations/:id/payment", paymentHandler.GetByReservation)
+
+	v1.POST("/payments/webhook", paymentHandler.Webhook)
+
+	v1.GET("/audit", auditHandler.List)
 
 	testServer = &TestServer{
 		Echo:    e,
@@ -106,7 +173,7 @@ func TestMain(m *testing.M) {
 
 // cleanupTables はテーブルをクリーンアップ
 func cleanupTables() {
-	testDB.Exec("TRUNCATE TABLE reservation_seats, reservations, seats, events RESTART IDENTITY CASCADE")
+	testDB.Exec("TRUNCATE TABLE audit_logs, payments, waitlist_entries, reservation_seats, reservations, seats, price_categories, events RESTART IDENTITY CASCADE")
 }
 
 // getTestServer は共有サーバーを取得（テスト前にテーブルをクリーンアップ）
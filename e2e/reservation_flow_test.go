@@ -2,18 +2,26 @@ package e2e
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	redisinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/redis"
 )
 
+// organizerHeaders はイベントを管理する主催者としてリクエストするためのヘッダー（X-User-ID 互換モード）
+var organizerHeaders = map[string]string{"X-User-ID": "e2e-organizer", "X-User-Roles": "organizer"}
+
 // TestServer はE2Eテスト用のサーバー
 type TestServer struct {
 	Echo    *echo.Echo
@@ -26,7 +34,11 @@ func (s *TestServer) Request(method, path string, body interface{}, headers map[
 	if body != nil {
 		reqBody, _ = json.Marshal(body)
 	}
+	return s.RequestRaw(method, path, reqBody, headers)
+}
 
+// RequestRaw は本文をそのまま送信する（署名検証など本文のバイト列が重要な場合に使用）
+func (s *TestServer) RequestRaw(method, path string, reqBody []byte, headers map[string]string) *httptest.ResponseRecorder {
 	req := httptest.NewRequest(method, path, bytes.NewReader(reqBody))
 	req.Header.Set("Content-Type", "application/json")
 	for k, v := range headers {
@@ -38,6 +50,21 @@ func (s *TestServer) Request(method, path string, body interface{}, headers map[
 	return rec
 }
 
+// publishEvent は下書きのイベントを公開する
+func publishEvent(t *testing.T, server *TestServer, eventID string) {
+	t.Helper()
+	rec := server.Request("POST", "/api/v1/events/"+eventID+"/publish", nil, organizerHeaders)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+}
+
+// openEventSales は作成直後（下書き）のイベントを公開して販売を開始する
+func openEventSales(t *testing.T, server *TestServer, eventID string) {
+	t.Helper()
+	publishEvent(t, server, eventID)
+	rec := server.Request("POST", "/api/v1/events/"+eventID+"/open", nil, organizerHeaders)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+}
+
 // TestE2E_HealthCheck はヘルスチェックをテスト
 func TestE2E_HealthCheck(t *testing.T) {
 	server := getTestServer(t)
@@ -69,12 +96,13 @@ func TestE2E_CompleteReservationJourney(t *testing.T) {
 			"total_seats": 10000,
 		}
 
-		rec := server.Request("POST", "/api/v1/events", body, nil)
+		rec := server.Request("POST", "/api/v1/events", body, organizerHeaders)
 		require.Equal(t, http.StatusCreated, rec.Code)
 
 		var resp map[string]interface{}
 		json.Unmarshal(rec.Body.Bytes(), &resp)
 		eventID = resp["id"].(string)
+		openEventSales(t, server, eventID)
 		assert.NotEmpty(t, eventID)
 	})
 
@@ -87,7 +115,7 @@ func TestE2E_CompleteReservationJourney(t *testing.T) {
 		}
 
 		path := fmt.Sprintf("/api/v1/events/%s/seats/bulk", eventID)
-		rec := server.Request("POST", path, body, nil)
+		rec := server.Request("POST", path, body, organizerHeaders)
 		require.Equal(t, http.StatusCreated, rec.Code)
 
 		var resp []map[string]interface{}
@@ -130,7 +158,7 @@ func TestE2E_CompleteReservationJourney(t *testing.T) {
 	// 5. 予約確定
 	t.Run("予約確定", func(t *testing.T) {
 		path := fmt.Sprintf("/api/v1/reservations/%s/confirm", reservationID)
-		rec := server.Request("POST", path, nil, map[string]string{
+		rec := server.Request("POST", path, map[string]interface{}{"payment_token": "tok_visa"}, map[string]string{
 			"X-User-ID": userID,
 		})
 		require.Equal(t, http.StatusOK, rec.Code)
@@ -180,14 +208,15 @@ func TestE2E_ReservationConflict(t *testing.T) {
 		"end_at":      time.Now().Add(7*24*time.Hour + 2*time.Hour).Format(time.RFC3339),
 		"total_seats": 1,
 	}
-	rec := server.Request("POST", "/api/v1/events", body, nil)
+	rec := server.Request("POST", "/api/v1/events", body, organizerHeaders)
 	require.Equal(t, http.StatusCreated, rec.Code)
 	var eventResp map[string]interface{}
 	json.Unmarshal(rec.Body.Bytes(), &eventResp)
 	eventID = eventResp["id"].(string)
+	openEventSales(t, server, eventID)
 
 	seatBody := map[string]interface{}{"prefix": "VIP", "count": 1, "price": 50000}
-	rec = server.Request("POST", fmt.Sprintf("/api/v1/events/%s/seats/bulk", eventID), seatBody, nil)
+	rec = server.Request("POST", fmt.Sprintf("/api/v1/events/%s/seats/bulk", eventID), seatBody, organizerHeaders)
 	require.Equal(t, http.StatusCreated, rec.Code)
 	var seatsResp []map[string]interface{}
 	json.Unmarshal(rec.Body.Bytes(), &seatsResp)
@@ -234,14 +263,15 @@ func TestE2E_CancelAndRebook(t *testing.T) {
 		"end_at":      time.Now().Add(5*24*time.Hour + 2*time.Hour).Format(time.RFC3339),
 		"total_seats": 1,
 	}
-	rec := server.Request("POST", "/api/v1/events", eventBody, nil)
+	rec := server.Request("POST", "/api/v1/events", eventBody, organizerHeaders)
 	require.Equal(t, http.StatusCreated, rec.Code)
 	var eventResp map[string]interface{}
 	json.Unmarshal(rec.Body.Bytes(), &eventResp)
 	eventID = eventResp["id"].(string)
+	openEventSales(t, server, eventID)
 
 	seatBody := map[string]interface{}{"prefix": "S", "count": 1, "price": 10000}
-	rec = server.Request("POST", fmt.Sprintf("/api/v1/events/%s/seats/bulk", eventID), seatBody, nil)
+	rec = server.Request("POST", fmt.Sprintf("/api/v1/events/%s/seats/bulk", eventID), seatBody, organizerHeaders)
 	require.Equal(t, http.StatusCreated, rec.Code)
 	var seatsResp []map[string]interface{}
 	json.Unmarshal(rec.Body.Bytes(), &seatsResp)
@@ -301,14 +331,15 @@ func TestE2E_IdempotencyKey(t *testing.T) {
 		"end_at":      time.Now().Add(3*24*time.Hour + 2*time.Hour).Format(time.RFC3339),
 		"total_seats": 10,
 	}
-	rec := server.Request("POST", "/api/v1/events", eventBody, nil)
+	rec := server.Request("POST", "/api/v1/events", eventBody, organizerHeaders)
 	require.Equal(t, http.StatusCreated, rec.Code)
 	var eventResp map[string]interface{}
 	json.Unmarshal(rec.Body.Bytes(), &eventResp)
 	eventID = eventResp["id"].(string)
+	openEventSales(t, server, eventID)
 
 	seatBody := map[string]interface{}{"prefix": "I", "count": 2, "price": 8000}
-	rec = server.Request("POST", fmt.Sprintf("/api/v1/events/%s/seats/bulk", eventID), seatBody, nil)
+	rec = server.Request("POST", fmt.Sprintf("/api/v1/events/%s/seats/bulk", eventID), seatBody, organizerHeaders)
 	require.Equal(t, http.StatusCreated, rec.Code)
 	var seatsResp []map[string]interface{}
 	json.Unmarshal(rec.Body.Bytes(), &seatsResp)
@@ -361,12 +392,13 @@ func TestE2E_EventCRUD(t *testing.T) {
 			"end_at":      time.Now().Add(10*24*time.Hour + 2*time.Hour).Format(time.RFC3339),
 			"total_seats": 50,
 		}
-		rec := server.Request("POST", "/api/v1/events", body, nil)
+		rec := server.Request("POST", "/api/v1/events", body, organizerHeaders)
 		require.Equal(t, http.StatusCreated, rec.Code)
 
 		var resp map[string]interface{}
 		json.Unmarshal(rec.Body.Bytes(), &resp)
 		eventID = resp["id"].(string)
+		publishEvent(t, server, eventID)
 	})
 
 	t.Run("イベント取得", func(t *testing.T) {
@@ -380,12 +412,45 @@ func TestE2E_EventCRUD(t *testing.T) {
 	})
 
 	t.Run("イベント一覧取得", func(t *testing.T) {
-		rec := server.Request("GET", "/api/v1/events", nil, nil)
+		rec := server.Request("GET", "/api/v1/events?limit=1", nil, nil)
 		require.Equal(t, http.StatusOK, rec.Code)
 
-		var resp []map[string]interface{}
+		var resp struct {
+			Data       []map[string]interface{} `json:"data"`
+			Pagination struct {
+				NextCursor string `json:"next_cursor"`
+				HasMore    bool   `json:"has_more"`
+			} `json:"pagination"`
+		}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		require.Len(t, resp.Data, 1)
+		// 作成日時の新しい順のため、直前に作成したイベントが先頭になる
+		assert.Equal(t, eventID, resp.Data[0]["id"])
+
+		if resp.Pagination.HasMore {
+			rec = server.Request("GET", "/api/v1/events?limit=1&cursor="+resp.Pagination.NextCursor, nil, nil)
+			require.Equal(t, http.StatusOK, rec.Code)
+			var next struct {
+				Data []map[string]interface{} `json:"data"`
+			}
+			json.Unmarshal(rec.Body.Bytes(), &next)
+			require.Len(t, next.Data, 1)
+			assert.NotEqual(t, eventID, next.Data[0]["id"])
+		}
+	})
+
+	t.Run("イベント一覧を名前で絞り込み", func(t *testing.T) {
+		rec := server.Request("GET", "/api/v1/events?q="+url.QueryEscape("CRUDテスト"), nil, nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var resp struct {
+			Data []map[string]interface{} `json:"data"`
+		}
 		json.Unmarshal(rec.Body.Bytes(), &resp)
-		assert.GreaterOrEqual(t, len(resp), 1)
+		require.NotEmpty(t, resp.Data)
+		for _, e := range resp.Data {
+			assert.Contains(t, e["name"], "CRUDテスト")
+		}
 	})
 
 	t.Run("イベント更新", func(t *testing.T) {
@@ -397,7 +462,7 @@ func TestE2E_EventCRUD(t *testing.T) {
 			"total_seats": 60,
 		}
 		path := fmt.Sprintf("/api/v1/events/%s", eventID)
-		rec := server.Request("PUT", path, body, nil)
+		rec := server.Request("PUT", path, body, organizerHeaders)
 		require.Equal(t, http.StatusOK, rec.Code)
 
 		var resp map[string]interface{}
@@ -407,7 +472,7 @@ func TestE2E_EventCRUD(t *testing.T) {
 
 	t.Run("イベント削除", func(t *testing.T) {
 		path := fmt.Sprintf("/api/v1/events/%s", eventID)
-		rec := server.Request("DELETE", path, nil, nil)
+		rec := server.Request("DELETE", path, nil, organizerHeaders)
 		require.Equal(t, http.StatusNoContent, rec.Code)
 
 		// 削除後は取得できない
@@ -415,3 +480,1439 @@ func TestE2E_EventCRUD(t *testing.T) {
 		assert.Equal(t, http.StatusNotFound, rec.Code)
 	})
 }
+
+// TestE2E_PriceCategoryPricing は価格カテゴリによる料金計算をテスト
+func TestE2E_PriceCategoryPricing(t *testing.T) {
+	server := getTestServer(t)
+
+	var eventID, categoryID string
+	var seatIDs []string
+
+	t.Run("イベント作成", func(t *testing.T) {
+		body := map[string]interface{}{
+			"name":        "価格カテゴリテスト",
+			"venue":       "テスト会場",
+			"start_at":    time.Now().Add(7 * 24 * time.Hour).Format(time.RFC3339),
+			"end_at":      time.Now().Add(7*24*time.Hour + 2*time.Hour).Format(time.RFC3339),
+			"total_seats": 10,
+		}
+		rec := server.Request("POST", "/api/v1/events", body, organizerHeaders)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var resp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		eventID = resp["id"].(string)
+		openEventSales(t, server, eventID)
+	})
+
+	t.Run("価格カテゴリ作成", func(t *testing.T) {
+		body := map[string]interface{}{"name": "S席", "currency": "JPY", "amount": 12000}
+		path := fmt.Sprintf("/api/v1/events/%s/price-categories", eventID)
+		rec := server.Request("POST", path, body, organizerHeaders)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var resp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		categoryID = resp["id"].(string)
+
+		// 同名カテゴリは作成できない
+		rec = server.Request("POST", path, body, organizerHeaders)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("カテゴリ指定で座席一括作成", func(t *testing.T) {
+		body := map[string]interface{}{"prefix": "S", "count": 2, "price_category_id": categoryID}
+		path := fmt.Sprintf("/api/v1/events/%s/seats/bulk", eventID)
+		rec := server.Request("POST", path, body, organizerHeaders)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var resp []map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		require.Len(t, resp, 2)
+		for _, s := range resp {
+			assert.Equal(t, float64(12000), s["price"])
+			seatIDs = append(seatIDs, s["id"].(string))
+		}
+	})
+
+	t.Run("カテゴリ金額の変更が予約金額に反映される", func(t *testing.T) {
+		body := map[string]interface{}{"name": "S席", "currency": "JPY", "amount": 15000}
+		path := fmt.Sprintf("/api/v1/events/%s/price-categories/%s", eventID, categoryID)
+		rec := server.Request("PUT", path, body, organizerHeaders)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rec = server.Request("POST", "/api/v1/reservations", map[string]interface{}{
+			"event_id":        eventID,
+			"seat_ids":        seatIDs,
+			"idempotency_key": "e2e-price-category-001",
+		}, map[string]string{"X-User-ID": "e2e-user-price"})
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var resp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.Equal(t, float64(30000), resp["total_amount"])
+
+		// 座席の価格の変更は座席の監査ログに記録する
+		rec = server.Request("GET", "/api/v1/audit?entity=seat&id="+seatIDs[0], nil, map[string]string{"X-User-ID": "e2e-support", "X-User-Roles": "support"})
+		require.Equal(t, http.StatusOK, rec.Code)
+		var audit struct {
+			Data []struct {
+				Before map[string]interface{} `json:"before"`
+				After  map[string]interface{} `json:"after"`
+			} `json:"data"`
+		}
+		json.Unmarshal(rec.Body.Bytes(), &audit)
+		require.NotEmpty(t, audit.Data)
+		var repriced bool
+		for _, entry := range audit.Data {
+			if entry.Before["price"] == float64(12000) && entry.After["price"] == float64(15000) {
+				repriced = true
+				assert.Equal(t, entry.Before["version"].(float64)+1, entry.After["version"])
+			}
+		}
+		assert.True(t, repriced)
+	})
+
+	t.Run("座席に割り当て済みのカテゴリは削除できない", func(t *testing.T) {
+		path := fmt.Sprintf("/api/v1/events/%s/price-categories/%s", eventID, categoryID)
+		rec := server.Request("DELETE", path, nil, organizerHeaders)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
+
+// TestE2E_SeatLayoutImport は座席レイアウト付きのイベント作成と座席表取得をテスト
+func TestE2E_SeatLayoutImport(t *testing.T) {
+	server := getTestServer(t)
+
+	body := map[string]interface{}{
+		"name":     "レイアウトテスト",
+		"venue":    "テストアリーナ",
+		"start_at": time.Now().Add(7 * 24 * time.Hour).Format(time.RFC3339),
+		"end_at":   time.Now().Add(7*24*time.Hour + 2*time.Hour).Format(time.RFC3339),
+		"layout": map[string]interface{}{
+			"sections": []map[string]interface{}{
+				{"name": "ARENA", "price": 12000, "rows": []map[string]interface{}{
+					{"label": "A", "seats": []map[string]interface{}{
+						{"index": 1, "x": 10, "y": 20, "accessible": true},
+						{"index": 2, "x": 20, "y": 20},
+					}},
+				}},
+				{"name": "STAND", "price": 8000, "rows": []map[string]interface{}{
+					{"label": "1", "seats": []map[string]interface{}{
+						{"index": 1, "x": 10, "y": 100, "obstructed_view": true},
+					}},
+				}},
+			},
+		},
+	}
+	rec := server.Request("POST", "/api/v1/events", body, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var ev map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &ev)
+	eventID := ev["id"].(string)
+	assert.Equal(t, float64(3), ev["total_seats"])
+
+	path := fmt.Sprintf("/api/v1/events/%s/seats?view=layout", eventID)
+	rec = server.Request("GET", path, nil, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var seatMap struct {
+		Sections []struct {
+			Name string `json:"name"`
+			Rows []struct {
+				Label string                   `json:"label"`
+				Seats []map[string]interface{} `json:"seats"`
+			} `json:"rows"`
+		} `json:"sections"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &seatMap))
+	require.Len(t, seatMap.Sections, 2)
+	assert.Equal(t, "ARENA", seatMap.Sections[0].Name)
+	require.Len(t, seatMap.Sections[0].Rows[0].Seats, 2)
+	assert.Equal(t, true, seatMap.Sections[0].Rows[0].Seats[0]["accessible"])
+	assert.Equal(t, float64(12000), seatMap.Sections[0].Rows[0].Seats[0]["price"])
+	assert.Equal(t, true, seatMap.Sections[1].Rows[0].Seats[0]["obstructed_view"])
+}
+
+// TestE2E_WaitlistOffer は売り切れ時の順番待ちと座席解放時のオファーをテスト
+func TestE2E_WaitlistOffer(t *testing.T) {
+	server := getTestServer(t)
+
+	var eventID, seatID, reservationID, entryID string
+
+	// セットアップ（1席のみのイベント）
+	eventBody := map[string]interface{}{
+		"name":        "順番待ちテスト",
+		"venue":       "テスト会場",
+		"start_at":    time.Now().Add(5 * 24 * time.Hour).Format(time.RFC3339),
+		"end_at":      time.Now().Add(5*24*time.Hour + 2*time.Hour).Format(time.RFC3339),
+		"total_seats": 1,
+	}
+	rec := server.Request("POST", "/api/v1/events", eventBody, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var eventResp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &eventResp)
+	eventID = eventResp["id"].(string)
+	openEventSales(t, server, eventID)
+
+	seatBody := map[string]interface{}{"prefix": "S", "count": 1, "price": 10000}
+	rec = server.Request("POST", fmt.Sprintf("/api/v1/events/%s/seats/bulk", eventID), seatBody, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var seatsResp []map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &seatsResp)
+	seatID = seatsResp[0]["id"].(string)
+
+	waitlistPath := fmt.Sprintf("/api/v1/events/%s/waitlist", eventID)
+
+	t.Run("空席がある間は順番待ちに登録できない", func(t *testing.T) {
+		rec := server.Request("POST", waitlistPath, map[string]interface{}{"quantity": 1}, map[string]string{
+			"X-User-ID": "user-B",
+		})
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("ユーザーAが最後の1席を予約", func(t *testing.T) {
+		body := map[string]interface{}{
+			"event_id":        eventID,
+			"seat_ids":        []string{seatID},
+			"idempotency_key": "waitlist-a",
+		}
+		rec := server.Request("POST", "/api/v1/reservations", body, map[string]string{
+			"X-User-ID": "user-A",
+		})
+		require.Equal(t, http.StatusCreated, rec.Code)
+		var resp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		reservationID = resp["id"].(string)
+	})
+
+	t.Run("ユーザーBが順番待ちに登録", func(t *testing.T) {
+		rec := server.Request("POST", waitlistPath, map[string]interface{}{"quantity": 1}, map[string]string{
+			"X-User-ID": "user-B",
+		})
+		require.Equal(t, http.StatusCreated, rec.Code)
+		var resp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		entryID = resp["id"].(string)
+
+		rec = server.Request("GET", waitlistPath+"/"+entryID, nil, map[string]string{"X-User-ID": "user-B"})
+		require.Equal(t, http.StatusOK, rec.Code)
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.Equal(t, "waiting", resp["status"])
+		assert.Equal(t, float64(1), resp["position"])
+
+		// 他のユーザーからはエントリの存在も見えない
+		rec = server.Request("GET", waitlistPath+"/"+entryID, nil, map[string]string{"X-User-ID": "user-A"})
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("キャンセルで解放された座席がユーザーBにオファーされる", func(t *testing.T) {
+		rec := server.Request("POST", fmt.Sprintf("/api/v1/reservations/%s/cancel", reservationID), nil, map[string]string{
+			"X-User-ID": "user-A",
+		})
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rec = server.Request("GET", waitlistPath+"/"+entryID, nil, map[string]string{"X-User-ID": "user-B"})
+		require.Equal(t, http.StatusOK, rec.Code)
+		var entry map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &entry)
+		assert.Equal(t, "offered", entry["status"])
+		require.NotNil(t, entry["reservation_id"])
+
+		rec = server.Request("GET", "/api/v1/reservations/"+entry["reservation_id"].(string), nil, map[string]string{"X-User-ID": "user-B"})
+		require.Equal(t, http.StatusOK, rec.Code)
+		var offer map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &offer)
+		assert.Equal(t, "user-B", offer["user_id"])
+		assert.Equal(t, "pending", offer["status"])
+		assert.Equal(t, []interface{}{seatID}, offer["seat_ids"])
+
+		// オファー後は順番待ちの取り消しではなく予約のキャンセルで辞退する
+		rec = server.Request("DELETE", waitlistPath+"/"+entryID, nil, map[string]string{"X-User-ID": "user-B"})
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("オファー前の順番待ちは本人だけが取り消せる", func(t *testing.T) {
+		rec := server.Request("POST", waitlistPath, map[string]interface{}{"quantity": 1}, map[string]string{
+			"X-User-ID": "user-C",
+		})
+		require.Equal(t, http.StatusCreated, rec.Code)
+		var resp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		entryC := resp["id"].(string)
+
+		rec = server.Request("DELETE", waitlistPath+"/"+entryC, nil, map[string]string{"X-User-ID": "user-B"})
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		rec = server.Request("DELETE", waitlistPath+"/"+entryC, nil, map[string]string{"X-User-ID": "user-C"})
+		require.Equal(t, http.StatusOK, rec.Code)
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.Equal(t, "cancelled", resp["status"])
+	})
+}
+
+// TestE2E_WaitingRoom は待合室が有効なイベントで、入場許可を得たユーザーだけが予約できることを確認
+func TestE2E_WaitingRoom(t *testing.T) {
+	server := getTestServer(t)
+
+	eventBody := map[string]interface{}{
+		"name":                 "待合室テスト",
+		"venue":                "テスト会場",
+		"start_at":             time.Now().Add(5 * 24 * time.Hour).Format(time.RFC3339),
+		"end_at":               time.Now().Add(5*24*time.Hour + 2*time.Hour).Format(time.RFC3339),
+		"total_seats":          2,
+		"waiting_room_enabled": true,
+	}
+	rec := server.Request("POST", "/api/v1/events", eventBody, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var eventResp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &eventResp)
+	eventID := eventResp["id"].(string)
+	openEventSales(t, server, eventID)
+	assert.Equal(t, true, eventResp["waiting_room_enabled"])
+
+	rec = server.Request("POST", fmt.Sprintf("/api/v1/events/%s/seats/bulk", eventID),
+		map[string]interface{}{"prefix": "Q", "count": 2, "price": 5000}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var seatsResp []map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &seatsResp)
+	seatID := seatsResp[0]["id"].(string)
+
+	queuePath := fmt.Sprintf("/api/v1/events/%s/queue", eventID)
+	var token string
+
+	t.Run("待合室に並ぶとチケットが発行される", func(t *testing.T) {
+		rec := server.Request("POST", queuePath, nil, map[string]string{"X-User-ID": "user-Q"})
+		require.Equal(t, http.StatusCreated, rec.Code)
+		var resp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		token = resp["token"].(string)
+		assert.Equal(t, "waiting", resp["status"])
+		assert.Equal(t, float64(1), resp["position"])
+	})
+
+	reserveBody := map[string]interface{}{
+		"event_id":        eventID,
+		"seat_ids":        []string{seatID},
+		"idempotency_key": "waiting-room-q",
+	}
+
+	t.Run("入場許可前は予約できない", func(t *testing.T) {
+		rec := server.Request("POST", "/api/v1/reservations", reserveBody, map[string]string{
+			"X-User-ID": "user-Q", "X-Queue-Token": token,
+		})
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("入場許可後は予約できる", func(t *testing.T) {
+		admitted, err := redisinfra.NewWaitingRoom(redisClient).Admit(context.Background(), eventID, 10, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, 1, admitted)
+
+		rec := server.Request("GET", queuePath+"/"+token, nil, nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var resp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.Equal(t, "admitted", resp["status"])
+
+		rec = server.Request("POST", "/api/v1/reservations", reserveBody, map[string]string{
+			"X-User-ID": "user-Q", "X-Queue-Token": token,
+		})
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+
+	t.Run("他人のトークンでは予約できない", func(t *testing.T) {
+		body := map[string]interface{}{
+			"event_id":        eventID,
+			"seat_ids":        []string{seatsResp[1]["id"].(string)},
+			"idempotency_key": "waiting-room-other",
+		}
+		rec := server.Request("POST", "/api/v1/reservations", body, map[string]string{
+			"X-User-ID": "user-other", "X-Queue-Token": token,
+		})
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
+
+// TestE2E_PaymentDeclinedThenRetry はカード拒否後に別のカードで確定し、Webhookで返金を反映できることを確認
+func TestE2E_PaymentDeclinedThenRetry(t *testing.T) {
+	server := getTestServer(t)
+
+	eventBody := map[string]interface{}{
+		"name":        "決済テスト",
+		"venue":       "テスト会場",
+		"start_at":    time.Now().Add(5 * 24 * time.Hour).Format(time.RFC3339),
+		"end_at":      time.Now().Add(5*24*time.Hour + 2*time.Hour).Format(time.RFC3339),
+		"total_seats": 1,
+	}
+	rec := server.Request("POST", "/api/v1/events", eventBody, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var eventResp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &eventResp)
+	eventID := eventResp["id"].(string)
+	openEventSales(t, server, eventID)
+
+	rec = server.Request("POST", fmt.Sprintf("/api/v1/events/%s/seats/bulk", eventID),
+		map[string]interface{}{"prefix": "P", "count": 1, "price": 8000}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var seatsResp []map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &seatsResp)
+
+	rec = server.Request("POST", "/api/v1/reservations", map[string]interface{}{
+		"event_id":        eventID,
+		"seat_ids":        []string{seatsResp[0]["id"].(string)},
+		"idempotency_key": "payment-flow",
+	}, map[string]string{"X-User-ID": "user-P"})
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var resResp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &resResp)
+	reservationID := resResp["id"].(string)
+
+	confirmPath := fmt.Sprintf("/api/v1/reservations/%s/confirm", reservationID)
+	paymentPath := fmt.Sprintf("/api/v1/reservations/%s/payment", reservationID)
+
+	t.Run("拒否されるカードでは確定できない", func(t *testing.T) {
+		rec := server.Request("POST", confirmPath, map[string]interface{}{"payment_token": "tok_decline"}, map[string]string{"X-User-ID": "user-P"})
+		assert.Equal(t, http.StatusPaymentRequired, rec.Code)
+
+		rec = server.Request("GET", paymentPath, nil, map[string]string{"X-User-ID": "user-P"})
+		require.Equal(t, http.StatusOK, rec.Code)
+		var pay map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &pay)
+		assert.Equal(t, "failed", pay["status"])
+
+		rec = server.Request("GET", "/api/v1/reservations/"+reservationID, nil, map[string]string{"X-User-ID": "user-P"})
+		var res map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.Equal(t, "pending", res["status"])
+	})
+
+	t.Run("プロバイダーがタイムアウトした場合は504", func(t *testing.T) {
+		rec := server.Request("POST", confirmPath, map[string]interface{}{"payment_token": "tok_timeout"}, map[string]string{"X-User-ID": "user-P"})
+		assert.Equal(t, http.StatusGatewayTimeout, rec.Code)
+	})
+
+	var providerPaymentID string
+	t.Run("別のカードで確定できる", func(t *testing.T) {
+		rec := server.Request("POST", confirmPath, map[string]interface{}{"payment_token": "tok_visa"}, map[string]string{"X-User-ID": "user-P"})
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rec = server.Request("GET", paymentPath, nil, map[string]string{"X-User-ID": "user-P"})
+		require.Equal(t, http.StatusOK, rec.Code)
+		var pay map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &pay)
+		assert.Equal(t, "captured", pay["status"])
+		assert.Equal(t, float64(8000), pay["amount"])
+		providerPaymentID = pay["provider_payment_id"].(string)
+	})
+
+	t.Run("署名付きWebhookで返金を反映できる", func(t *testing.T) {
+		payload := []byte(fmt.Sprintf(`{"type":"payment.refunded","payment_id":%q}`, providerPaymentID))
+
+		rec := server.RequestRaw("POST", "/api/v1/payments/webhook", payload, map[string]string{
+			"X-Payment-Signature": "invalid",
+		})
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+		rec = server.RequestRaw("POST", "/api/v1/payments/webhook", payload, map[string]string{
+			"X-Payment-Signature": paymentProvider.SignWebhook(payload),
+		})
+		require.Equal(t, http.StatusOK, rec.Code)
+		var pay map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &pay)
+		assert.Equal(t, "refunded", pay["status"])
+	})
+}
+
+func TestE2E_RefundPolicy(t *testing.T) {
+	server := getTestServer(t)
+
+	// 開始3日前: デフォルトの返金ポリシー（7日前まで全額、1日前まで50%）では部分返金
+	rec := server.Request("POST", "/api/v1/events", map[string]interface{}{
+		"name":        "返金テスト",
+		"venue":       "テスト会場",
+		"start_at":    time.Now().Add(3 * 24 * time.Hour).Format(time.RFC3339),
+		"end_at":      time.Now().Add(3*24*time.Hour + 2*time.Hour).Format(time.RFC3339),
+		"total_seats": 1,
+	}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var eventResp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &eventResp)
+	eventID := eventResp["id"].(string)
+	openEventSales(t, server, eventID)
+	assert.Equal(t, float64(50), eventResp["partial_refund_percent"])
+
+	rec = server.Request("POST", fmt.Sprintf("/api/v1/events/%s/seats/bulk", eventID),
+		map[string]interface{}{"prefix": "R", "count": 1, "price": 8000}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var seatsResp []map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &seatsResp)
+
+	rec = server.Request("POST", "/api/v1/reservations", map[string]interface{}{
+		"event_id":        eventID,
+		"seat_ids":        []string{seatsResp[0]["id"].(string)},
+		"idempotency_key": "refund-flow",
+	}, map[string]string{"X-User-ID": "user-R"})
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var resResp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &resResp)
+	reservationID := resResp["id"].(string)
+	refundPath := fmt.Sprintf("/api/v1/reservations/%s/refund", reservationID)
+
+	t.Run("確定前の予約は返金できない", func(t *testing.T) {
+		rec := server.Request("POST", refundPath, nil, map[string]string{"X-User-ID": "user-R"})
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	rec = server.Request("POST", fmt.Sprintf("/api/v1/reservations/%s/confirm", reservationID),
+		map[string]interface{}{"payment_token": "tok_visa"}, map[string]string{"X-User-ID": "user-R"})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	t.Run("部分返金して座席を解放する", func(t *testing.T) {
+		rec := server.Request("POST", refundPath, nil, map[string]string{"X-User-ID": "user-R"})
+		require.Equal(t, http.StatusOK, rec.Code)
+		var res map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.Equal(t, "refunded", res["status"])
+		assert.Equal(t, float64(4000), res["refunded_amount"])
+
+		rec = server.Request("GET", fmt.Sprintf("/api/v1/reservations/%s/payment", reservationID), nil, map[string]string{"X-User-ID": "user-R"})
+		require.Equal(t, http.StatusOK, rec.Code)
+		var pay map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &pay)
+		assert.Equal(t, "refunded", pay["status"])
+		assert.Equal(t, float64(4000), pay["refunded_amount"])
+
+		rec = server.Request("GET", fmt.Sprintf("/api/v1/events/%s/seats/available/count", eventID), nil, nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var countResp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &countResp)
+		assert.Equal(t, float64(1), countResp["count"])
+	})
+
+	t.Run("返金済みの予約は再度返金できない", func(t *testing.T) {
+		rec := server.Request("POST", refundPath, nil, map[string]string{"X-User-ID": "user-R"})
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
+
+func TestE2E_RemoveSeatsFromReservation(t *testing.T) {
+	server := getTestServer(t)
+
+	// 確定後に外した座席を全額返金できるよう、全額返金の期間（開始7日前まで）に収める
+	rec := server.Request("POST", "/api/v1/events", map[string]interface{}{
+		"name":        "座席取り外しテスト",
+		"venue":       "テスト会場",
+		"start_at":    time.Now().Add(10 * 24 * time.Hour).Format(time.RFC3339),
+		"end_at":      time.Now().Add(10*24*time.Hour + 2*time.Hour).Format(time.RFC3339),
+		"total_seats": 3,
+	}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var eventResp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &eventResp)
+	eventID := eventResp["id"].(string)
+	openEventSales(t, server, eventID)
+
+	rec = server.Request("POST", fmt.Sprintf("/api/v1/events/%s/seats/bulk", eventID),
+		map[string]interface{}{"prefix": "S", "count": 3, "price": 3000}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var seatsResp []map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &seatsResp)
+	seatIDs := make([]string, len(seatsResp))
+	for i, s := range seatsResp {
+		seatIDs[i] = s["id"].(string)
+	}
+
+	rec = server.Request("POST", "/api/v1/reservations", map[string]interface{}{
+		"event_id":        eventID,
+		"seat_ids":        seatIDs,
+		"idempotency_key": "remove-seats-flow",
+	}, map[string]string{"X-User-ID": "user-S"})
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var resResp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &resResp)
+	reservationID := resResp["id"].(string)
+	removePath := fmt.Sprintf("/api/v1/reservations/%s/seats/remove", reservationID)
+	countPath := fmt.Sprintf("/api/v1/events/%s/seats/available/count", eventID)
+
+	t.Run("1席だけ外すと座席が解放され金額が再計算される", func(t *testing.T) {
+		rec := server.Request("POST", removePath, map[string]interface{}{"seat_ids": []string{seatIDs[2]}}, map[string]string{"X-User-ID": "user-S"})
+		require.Equal(t, http.StatusOK, rec.Code)
+		var res map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.Len(t, res["seat_ids"], 2)
+		assert.Equal(t, float64(6000), res["total_amount"])
+
+		rec = server.Request("GET", "/api/v1/reservations/"+reservationID, nil, map[string]string{"X-User-ID": "user-S"})
+		json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.Len(t, res["seat_ids"], 2)
+		assert.Equal(t, float64(6000), res["total_amount"])
+
+		rec = server.Request("GET", countPath, nil, nil)
+		var countResp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &countResp)
+		assert.Equal(t, float64(1), countResp["count"])
+	})
+
+	t.Run("確定後に外すと減額分が返金される", func(t *testing.T) {
+		rec := server.Request("POST", fmt.Sprintf("/api/v1/reservations/%s/confirm", reservationID),
+			map[string]interface{}{"payment_token": "tok_visa"}, map[string]string{"X-User-ID": "user-S"})
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rec = server.Request("POST", removePath, map[string]interface{}{"seat_ids": []string{seatIDs[1]}}, map[string]string{"X-User-ID": "user-S"})
+		require.Equal(t, http.StatusOK, rec.Code)
+		var res map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.Equal(t, "confirmed", res["status"])
+		assert.Equal(t, float64(3000), res["total_amount"])
+
+		rec = server.Request("GET", fmt.Sprintf("/api/v1/reservations/%s/payment", reservationID), nil, map[string]string{"X-User-ID": "user-S"})
+		var pay map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &pay)
+		assert.Equal(t, float64(3000), pay["refunded_amount"])
+	})
+
+	t.Run("最後の1席は外せない", func(t *testing.T) {
+		rec := server.Request("POST", removePath, map[string]interface{}{"seat_ids": []string{seatIDs[0]}}, map[string]string{"X-User-ID": "user-S"})
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestE2E_BearerAuthentication(t *testing.T) {
+	server := getTestServer(t)
+
+	bearer := func(t *testing.T, sub string, exp time.Time) map[string]string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": sub, "exp": exp.Unix()}).
+			SignedString([]byte(testJWTSecret))
+		require.NoError(t, err)
+		return map[string]string{"Authorization": "Bearer " + token}
+	}
+
+	rec := server.Request("POST", "/api/v1/events", map[string]interface{}{
+		"name":        "認証テスト",
+		"venue":       "テスト会場",
+		"start_at":    time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+		"end_at":      time.Now().Add(26 * time.Hour).Format(time.RFC3339),
+		"total_seats": 1,
+	}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var eventResp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &eventResp)
+	eventID := eventResp["id"].(string)
+	openEventSales(t, server, eventID)
+
+	rec = server.Request("POST", fmt.Sprintf("/api/v1/events/%s/seats", eventID),
+		map[string]interface{}{"seat_number": "A1", "price": 5000}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var seatResp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &seatResp)
+	seatID := seatResp["id"].(string)
+
+	body := map[string]interface{}{
+		"event_id":        eventID,
+		"seat_ids":        []string{seatID},
+		"idempotency_key": "bearer-auth-flow",
+	}
+
+	t.Run("期限切れのトークンは401", func(t *testing.T) {
+		rec := server.Request("POST", "/api/v1/reservations", body, bearer(t, "user-jwt", time.Now().Add(-time.Minute)))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("トークンの subject で予約する", func(t *testing.T) {
+		headers := bearer(t, "user-jwt", time.Now().Add(time.Hour))
+		// トークンがある場合 X-User-ID は使われない
+		headers["X-User-ID"] = "someone-else"
+
+		rec := server.Request("POST", "/api/v1/reservations", body, headers)
+		require.Equal(t, http.StatusCreated, rec.Code)
+		var res map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.Equal(t, "user-jwt", res["user_id"])
+
+		rec = server.Request("GET", "/api/v1/reservations", nil, bearer(t, "user-jwt", time.Now().Add(time.Hour)))
+		require.Equal(t, http.StatusOK, rec.Code)
+		var list struct {
+			Data []map[string]interface{} `json:"data"`
+		}
+		json.Unmarshal(rec.Body.Bytes(), &list)
+		require.Len(t, list.Data, 1)
+		assert.Equal(t, res["id"], list.Data[0]["id"])
+	})
+}
+
+func TestE2E_ReservationOwnership(t *testing.T) {
+	server := getTestServer(t)
+
+	rec := server.Request("POST", "/api/v1/events", map[string]interface{}{
+		"name":        "所有者チェックテスト",
+		"venue":       "テスト会場",
+		"start_at":    time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+		"end_at":      time.Now().Add(26 * time.Hour).Format(time.RFC3339),
+		"total_seats": 1,
+	}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var eventResp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &eventResp)
+	eventID := eventResp["id"].(string)
+	openEventSales(t, server, eventID)
+
+	rec = server.Request("POST", fmt.Sprintf("/api/v1/events/%s/seats", eventID),
+		map[string]interface{}{"seat_number": "A1", "price": 5000}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var seatResp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &seatResp)
+
+	rec = server.Request("POST", "/api/v1/reservations", map[string]interface{}{
+		"event_id":        eventID,
+		"seat_ids":        []string{seatResp["id"].(string)},
+		"idempotency_key": "ownership-flow",
+	}, map[string]string{"X-User-ID": "user-owner"})
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var resResp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &resResp)
+	reservationID := resResp["id"].(string)
+	path := "/api/v1/reservations/" + reservationID
+
+	t.Run("認証なしは401", func(t *testing.T) {
+		rec := server.Request("GET", path, nil, nil)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("他のユーザーには存在しない予約として404を返す", func(t *testing.T) {
+		other := map[string]string{"X-User-ID": "user-other"}
+
+		rec := server.Request("GET", path, nil, other)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		rec = server.Request("POST", path+"/confirm", map[string]interface{}{"payment_token": "tok_visa"}, other)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		rec = server.Request("POST", path+"/cancel", nil, other)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		rec = server.Request("GET", path, nil, map[string]string{"X-User-ID": "user-owner"})
+		require.Equal(t, http.StatusOK, rec.Code)
+		var res map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.Equal(t, "pending", res["status"])
+	})
+
+	t.Run("管理者は他のユーザーの予約を参照・キャンセルできる", func(t *testing.T) {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"sub": "admin-1", "roles": []string{"admin"}, "exp": time.Now().Add(time.Hour).Unix(),
+		}).SignedString([]byte(testJWTSecret))
+		require.NoError(t, err)
+		admin := map[string]string{"Authorization": "Bearer " + token}
+
+		rec := server.Request("GET", path, nil, admin)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rec = server.Request("POST", path+"/cancel", nil, admin)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var res map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.Equal(t, "cancelled", res["status"])
+		assert.Equal(t, "user-owner", res["user_id"])
+	})
+}
+
+func TestE2E_EventAdministration(t *testing.T) {
+	server := getTestServer(t)
+	eventBody := map[string]interface{}{
+		"name":        "主催者権限テスト",
+		"venue":       "テスト会場",
+		"start_at":    time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+		"end_at":      time.Now().Add(26 * time.Hour).Format(time.RFC3339),
+		"total_seats": 10,
+	}
+	otherOrganizer := map[string]string{"X-User-ID": "e2e-organizer-2", "X-User-Roles": "organizer"}
+	customer := map[string]string{"X-User-ID": "e2e-customer"}
+
+	t.Run("一般ユーザーや未認証ではイベントを作成できない", func(t *testing.T) {
+		rec := server.Request("POST", "/api/v1/events", eventBody, customer)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		rec = server.Request("POST", "/api/v1/events", eventBody, nil)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	rec := server.Request("POST", "/api/v1/events", eventBody, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var eventResp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &eventResp)
+	eventID := eventResp["id"].(string)
+	openEventSales(t, server, eventID)
+	eventPath := "/api/v1/events/" + eventID
+
+	t.Run("他の主催者は編集・座席追加・予約一覧の閲覧ができない", func(t *testing.T) {
+		rec := server.Request("PUT", eventPath, eventBody, otherOrganizer)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		rec = server.Request("POST", eventPath+"/seats", map[string]interface{}{"seat_number": "A1", "price": 5000}, otherOrganizer)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		rec = server.Request("GET", eventPath+"/reservations", nil, customer)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		rec = server.Request("DELETE", eventPath, nil, otherOrganizer)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("主催者と管理者はイベントの予約一覧を閲覧できる", func(t *testing.T) {
+		rec := server.Request("POST", eventPath+"/seats", map[string]interface{}{"seat_number": "A1", "price": 5000}, organizerHeaders)
+		require.Equal(t, http.StatusCreated, rec.Code)
+		var seatResp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &seatResp)
+
+		rec = server.Request("POST", "/api/v1/reservations", map[string]interface{}{
+			"event_id":        eventID,
+			"seat_ids":        []string{seatResp["id"].(string)},
+			"idempotency_key": "event-admin-flow",
+		}, customer)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		for _, headers := range []map[string]string{
+			organizerHeaders,
+			{"X-User-ID": "e2e-admin", "X-User-Roles": "admin"},
+		} {
+			rec = server.Request("GET", eventPath+"/reservations", nil, headers)
+			require.Equal(t, http.StatusOK, rec.Code)
+			var list []map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &list)
+			require.Len(t, list, 1)
+			assert.Equal(t, "e2e-customer", list[0]["user_id"])
+		}
+	})
+}
+
+// TestE2E_PurchaseLimits は購入枚数の上限（1回あたり・1人あたり）をテスト
+func TestE2E_PurchaseLimits(t *testing.T) {
+	server := getTestServer(t)
+
+	eventBody := map[string]interface{}{
+		"name":                      "購入上限テストイベント",
+		"venue":                     "テスト会場",
+		"start_at":                  time.Now().Add(7 * 24 * time.Hour).Format(time.RFC3339),
+		"end_at":                    time.Now().Add(7*24*time.Hour + 2*time.Hour).Format(time.RFC3339),
+		"total_seats":               6,
+		"max_seats_per_reservation": 2,
+		"max_seats_per_user":        3,
+	}
+	rec := server.Request("POST", "/api/v1/events", eventBody, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var eventResp map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &eventResp)
+	eventID := eventResp["id"].(string)
+	openEventSales(t, server, eventID)
+	assert.Equal(t, float64(2), eventResp["max_seats_per_reservation"])
+	assert.Equal(t, float64(3), eventResp["max_seats_per_user"])
+
+	seatBody := map[string]interface{}{"prefix": "L", "count": 6, "price": 5000}
+	rec = server.Request("POST", fmt.Sprintf("/api/v1/events/%s/seats/bulk", eventID), seatBody, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var seatsResp []map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &seatsResp)
+	seatIDs := make([]string, len(seatsResp))
+	for i, se := range seatsResp {
+		seatIDs[i] = se["id"].(string)
+	}
+	user := map[string]string{"X-User-ID": "limit-user"}
+
+	t.Run("1回の予約の上限を超えると409", func(t *testing.T) {
+		body := map[string]interface{}{
+			"event_id": eventID, "seat_ids": seatIDs[:3], "idempotency_key": "limit-per-reservation",
+		}
+		rec := server.Request("POST", "/api/v1/reservations", body, user)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("1人あたりの上限まで予約できる", func(t *testing.T) {
+		body := map[string]interface{}{
+			"event_id": eventID, "seat_ids": seatIDs[:2], "idempotency_key": "limit-first",
+		}
+		rec := server.Request("POST", "/api/v1/reservations", body, user)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		body = map[string]interface{}{
+			"event_id": eventID, "seat_ids": seatIDs[2:3], "idempotency_key": "limit-second",
+		}
+		rec = server.Request("POST", "/api/v1/reservations", body, user)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+
+	t.Run("1人あたりの上限を超えると409", func(t *testing.T) {
+		body := map[string]interface{}{
+			"event_id": eventID, "seat_ids": seatIDs[3:4], "idempotency_key": "limit-third",
+		}
+		rec := server.Request("POST", "/api/v1/reservations", body, user)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("他のユーザーは影響を受けない", func(t *testing.T) {
+		body := map[string]interface{}{
+			"event_id": eventID, "seat_ids": seatIDs[3:5], "idempotency_key": "limit-other-user",
+		}
+		rec := server.Request("POST", "/api/v1/reservations", body, map[string]string{"X-User-ID": "limit-other"})
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+}
+
+func TestE2E_EventSearch(t *testing.T) {
+	server := getTestServer(t)
+
+	// 他のテストのイベントと区別するための語
+	marker := fmt.Sprintf("srch%d", time.Now().UnixNano())
+	createEvent := func(name, description string, startAt time.Time) string {
+		rec := server.Request("POST", "/api/v1/events", map[string]interface{}{
+			"name":        name,
+			"description": description,
+			"venue":       "検索テスト会場",
+			"start_at":    startAt.Format(time.RFC3339),
+			"end_at":      startAt.Add(2 * time.Hour).Format(time.RFC3339),
+			"total_seats": 2,
+		}, organizerHeaders)
+		require.Equal(t, http.StatusCreated, rec.Code)
+		var resp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		return resp["id"].(string)
+	}
+	upcomingID := createEvent("夏の花火大会", "隅田川の花火を<b>特等席</b>で "+marker, time.Now().Add(7*24*time.Hour))
+	pastID := createEvent("冬の花火大会", marker, time.Now().Add(-7*24*time.Hour))
+	draftID := createEvent("秋の花火大会", marker, time.Now().Add(14*24*time.Hour))
+	publishEvent(t, server, upcomingID)
+	publishEvent(t, server, pastID)
+	rec := server.Request("POST", fmt.Sprintf("/api/v1/events/%s/seats/bulk", upcomingID),
+		map[string]interface{}{"prefix": "S", "count": 2, "price": 3000}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	search := func(query string) []map[string]interface{} {
+		rec := server.Request("GET", "/api/v1/events/search?"+query, nil, nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var resp struct {
+			Data []map[string]interface{} `json:"data"`
+		}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		return resp.Data
+	}
+	ids := func(results []map[string]interface{}) []string {
+		var ids []string
+		for _, r := range results {
+			ids = append(ids, r["event"].(map[string]interface{})["id"].(string))
+		}
+		return ids
+	}
+
+	t.Run("英数字の語で全文検索し一致箇所を強調する", func(t *testing.T) {
+		results := search("q=" + marker)
+		assert.ElementsMatch(t, []string{upcomingID, pastID}, ids(results))
+		assert.Contains(t, results[0]["snippet"], "<mark>"+marker+"</mark>")
+	})
+
+	t.Run("日本語の語は部分一致で検索する", func(t *testing.T) {
+		results := search("q=" + url.QueryEscape("花火 "+marker))
+		assert.ElementsMatch(t, []string{upcomingID, pastID}, ids(results))
+
+		results = search("q=" + url.QueryEscape("特等席 "+marker))
+		require.Equal(t, []string{upcomingID}, ids(results))
+		// 説明の HTML はエスケープする
+		assert.Contains(t, results[0]["snippet"], "&lt;b&gt;<mark>特等席</mark>&lt;/b&gt;")
+	})
+
+	t.Run("開始前・空席ありで絞り込む", func(t *testing.T) {
+		assert.Equal(t, []string{upcomingID}, ids(search("q="+marker+"&upcoming=true")))
+		assert.Equal(t, []string{upcomingID}, ids(search("q="+marker+"&available=true")))
+	})
+
+	t.Run("下書きのイベントは検索結果に含めない", func(t *testing.T) {
+		assert.NotContains(t, ids(search("q="+marker)), draftID)
+	})
+
+	t.Run("キーワードがない場合400", func(t *testing.T) {
+		rec := server.Request("GET", "/api/v1/events/search", nil, nil)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestE2E_EventLifecycle(t *testing.T) {
+	server := getTestServer(t)
+
+	name := fmt.Sprintf("ライフサイクル%d", time.Now().UnixNano())
+	rec := server.Request("POST", "/api/v1/events", map[string]interface{}{
+		"name":        name,
+		"venue":       "テスト会場",
+		"start_at":    time.Now().Add(7 * 24 * time.Hour).Format(time.RFC3339),
+		"end_at":      time.Now().Add(7*24*time.Hour + 2*time.Hour).Format(time.RFC3339),
+		"total_seats": 1,
+	}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var ev map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &ev)
+	eventID := ev["id"].(string)
+	eventPath := "/api/v1/events/" + eventID
+	assert.Equal(t, "draft", ev["status"])
+	assert.Equal(t, false, ev["booking_open"])
+
+	rec = server.Request("POST", eventPath+"/seats", map[string]interface{}{"seat_number": "A1", "price": 5000}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var st map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &st)
+	reserveBody := map[string]interface{}{"event_id": eventID, "seat_ids": []string{st["id"].(string)}}
+	customer := map[string]string{"X-User-ID": "lifecycle-user"}
+
+	listed := func() bool {
+		rec := server.Request("GET", "/api/v1/events?q="+url.QueryEscape(name), nil, nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var resp struct {
+			Data []map[string]interface{} `json:"data"`
+		}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		return len(resp.Data) == 1
+	}
+
+	t.Run("下書きは一覧に表示されず予約できない", func(t *testing.T) {
+		assert.False(t, listed())
+		rec := server.Request("POST", "/api/v1/reservations", reserveBody, customer)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("販売開始前の状態からは販売を終了できない", func(t *testing.T) {
+		rec := server.Request("POST", eventPath+"/close", nil, organizerHeaders)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("主催者以外は状態を変更できない", func(t *testing.T) {
+		rec := server.Request("POST", eventPath+"/publish", nil, customer)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("公開すると一覧に表示される", func(t *testing.T) {
+		publishEvent(t, server, eventID)
+		assert.True(t, listed())
+	})
+
+	t.Run("販売中のみ予約できる", func(t *testing.T) {
+		rec := server.Request("POST", eventPath+"/open", nil, organizerHeaders)
+		require.Equal(t, http.StatusOK, rec.Code)
+		json.Unmarshal(rec.Body.Bytes(), &ev)
+		assert.Equal(t, "on_sale", ev["status"])
+		assert.Equal(t, true, ev["booking_open"])
+
+		rec = server.Request("POST", eventPath+"/close", nil, organizerHeaders)
+		require.Equal(t, http.StatusOK, rec.Code)
+		rec = server.Request("POST", "/api/v1/reservations", reserveBody, customer)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		rec = server.Request("POST", eventPath+"/open", nil, organizerHeaders)
+		require.Equal(t, http.StatusOK, rec.Code)
+		rec = server.Request("POST", "/api/v1/reservations", reserveBody, customer)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+
+	t.Run("中止したイベントは再開できない", func(t *testing.T) {
+		rec := server.Request("POST", eventPath+"/cancel", nil, organizerHeaders)
+		require.Equal(t, http.StatusAccepted, rec.Code)
+
+		rec = server.Request("GET", eventPath, nil, nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		json.Unmarshal(rec.Body.Bytes(), &ev)
+		assert.Equal(t, "cancelled", ev["status"])
+
+		rec = server.Request("POST", eventPath+"/open", nil, organizerHeaders)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
+
+func TestE2E_EventCancellation(t *testing.T) {
+	server := getTestServer(t)
+
+	rec := server.Request("POST", "/api/v1/events", map[string]interface{}{
+		"name":        "中止テスト",
+		"venue":       "テスト会場",
+		"start_at":    time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+		"end_at":      time.Now().Add(26 * time.Hour).Format(time.RFC3339),
+		"total_seats": 5,
+	}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var ev map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &ev)
+	eventID := ev["id"].(string)
+	eventPath := "/api/v1/events/" + eventID
+	openEventSales(t, server, eventID)
+
+	rec = server.Request("POST", eventPath+"/seats/bulk",
+		map[string]interface{}{"prefix": "C", "count": 5, "price": 3000}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var seatsResp []map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &seatsResp)
+
+	// 保留中の予約2件と確定済みの予約1件を作成（バッチサイズ2のため複数バッチで処理される）
+	reserve := func(user string, seatIDs ...string) string {
+		rec := server.Request("POST", "/api/v1/reservations", map[string]interface{}{
+			"event_id":        eventID,
+			"seat_ids":        seatIDs,
+			"idempotency_key": "event-cancel-" + user,
+		}, map[string]string{"X-User-ID": user})
+		require.Equal(t, http.StatusCreated, rec.Code)
+		var res map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &res)
+		return res["id"].(string)
+	}
+	pending1 := reserve("cancel-user-1", seatsResp[0]["id"].(string))
+	pending2 := reserve("cancel-user-2", seatsResp[1]["id"].(string), seatsResp[2]["id"].(string))
+	confirmed := reserve("cancel-user-3", seatsResp[3]["id"].(string))
+	rec = server.Request("POST", fmt.Sprintf("/api/v1/reservations/%s/confirm", confirmed),
+		map[string]interface{}{"payment_token": "tok_visa"}, map[string]string{"X-User-ID": "cancel-user-3"})
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	t.Run("予約が残っているイベントは削除できない", func(t *testing.T) {
+		rec := server.Request("DELETE", eventPath, nil, organizerHeaders)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Contains(t, rec.Body.String(), "EVENT_HAS_ACTIVE_RESERVATIONS")
+	})
+
+	t.Run("中止していないイベントの進捗は404", func(t *testing.T) {
+		rec := server.Request("GET", eventPath+"/cancellation", nil, organizerHeaders)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("主催者以外は中止できない", func(t *testing.T) {
+		rec := server.Request("POST", eventPath+"/cancel", nil, map[string]string{"X-User-ID": "cancel-user-1"})
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("中止すると202で進捗を返す", func(t *testing.T) {
+		rec := server.Request("POST", eventPath+"/cancel", nil, organizerHeaders)
+		require.Equal(t, http.StatusAccepted, rec.Code)
+		var progress map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &progress)
+		assert.Equal(t, "running", progress["status"])
+		assert.Equal(t, float64(3), progress["total_reservations"])
+		assert.Equal(t, float64(0), progress["processed_reservations"])
+
+		// 再度中止しても同じ中止処理の進捗を返す
+		rec = server.Request("POST", eventPath+"/cancel", nil, organizerHeaders)
+		require.Equal(t, http.StatusAccepted, rec.Code)
+	})
+
+	t.Run("中止処理で予約を取り消して座席を解放する", func(t *testing.T) {
+		n, err := cancellationService.ProcessPending(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+
+		rec := server.Request("GET", eventPath+"/cancellation", nil, organizerHeaders)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var progress map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &progress)
+		assert.Equal(t, "completed", progress["status"])
+		assert.Equal(t, float64(3), progress["processed_reservations"])
+		assert.Equal(t, float64(2), progress["cancelled_reservations"])
+		assert.Equal(t, float64(1), progress["refund_pending_reservations"])
+		assert.Equal(t, float64(4), progress["released_seats"])
+		assert.NotNil(t, progress["completed_at"])
+
+		status := func(id, user string) string {
+			rec := server.Request("GET", "/api/v1/reservations/"+id, nil, map[string]string{"X-User-ID": user})
+			require.Equal(t, http.StatusOK, rec.Code)
+			var res map[string]interface{}
+			json.Unmarshal(rec.Body.Bytes(), &res)
+			return res["status"].(string)
+		}
+		assert.Equal(t, "cancelled", status(pending1, "cancel-user-1"))
+		assert.Equal(t, "cancelled", status(pending2, "cancel-user-2"))
+		assert.Equal(t, "refund_pending", status(confirmed, "cancel-user-3"))
+
+		rec = server.Request("GET", eventPath+"/seats/available/count", nil, nil)
+		var countResp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &countResp)
+		assert.Equal(t, float64(5), countResp["count"])
+	})
+
+	t.Run("返金待ちの予約は全額返金できる", func(t *testing.T) {
+		rec := server.Request("POST", fmt.Sprintf("/api/v1/reservations/%s/refund", confirmed), nil, map[string]string{"X-User-ID": "cancel-user-3"})
+		require.Equal(t, http.StatusOK, rec.Code)
+		var res map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &res)
+		assert.Equal(t, "refunded", res["status"])
+		assert.Equal(t, float64(3000), res["refunded_amount"])
+	})
+}
+
+// TestE2E_AuditLog は論理削除と監査ログの記録をテスト
+func TestE2E_AuditLog(t *testing.T) {
+	server := getTestServer(t)
+	supportHeaders := map[string]string{"X-User-ID": "e2e-support", "X-User-Roles": "support"}
+
+	rec := server.Request("POST", "/api/v1/events", map[string]interface{}{
+		"name":        "監査ログテスト",
+		"venue":       "テスト会場",
+		"start_at":    time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+		"end_at":      time.Now().Add(26 * time.Hour).Format(time.RFC3339),
+		"total_seats": 10,
+	}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var ev map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &ev)
+	eventID := ev["id"].(string)
+	eventPath := "/api/v1/events/" + eventID
+
+	rec = server.Request("PUT", eventPath, map[string]interface{}{
+		"name":        "監査ログテスト（更新）",
+		"venue":       "テスト会場",
+		"start_at":    time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+		"end_at":      time.Now().Add(26 * time.Hour).Format(time.RFC3339),
+		"total_seats": 10,
+	}, organizerHeaders)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = server.Request("DELETE", eventPath, nil, organizerHeaders)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	t.Run("削除したイベントは取得できない", func(t *testing.T) {
+		rec := server.Request("GET", eventPath, nil, nil)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("削除した行は残る", func(t *testing.T) {
+		var deleted bool
+		require.NoError(t, testDB.Get(&deleted, "SELECT deleted_at IS NOT NULL FROM events WHERE id = $1", eventID))
+		assert.True(t, deleted)
+	})
+
+	t.Run("サポート担当者は変更履歴を新しい順に取得できる", func(t *testing.T) {
+		rec := server.Request("GET", "/api/v1/audit?entity=event&id="+eventID, nil, supportHeaders)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var resp struct {
+			Data []struct {
+				Action  string                 `json:"action"`
+				ActorID string                 `json:"actor_id"`
+				Before  map[string]interface{} `json:"before"`
+				After   map[string]interface{} `json:"after"`
+			} `json:"data"`
+		}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		require.Len(t, resp.Data, 3)
+		assert.Equal(t, "delete", resp.Data[0].Action)
+		assert.NotNil(t, resp.Data[0].After["deleted_at"])
+		assert.Equal(t, "update", resp.Data[1].Action)
+		assert.Equal(t, "監査ログテスト", resp.Data[1].Before["name"])
+		assert.Equal(t, "監査ログテスト（更新）", resp.Data[1].After["name"])
+		assert.Equal(t, "create", resp.Data[2].Action)
+		assert.Nil(t, resp.Data[2].Before)
+		for _, entry := range resp.Data {
+			assert.Equal(t, "e2e-organizer", entry.ActorID)
+		}
+	})
+
+	t.Run("サポート担当者以外は取得できない", func(t *testing.T) {
+		rec := server.Request("GET", "/api/v1/audit?entity=event&id="+eventID, nil, organizerHeaders)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("監査ログは変更できない", func(t *testing.T) {
+		_, err := testDB.Exec("DELETE FROM audit_logs WHERE entity_id = $1", eventID)
+		assert.Error(t, err)
+	})
+}
+
+// TestE2E_EventETag は ETag / If-Match によるイベント更新の楽観的ロックをテスト
+func TestE2E_EventETag(t *testing.T) {
+	server := getTestServer(t)
+
+	eventBody := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":        name,
+			"venue":       "テスト会場",
+			"start_at":    time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+			"end_at":      time.Now().Add(26 * time.Hour).Format(time.RFC3339),
+			"total_seats": 10,
+		}
+	}
+	rec := server.Request("POST", "/api/v1/events", eventBody("ETagテスト"), organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var ev map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &ev)
+	eventPath := "/api/v1/events/" + ev["id"].(string)
+
+	rec = server.Request("GET", eventPath, nil, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	withIfMatch := func(etag string) map[string]string {
+		return map[string]string{"X-User-ID": "e2e-organizer", "X-User-Roles": "organizer", "If-Match": etag}
+	}
+
+	// 主催者Aが取得した ETag で更新すると、新しい ETag が返る
+	rec = server.Request("PUT", eventPath, eventBody("主催者Aの更新"), withIfMatch(etag))
+	require.Equal(t, http.StatusOK, rec.Code)
+	newETag := rec.Header().Get("ETag")
+	assert.NotEqual(t, etag, newETag)
+
+	t.Run("古い ETag での更新は412で上書きしない", func(t *testing.T) {
+		rec := server.Request("PUT", eventPath, eventBody("主催者Bの更新"), withIfMatch(etag))
+		require.Equal(t, http.StatusPreconditionFailed, rec.Code)
+		var errResp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &errResp)
+		assert.Equal(t, "EVENT_VERSION_MISMATCH", errResp["error_code"])
+
+		rec = server.Request("GET", eventPath, nil, nil)
+		var got map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &got)
+		assert.Equal(t, "主催者Aの更新", got["name"])
+		assert.Equal(t, newETag, rec.Header().Get("ETag"))
+	})
+
+	t.Run("If-Match なしの更新は受け付ける（strict モード以外）", func(t *testing.T) {
+		rec := server.Request("PUT", eventPath, eventBody("If-Matchなしの更新"), organizerHeaders)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+// TestE2E_EventPatch は JSON Merge Patch によるイベントの部分更新をテスト
+func TestE2E_EventPatch(t *testing.T) {
+	server := getTestServer(t)
+	patchHeaders := map[string]string{
+		"X-User-ID": "e2e-organizer", "X-User-Roles": "organizer", "Content-Type": "application/merge-patch+json",
+	}
+
+	rec := server.Request("POST", "/api/v1/events", map[string]interface{}{
+		"name":                      "部分更新テスト",
+		"description":               "説明",
+		"venue":                     "テスト会場",
+		"start_at":                  time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+		"end_at":                    time.Now().Add(26 * time.Hour).Format(time.RFC3339),
+		"total_seats":               10,
+		"max_seats_per_reservation": 4,
+	}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var created map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &created)
+	eventPath := "/api/v1/events/" + created["id"].(string)
+
+	rec = server.Request("POST", eventPath+"/seats/bulk",
+		map[string]interface{}{"prefix": "P", "count": 8, "price": 1000}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	t.Run("指定した項目だけが変わる", func(t *testing.T) {
+		rec := server.Request("PATCH", eventPath, map[string]interface{}{"name": "部分更新テスト（改）"}, patchHeaders)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var got map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &got)
+		assert.Equal(t, "部分更新テスト（改）", got["name"])
+		assert.Equal(t, "説明", got["description"])
+		assert.Equal(t, "テスト会場", got["venue"])
+		assert.Equal(t, float64(10), got["total_seats"])
+		assert.Equal(t, float64(4), got["max_seats_per_reservation"])
+	})
+
+	t.Run("null を指定した項目は削除される", func(t *testing.T) {
+		rec := server.RequestRaw("PATCH", eventPath, []byte(`{"description": null}`), patchHeaders)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var got map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &got)
+		assert.Empty(t, got["description"])
+		assert.Equal(t, "部分更新テスト（改）", got["name"])
+	})
+
+	t.Run("座席数を作成済みの座席数より少なくできない", func(t *testing.T) {
+		rec := server.Request("PATCH", eventPath, map[string]interface{}{"total_seats": 7}, patchHeaders)
+		require.Equal(t, http.StatusConflict, rec.Code)
+		var errResp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &errResp)
+		assert.Equal(t, "TOTAL_SEATS_BELOW_CREATED_SEATS", errResp["error_code"])
+
+		rec = server.Request("PATCH", eventPath, map[string]interface{}{"total_seats": 8}, patchHeaders)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("変更後のイベントが不正な場合は400", func(t *testing.T) {
+		rec := server.Request("PATCH", eventPath, map[string]interface{}{
+			"end_at": time.Now().Format(time.RFC3339),
+		}, patchHeaders)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+// TestE2E_SeatCapacity は総座席数を超える座席の作成の拒否と、座席数の集計・突き合わせをテスト
+func TestE2E_SeatCapacity(t *testing.T) {
+	server := getTestServer(t)
+
+	rec := server.Request("POST", "/api/v1/events", map[string]interface{}{
+		"name":        "座席数テスト",
+		"venue":       "テスト会場",
+		"start_at":    time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+		"end_at":      time.Now().Add(26 * time.Hour).Format(time.RFC3339),
+		"total_seats": 5,
+	}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+	var created map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &created)
+	eventID := created["id"].(string)
+	eventPath := "/api/v1/events/" + eventID
+
+	rec = server.Request("POST", eventPath+"/seats/bulk",
+		map[string]interface{}{"prefix": "C", "count": 4, "price": 3000}, organizerHeaders)
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	t.Run("総座席数を超える座席は作成できない", func(t *testing.T) {
+		rec := server.Request("POST", eventPath+"/seats/bulk",
+			map[string]interface{}{"prefix": "D", "count": 2, "price": 3000}, organizerHeaders)
+		require.Equal(t, http.StatusConflict, rec.Code)
+		var errResp map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &errResp)
+		assert.Equal(t, "SEAT_CAPACITY_EXCEEDED", errResp["error_code"])
+
+		rec = server.Request("POST", eventPath+"/seats", map[string]interface{}{"seat_number": "D1", "price": 3000}, organizerHeaders)
+		require.Equal(t, http.StatusCreated, rec.Code)
+		rec = server.Request("POST", eventPath+"/seats", map[string]interface{}{"seat_number": "D2", "price": 3000}, organizerHeaders)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("イベント詳細に座席の状態ごとの数が含まれる", func(t *testing.T) {
+		rec := server.Request("GET", eventPath, nil, nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var got struct {
+			TotalSeats int `json:"total_seats"`
+			Seats      struct {
+				Total, Available, Reserved, Confirmed int
+			} `json:"seats"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+		assert.Equal(t, 5, got.TotalSeats)
+		assert.Equal(t, 5, got.Seats.Total)
+		assert.Equal(t, 5, got.Seats.Available)
+		assert.Equal(t, 0, got.Seats.Reserved)
+		assert.Equal(t, 0, got.Seats.Confirmed)
+	})
+
+	t.Run("突き合わせで総座席数のずれを修正できる", func(t *testing.T) {
+		// 上限チェック導入前に作られたデータを再現する
+		_, err := testDB.Exec(`UPDATE events SET total_seats = 3 WHERE id = $1`, eventID)
+		require.NoError(t, err)
+
+		results, err := seatService.ReconcileTotalSeats(context.Background(), false)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, 2, results[0].Excess())
+		assert.False(t, results[0].Fixed)
+
+		results, err = seatService.ReconcileTotalSeats(context.Background(), true)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Fixed)
+
+		rec := server.Request("GET", eventPath, nil, nil)
+		var got map[string]interface{}
+		json.Unmarshal(rec.Body.Bytes(), &got)
+		assert.Equal(t, float64(5), got["total_seats"])
+
+		results, err = seatService.ReconcileTotalSeats(context.Background(), false)
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+}
@@ -0,0 +1,302 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/audit"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pagination"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/payment"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pricecategory"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/queue"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/reservation"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/waitlist"
+)
+
+// ErrorCode はクライアントがエラーを判別するための安定したコード
+// メッセージは変わり得るため、クライアントはこのコードで分岐する
+type ErrorCode string
+
+// 汎用のエラーコード（ドメインエラーに対応しない場合に HTTP ステータスから決める）
+const (
+	CodeInvalidRequest       ErrorCode = "INVALID_REQUEST"
+	CodeValidationFailed     ErrorCode = "VALIDATION_FAILED"
+	CodeUnauthenticated      ErrorCode = "UNAUTHENTICATED"
+	CodePermissionDenied     ErrorCode = "PERMISSION_DENIED"
+	CodeNotFound             ErrorCode = "NOT_FOUND"
+	CodeMethodNotAllowed     ErrorCode = "METHOD_NOT_ALLOWED"
+	CodeConflict             ErrorCode = "CONFLICT"
+	CodeRateLimited          ErrorCode = "RATE_LIMITED"
+	CodeInternal             ErrorCode = "INTERNAL_ERROR"
+	CodeServiceUnavailable   ErrorCode = "SERVICE_UNAVAILABLE"
+	CodeGatewayTimeout       ErrorCode = "GATEWAY_TIMEOUT"
+	CodeInvalidCursor        ErrorCode = "INVALID_CURSOR"
+	CodePreconditionFailed   ErrorCode = "PRECONDITION_FAILED"
+	CodePreconditionRequired ErrorCode = "PRECONDITION_REQUIRED"
+)
+
+// ドメインエラーに対応するエラーコード
+const (
+	// イベント
+	CodeEventNotFound          ErrorCode = "EVENT_NOT_FOUND"
+	CodeEventNameRequired      ErrorCode = "EVENT_NAME_REQUIRED"
+	CodeInvalidTotalSeats      ErrorCode = "INVALID_TOTAL_SEATS"
+	CodeInvalidEventTime       ErrorCode = "INVALID_EVENT_TIME"
+	CodeEventNotOpen           ErrorCode = "EVENT_NOT_OPEN"
+	CodeOptimisticLockConflict ErrorCode = "OPTIMISTIC_LOCK_CONFLICT"
+	CodeInvalidHoldPolicy      ErrorCode = "INVALID_HOLD_POLICY"
+	CodeInvalidRefundPolicy    ErrorCode = "INVALID_REFUND_POLICY"
+	CodeInvalidPurchaseLimit   ErrorCode = "INVALID_PURCHASE_LIMIT"
+	CodePurchaseLimitExceeded  ErrorCode = "PURCHASE_LIMIT_EXCEEDED"
+	CodeEventIDRequired        ErrorCode = "EVENT_ID_REQUIRED"
+	CodeInvalidSearchQuery     ErrorCode = "INVALID_SEARCH_QUERY"
+	CodeEventInvalidTransition ErrorCode = "EVENT_INVALID_STATUS_TRANSITION"
+	CodeInvalidSalesPeriod     ErrorCode = "INVALID_SALES_PERIOD"
+	CodeCancellationNotFound   ErrorCode = "EVENT_CANCELLATION_NOT_FOUND"
+	CodeEventVersionMismatch   ErrorCode = "EVENT_VERSION_MISMATCH"
+	CodeTotalSeatsBelowCreated ErrorCode = "TOTAL_SEATS_BELOW_CREATED_SEATS"
+	CodeEventHasReservations   ErrorCode = "EVENT_HAS_ACTIVE_RESERVATIONS"
+	CodeEventCancelled         ErrorCode = "EVENT_CANCELLED"
+
+	// 座席
+	CodeSeatNotFound          ErrorCode = "SEAT_NOT_FOUND"
+	CodeSeatNotAvailable      ErrorCode = "SEAT_NOT_AVAILABLE"
+	CodeSeatNotReserved       ErrorCode = "SEAT_NOT_RESERVED"
+	CodeSeatAlreadyReserved   ErrorCode = "SEAT_ALREADY_RESERVED"
+	CodeSeatNumberRequired    ErrorCode = "SEAT_NUMBER_REQUIRED"
+	CodeInvalidPrice          ErrorCode = "INVALID_PRICE"
+	CodeEmptyLayout           ErrorCode = "EMPTY_LAYOUT"
+	CodeInvalidLayout         ErrorCode = "INVALID_LAYOUT"
+	CodeLayoutExceedsCapacity ErrorCode = "LAYOUT_EXCEEDS_CAPACITY"
+	CodeSeatCapacityExceeded  ErrorCode = "SEAT_CAPACITY_EXCEEDED"
+	CodeInvalidQuantity       ErrorCode = "INVALID_QUANTITY"
+	CodeInsufficientSeats     ErrorCode = "INSUFFICIENT_SEATS"
+	CodeNoAdjacentSeats       ErrorCode = "NO_ADJACENT_SEATS"
+	CodeLockContention        ErrorCode = "LOCK_CONTENTION"
+	CodeLockUnavailable       ErrorCode = "LOCK_UNAVAILABLE"
+
+	// 予約
+	CodeReservationNotFound         ErrorCode = "RESERVATION_NOT_FOUND"
+	CodeReservationNotPending       ErrorCode = "RESERVATION_NOT_PENDING"
+	CodeReservationExpired          ErrorCode = "RESERVATION_EXPIRED"
+	CodeReservationAlreadyCancelled ErrorCode = "RESERVATION_ALREADY_CANCELLED"
+	CodeReservationAlreadyConfirmed ErrorCode = "RESERVATION_ALREADY_CONFIRMED"
+	CodeReservationNotConfirmed     ErrorCode = "RESERVATION_NOT_CONFIRMED"
+	CodeReservationAlreadyRefunded  ErrorCode = "RESERVATION_ALREADY_REFUNDED"
+	CodeUserIDRequired              ErrorCode = "USER_ID_REQUIRED"
+	CodeSeatIDsRequired             ErrorCode = "SEAT_IDS_REQUIRED"
+	CodeIdempotencyKeyRequired      ErrorCode = "IDEMPOTENCY_KEY_REQUIRED"
+	CodeIdempotencyKeyConflict      ErrorCode = "IDEMPOTENCY_KEY_CONFLICT"
+	CodeHoldExtensionLimitReached   ErrorCode = "HOLD_EXTENSION_LIMIT_REACHED"
+	CodeHoldMaxDurationReached      ErrorCode = "HOLD_MAX_DURATION_REACHED"
+	CodeRefundPeriodEnded           ErrorCode = "REFUND_PERIOD_ENDED"
+	CodeSeatNotInReservation        ErrorCode = "SEAT_NOT_IN_RESERVATION"
+	CodeCannotRemoveAllSeats        ErrorCode = "CANNOT_REMOVE_ALL_SEATS"
+	CodeInvalidReservationStatus    ErrorCode = "INVALID_RESERVATION_STATUS"
+	CodeReservationRefundPending    ErrorCode = "RESERVATION_REFUND_PENDING"
+
+	// 価格カテゴリ
+	CodePriceCategoryNotFound     ErrorCode = "PRICE_CATEGORY_NOT_FOUND"
+	CodePriceCategoryNameRequired ErrorCode = "PRICE_CATEGORY_NAME_REQUIRED"
+	CodeInvalidCurrency           ErrorCode = "INVALID_CURRENCY"
+	CodeInvalidAmount             ErrorCode = "INVALID_AMOUNT"
+	CodePriceCategoryDuplicate    ErrorCode = "PRICE_CATEGORY_DUPLICATE"
+	CodePriceCategoryInUse        ErrorCode = "PRICE_CATEGORY_IN_USE"
+	CodePriceCategoryMismatch     ErrorCode = "PRICE_CATEGORY_EVENT_MISMATCH"
+	CodeCurrencyMismatch          ErrorCode = "CURRENCY_MISMATCH"
+
+	// 待合室
+	CodeQueueTicketNotFound ErrorCode = "QUEUE_TICKET_NOT_FOUND"
+	CodeQueueNotAdmitted    ErrorCode = "QUEUE_NOT_ADMITTED"
+	CodeWaitingRoomDisabled ErrorCode = "WAITING_ROOM_DISABLED"
+
+	// 順番待ち
+	CodeWaitlistEntryNotFound   ErrorCode = "WAITLIST_ENTRY_NOT_FOUND"
+	CodeWaitlistInvalidQuantity ErrorCode = "WAITLIST_INVALID_QUANTITY"
+	CodeWaitlistAlreadyWaiting  ErrorCode = "WAITLIST_ALREADY_WAITING"
+	CodeWaitlistSeatsAvailable  ErrorCode = "WAITLIST_SEATS_AVAILABLE"
+	CodeWaitlistEntryNotWaiting ErrorCode = "WAITLIST_ENTRY_NOT_WAITING"
+	CodeWaitlistEntryNotOffered ErrorCode = "WAITLIST_ENTRY_NOT_OFFERED"
+	CodeWaitlistEntryClosed     ErrorCode = "WAITLIST_ENTRY_CLOSED"
+
+	// 決済
+	CodePaymentNotFound          ErrorCode = "PAYMENT_NOT_FOUND"
+	CodeReservationIDRequired    ErrorCode = "RESERVATION_ID_REQUIRED"
+	CodeInvalidPaymentAmount     ErrorCode = "INVALID_PAYMENT_AMOUNT"
+	CodePaymentMethodRequired    ErrorCode = "PAYMENT_METHOD_REQUIRED"
+	CodePaymentDeclined          ErrorCode = "PAYMENT_DECLINED"
+	CodePaymentTimeout           ErrorCode = "PAYMENT_TIMEOUT"
+	CodePaymentInvalidTransition ErrorCode = "PAYMENT_INVALID_STATUS_TRANSITION"
+	CodeInvalidWebhookSignature  ErrorCode = "INVALID_WEBHOOK_SIGNATURE"
+	CodeUnknownWebhookEvent      ErrorCode = "UNKNOWN_WEBHOOK_EVENT"
+
+	// 監査ログ
+	CodeInvalidAuditEntity    ErrorCode = "INVALID_AUDIT_ENTITY"
+	CodeAuditEntityIDRequired ErrorCode = "AUDIT_ENTITY_ID_REQUIRED"
+)
+
+// errorMapping はドメインエラーと HTTP ステータス・エラーコードの対応
+type errorMapping struct {
+	err    error
+	status int
+	code   ErrorCode
+}
+
+// errorCatalog はドメインエラーのコード表
+// 複数のドメインエラーをラップしている場合は先に一致したものを使うため、より具体的なものを先に並べる
+var errorCatalog = []errorMapping{
+	// 認可
+	{auth.ErrUnauthenticated, http.StatusUnauthorized, CodeUnauthenticated},
+	{auth.ErrPermissionDenied, http.StatusForbidden, CodePermissionDenied},
+
+	// ロック（他のエラーをラップして返されるため先に判定する）
+	{seat.ErrSeatLockContention, http.StatusLocked, CodeLockContention},
+	{seat.ErrSeatLockUnavailable, http.StatusServiceUnavailable, CodeLockUnavailable},
+	{event.ErrOptimisticLockConflict, http.StatusConflict, CodeOptimisticLockConflict},
+	{seat.ErrOptimisticLockConflict, http.StatusConflict, CodeOptimisticLockConflict},
+
+	// ページング
+	{pagination.ErrInvalidCursor, http.StatusBadRequest, CodeInvalidCursor},
+
+	// 決済
+	{payment.ErrPaymentNotFound, http.StatusNotFound, CodePaymentNotFound},
+	{payment.ErrReservationIDRequired, http.StatusBadRequest, CodeReservationIDRequired},
+	{payment.ErrInvalidAmount, http.StatusBadRequest, CodeInvalidPaymentAmount},
+	{payment.ErrPaymentMethodRequired, http.StatusBadRequest, CodePaymentMethodRequired},
+	{payment.ErrPaymentDeclined, http.StatusPaymentRequired, CodePaymentDeclined},
+	{payment.ErrPaymentTimeout, http.StatusGatewayTimeout, CodePaymentTimeout},
+	{payment.ErrInvalidStatusTransition, http.StatusConflict, CodePaymentInvalidTransition},
+	{payment.ErrInvalidWebhookSignature, http.StatusUnauthorized, CodeInvalidWebhookSignature},
+	{payment.ErrUnknownWebhookEvent, http.StatusBadRequest, CodeUnknownWebhookEvent},
+
+	// 監査ログ
+	{audit.ErrInvalidEntityType, http.StatusBadRequest, CodeInvalidAuditEntity},
+	{audit.ErrEntityIDRequired, http.StatusBadRequest, CodeAuditEntityIDRequired},
+
+	// 予約
+	{reservation.ErrReservationNotFound, http.StatusNotFound, CodeReservationNotFound},
+	{reservation.ErrReservationNotPending, http.StatusConflict, CodeReservationNotPending},
+	{reservation.ErrReservationExpired, http.StatusConflict, CodeReservationExpired},
+	{reservation.ErrReservationAlreadyCancelled, http.StatusConflict, CodeReservationAlreadyCancelled},
+	{reservation.ErrReservationAlreadyConfirmed, http.StatusConflict, CodeReservationAlreadyConfirmed},
+	{reservation.ErrReservationNotConfirmed, http.StatusConflict, CodeReservationNotConfirmed},
+	{reservation.ErrReservationAlreadyRefunded, http.StatusConflict, CodeReservationAlreadyRefunded},
+	{reservation.ErrEventIDRequired, http.StatusBadRequest, CodeEventIDRequired},
+	{reservation.ErrUserIDRequired, http.StatusBadRequest, CodeUserIDRequired},
+	{reservation.ErrSeatIDsRequired, http.StatusBadRequest, CodeSeatIDsRequired},
+	{reservation.ErrIdempotencyKeyRequired, http.StatusBadRequest, CodeIdempotencyKeyRequired},
+	{reservation.ErrIdempotencyKeyAlreadyExists, http.StatusConflict, CodeIdempotencyKeyConflict},
+	{reservation.ErrHoldExtensionLimitReached, http.StatusConflict, CodeHoldExtensionLimitReached},
+	{reservation.ErrHoldMaxDurationReached, http.StatusConflict, CodeHoldMaxDurationReached},
+	{reservation.ErrRefundPeriodEnded, http.StatusConflict, CodeRefundPeriodEnded},
+	{reservation.ErrSeatNotInReservation, http.StatusBadRequest, CodeSeatNotInReservation},
+	{reservation.ErrCannotRemoveAllSeats, http.StatusBadRequest, CodeCannotRemoveAllSeats},
+	{reservation.ErrInvalidStatus, http.StatusBadRequest, CodeInvalidReservationStatus},
+	{reservation.ErrReservationRefundPending, http.StatusConflict, CodeReservationRefundPending},
+
+	// 座席
+	{seat.ErrSeatNotFound, http.StatusNotFound, CodeSeatNotFound},
+	{seat.ErrSeatNotAvailable, http.StatusConflict, CodeSeatNotAvailable},
+	{seat.ErrSeatNotReserved, http.StatusConflict, CodeSeatNotReserved},
+	{seat.ErrSeatAlreadyReserved, http.StatusConflict, CodeSeatAlreadyReserved},
+	{seat.ErrEventIDRequired, http.StatusBadRequest, CodeEventIDRequired},
+	{seat.ErrSeatNumberRequired, http.StatusBadRequest, CodeSeatNumberRequired},
+	{seat.ErrInvalidPrice, http.StatusBadRequest, CodeInvalidPrice},
+	{seat.ErrEmptyLayout, http.StatusBadRequest, CodeEmptyLayout},
+	{seat.ErrInvalidLayout, http.StatusBadRequest, CodeInvalidLayout},
+	{seat.ErrLayoutExceedsCapacity, http.StatusBadRequest, CodeLayoutExceedsCapacity},
+	{seat.ErrCapacityExceeded, http.StatusConflict, CodeSeatCapacityExceeded},
+	{seat.ErrInvalidQuantity, http.StatusBadRequest, CodeInvalidQuantity},
+	{seat.ErrInsufficientSeats, http.StatusConflict, CodeInsufficientSeats},
+	{seat.ErrNoAdjacentSeats, http.StatusConflict, CodeNoAdjacentSeats},
+
+	// 価格カテゴリ
+	{pricecategory.ErrPriceCategoryNotFound, http.StatusNotFound, CodePriceCategoryNotFound},
+	{pricecategory.ErrEventIDRequired, http.StatusBadRequest, CodeEventIDRequired},
+	{pricecategory.ErrNameRequired, http.StatusBadRequest, CodePriceCategoryNameRequired},
+	{pricecategory.ErrInvalidCurrency, http.StatusBadRequest, CodeInvalidCurrency},
+	{pricecategory.ErrInvalidAmount, http.StatusBadRequest, CodeInvalidAmount},
+	{pricecategory.ErrDuplicateName, http.StatusConflict, CodePriceCategoryDuplicate},
+	{pricecategory.ErrPriceCategoryInUse, http.StatusConflict, CodePriceCategoryInUse},
+	{pricecategory.ErrEventMismatch, http.StatusBadRequest, CodePriceCategoryMismatch},
+	{pricecategory.ErrCurrencyMismatch, http.StatusBadRequest, CodeCurrencyMismatch},
+
+	// 待合室
+	{queue.ErrTicketNotFound, http.StatusNotFound, CodeQueueTicketNotFound},
+	{queue.ErrNotAdmitted, http.StatusForbidden, CodeQueueNotAdmitted},
+	{queue.ErrWaitingRoomDisabled, http.StatusBadRequest, CodeWaitingRoomDisabled},
+
+	// 順番待ち
+	{waitlist.ErrEntryNotFound, http.StatusNotFound, CodeWaitlistEntryNotFound},
+	{waitlist.ErrEventIDRequired, http.StatusBadRequest, CodeEventIDRequired},
+	{waitlist.ErrUserIDRequired, http.StatusBadRequest, CodeUserIDRequired},
+	{waitlist.ErrInvalidQuantity, http.StatusBadRequest, CodeWaitlistInvalidQuantity},
+	{waitlist.ErrAlreadyWaiting, http.StatusConflict, CodeWaitlistAlreadyWaiting},
+	{waitlist.ErrSeatsAvailable, http.StatusConflict, CodeWaitlistSeatsAvailable},
+	{waitlist.ErrEntryNotWaiting, http.StatusConflict, CodeWaitlistEntryNotWaiting},
+	{waitlist.ErrEntryNotOffered, http.StatusConflict, CodeWaitlistEntryNotOffered},
+	{waitlist.ErrEntryClosed, http.StatusConflict, CodeWaitlistEntryClosed},
+
+	// イベント（予約・座席のエラーがイベントのエラーをラップすることがあるため最後に判定する）
+	{event.ErrEventNotFound, http.StatusNotFound, CodeEventNotFound},
+	{event.ErrEventNameRequired, http.StatusBadRequest, CodeEventNameRequired},
+	{event.ErrInvalidTotalSeats, http.StatusBadRequest, CodeInvalidTotalSeats},
+	{event.ErrInvalidEventTime, http.StatusBadRequest, CodeInvalidEventTime},
+	{event.ErrEventNotOpen, http.StatusConflict, CodeEventNotOpen},
+	{event.ErrInvalidHoldPolicy, http.StatusBadRequest, CodeInvalidHoldPolicy},
+	{event.ErrInvalidRefundPolicy, http.StatusBadRequest, CodeInvalidRefundPolicy},
+	{event.ErrInvalidPurchaseLimit, http.StatusBadRequest, CodeInvalidPurchaseLimit},
+	{event.ErrPurchaseLimitExceeded, http.StatusConflict, CodePurchaseLimitExceeded},
+	{event.ErrInvalidSearchQuery, http.StatusBadRequest, CodeInvalidSearchQuery},
+	{event.ErrInvalidStatusTransition, http.StatusConflict, CodeEventInvalidTransition},
+	{event.ErrInvalidSalesPeriod, http.StatusBadRequest, CodeInvalidSalesPeriod},
+	{event.ErrCancellationNotFound, http.StatusNotFound, CodeCancellationNotFound},
+	{event.ErrVersionMismatch, http.StatusPreconditionFailed, CodeEventVersionMismatch},
+	{event.ErrTotalSeatsBelowCreated, http.StatusConflict, CodeTotalSeatsBelowCreated},
+	{event.ErrEventHasActiveReservations, http.StatusConflict, CodeEventHasReservations},
+	{event.ErrEventCancelled, http.StatusConflict, CodeEventCancelled},
+}
+
+// LookupError はエラーコード表からエラーに対応する HTTP ステータスとエラーコードを返す
+// ラップされたエラーも判定し、表にない場合は ok=false を返す
+func LookupError(err error) (status int, code ErrorCode, ok bool) {
+	for _, m := range errorCatalog {
+		if errors.Is(err, m.err) {
+			return m.status, m.code, true
+		}
+	}
+	return 0, "", false
+}
+
+// codeForStatus はドメインエラーに対応しない場合のエラーコードを HTTP ステータスから決める
+func codeForStatus(status int) ErrorCode {
+	switch status {
+	case http.StatusUnauthorized:
+		return CodeUnauthenticated
+	case http.StatusForbidden:
+		return CodePermissionDenied
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusMethodNotAllowed:
+		return CodeMethodNotAllowed
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusPreconditionFailed:
+		return CodePreconditionFailed
+	case http.StatusPreconditionRequired:
+		return CodePreconditionRequired
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
+	case http.StatusServiceUnavailable:
+		return CodeServiceUnavailable
+	case http.StatusGatewayTimeout:
+		return CodeGatewayTimeout
+	}
+	if status >= http.StatusInternalServerError {
+		return CodeInternal
+	}
+	return CodeInvalidRequest
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -11,44 +12,85 @@ import (
 
 // ErrorResponse はエラーレスポンスの統一フォーマット
 type ErrorResponse struct {
-	Error   string `json:"error"`
-	Code    int    `json:"code,omitempty"`
-	Details string `json:"details,omitempty"`
+	Error     string       `json:"error"`
+	Code      int          `json:"code,omitempty"`
+	ErrorCode ErrorCode    `json:"error_code" example:"SEAT_ALREADY_RESERVED"`
+	Details   string       `json:"details,omitempty"`
+	Fields    []FieldError `json:"fields,omitempty"`
 }
 
 // CustomHTTPErrorHandler はカスタムエラーハンドラー
+// ステータスはハンドラーが返した HTTPError を優先し、エラーコードはエラーコード表から決める
+// メッセージは Accept-Language から決めた言語で返す
 func CustomHTTPErrorHandler(err error, c echo.Context) {
 	if c.Response().Committed {
 		return
 	}
 
 	var (
-		code    = http.StatusInternalServerError
-		message = "内部サーバーエラー"
+		code       = http.StatusInternalServerError
+		message    = ""
+		errorCode  = CodeInternal
+		domainCode = false
+		fields     []FieldError
 	)
 
-	if he, ok := err.(*echo.HTTPError); ok {
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
 		code = he.Code
-		if m, ok := he.Message.(string); ok {
+		if m, ok := he.Message.(string); ok && m != http.StatusText(code) {
 			message = m
-		} else {
-			message = http.StatusText(code)
 		}
+		errorCode = codeForStatus(code)
+		if _, ec, ok := LookupError(he.Internal); ok {
+			errorCode = ec
+			domainCode = true
+		}
+	} else if status, ec, ok := LookupError(err); ok {
+		// サービス層のエラーがそのまま返された場合（認可のエラーなど）は、コード表のステータスに変換する
+		code = status
+		errorCode = ec
+		domainCode = true
+	}
+
+	lang := NegotiateLanguage(c.Request().Header.Get("Accept-Language"))
+
+	var verr *ValidationError
+	if errors.As(err, &verr) {
+		errorCode = CodeValidationFailed
+		domainCode = true
+		fields = verr.Fields(lang)
+	}
+
+	// ドメインエラーはコードに対応するメッセージを使う
+	// ハンドラーが付けたメッセージは日本語で書かれているため、日本語以外の場合だけ汎用のメッセージに置き換える
+	if domainCode || message == "" || lang != DefaultLanguage {
+		if m, ok := localizedMessage(lang, errorCode); ok {
+			message = m
+		}
+	}
+	if message == "" {
+		message = http.StatusText(code)
 	}
 
 	// エラーログを出力（5xx エラーの場合）
 	if code >= 500 {
 		logger.Error("サーバーエラー",
 			zap.Int("status", code),
+			zap.String("error_code", string(errorCode)),
 			zap.String("path", c.Request().URL.Path),
 			zap.Error(err),
 		)
 	}
 
 	// JSONレスポンスを返す
+	c.Response().Header().Set("Content-Language", string(lang))
+	c.Response().Header().Add(echo.HeaderVary, "Accept-Language")
 	if err := c.JSON(code, ErrorResponse{
-		Error: message,
-		Code:  code,
+		Error:     message,
+		Code:      code,
+		ErrorCode: errorCode,
+		Fields:    fields,
 	}); err != nil {
 		logger.Error("エラーレスポンス送信失敗", zap.Error(err))
 	}
@@ -0,0 +1,167 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/reservation"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
+)
+
+func TestCustomHTTPErrorHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		wantCode      int
+		wantMsg       string
+		wantErrorCode ErrorCode
+	}{
+		{"HTTPError", echo.NewHTTPError(http.StatusNotFound, "イベントが見つかりません"), http.StatusNotFound, "イベントが見つかりません", CodeNotFound},
+		{"レート制限", echo.NewHTTPError(http.StatusTooManyRequests, "リクエスト数の上限を超えました"), http.StatusTooManyRequests, "リクエスト数の上限を超えました", CodeRateLimited},
+		{"ドメインエラーを持つHTTPError", echo.NewHTTPError(http.StatusConflict, seat.ErrSeatAlreadyReserved.Error()).SetInternal(seat.ErrSeatAlreadyReserved), http.StatusConflict, seat.ErrSeatAlreadyReserved.Error(), CodeSeatAlreadyReserved},
+		{"未認証", auth.ErrUnauthenticated, http.StatusUnauthorized, auth.ErrUnauthenticated.Error(), CodeUnauthenticated},
+		{"権限なし", auth.ErrPermissionDenied, http.StatusForbidden, auth.ErrPermissionDenied.Error(), CodePermissionDenied},
+		{"ラップされた権限なし", fmt.Errorf("イベント更新: %w", auth.ErrPermissionDenied), http.StatusForbidden, auth.ErrPermissionDenied.Error(), CodePermissionDenied},
+		{"メッセージのないHTTPError", echo.ErrNotFound, http.StatusNotFound, "見つかりません", CodeNotFound},
+		{"If-Match なし", echo.NewHTTPError(http.StatusPreconditionRequired, "If-Match ヘッダーが必要です"), http.StatusPreconditionRequired, "If-Match ヘッダーが必要です", CodePreconditionRequired},
+		{"バージョン不一致", event.ErrVersionMismatch, http.StatusPreconditionFailed, event.ErrVersionMismatch.Error(), CodeEventVersionMismatch},
+		{"ドメインエラー", event.ErrEventNotOpen, http.StatusConflict, event.ErrEventNotOpen.Error(), CodeEventNotOpen},
+		{"ロック競合", seat.ErrSeatLockContention, http.StatusLocked, seat.ErrSeatLockContention.Error(), CodeLockContention},
+		{"その他のエラー", errors.New("想定外のエラー"), http.StatusInternalServerError, "内部サーバーエラー", CodeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			CustomHTTPErrorHandler(tt.err, c)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			var resp ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantMsg, resp.Error)
+			assert.Equal(t, tt.wantCode, resp.Code)
+			assert.Equal(t, tt.wantErrorCode, resp.ErrorCode)
+		})
+	}
+}
+
+func TestCustomHTTPErrorHandler_ValidationError(t *testing.T) {
+	type item struct {
+		Name string `json:"name" validate:"required"`
+	}
+	type request struct {
+		SeatIDs []string `json:"seat_ids" validate:"required,min=1"`
+		Items   []item   `json:"items" validate:"dive"`
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := NewValidator().Validate(&request{SeatIDs: []string{}, Items: []item{{}}})
+	require.Error(t, err)
+
+	CustomHTTPErrorHandler(err, c)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	var resp ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, CodeValidationFailed, resp.ErrorCode)
+	assert.Equal(t, "入力内容に誤りがあります", resp.Error)
+	assert.Equal(t, []FieldError{
+		{Field: "seat_ids", Rule: "min", Param: "1", Message: "seat_idsは少なくとも1つの項目を含まなければなりません"},
+		{Field: "items[0].name", Rule: "required", Message: "nameは必須フィールドです"},
+	}, resp.Fields)
+}
+
+func TestCustomHTTPErrorHandler_AcceptLanguage(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		err            error
+		wantMsg        string
+		wantLanguage   string
+	}{
+		{"英語のドメインエラー", "en-US,en;q=0.9", seat.ErrSeatAlreadyReserved, "The seat is already reserved", "en"},
+		{"英語のラップされたドメインエラー", "en", echo.NewHTTPError(http.StatusNotFound, "予約取得に失敗").SetInternal(fmt.Errorf("予約取得に失敗: %w", reservation.ErrReservationNotFound)), "Reservation not found", "en"},
+		{"英語のハンドラーのメッセージは汎用メッセージに置き換える", "en", echo.NewHTTPError(http.StatusBadRequest, "開始時刻の形式が不正です"), "Invalid request", "en"},
+		{"日本語のハンドラーのメッセージはそのまま", "ja", echo.NewHTTPError(http.StatusBadRequest, "開始時刻の形式が不正です"), "開始時刻の形式が不正です", "ja"},
+		{"英語の内部エラー", "en", errors.New("想定外のエラー"), "Internal server error", "en"},
+		{"未対応の言語は日本語", "fr-FR", event.ErrEventNotOpen, event.ErrEventNotOpen.Error(), "ja"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Accept-Language", tt.acceptLanguage)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			CustomHTTPErrorHandler(tt.err, c)
+
+			var resp ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.wantMsg, resp.Error)
+			assert.Equal(t, tt.wantLanguage, rec.Header().Get("Content-Language"))
+		})
+	}
+}
+
+func TestCustomHTTPErrorHandler_ValidationErrorInEnglish(t *testing.T) {
+	type request struct {
+		SeatIDs []string `json:"seat_ids" validate:"required,min=1"`
+	}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("Accept-Language", "en")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	CustomHTTPErrorHandler(NewValidator().Validate(&request{SeatIDs: []string{}}), c)
+
+	var resp ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, "The request contains invalid fields", resp.Error)
+	require.Len(t, resp.Fields, 1)
+	assert.Equal(t, "seat_ids must contain at least 1 item", resp.Fields[0].Message)
+}
+
+func TestLookupError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   ErrorCode
+		wantOK     bool
+	}{
+		{"座席予約済み", seat.ErrSeatAlreadyReserved, http.StatusConflict, CodeSeatAlreadyReserved, true},
+		{"ラップされた予約なし", fmt.Errorf("予約取得に失敗: %w", reservation.ErrReservationNotFound), http.StatusNotFound, CodeReservationNotFound, true},
+		{"イベントのエラーをラップしたロック障害", fmt.Errorf("%w: %w", seat.ErrSeatLockUnavailable, event.ErrEventNotFound), http.StatusServiceUnavailable, CodeLockUnavailable, true},
+		{"楽観的ロック", event.ErrOptimisticLockConflict, http.StatusConflict, CodeOptimisticLockConflict, true},
+		{"コード表にないエラー", errors.New("想定外のエラー"), 0, "", false},
+		{"nil", nil, 0, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, code, ok := LookupError(tt.err)
+			assert.Equal(t, tt.wantStatus, status)
+			assert.Equal(t, tt.wantCode, code)
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/application"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/audit"
+)
+
+type AuditHandler struct {
+	service AuditServiceInterface
+}
+
+func NewAuditHandler(s AuditServiceInterface) *AuditHandler {
+	return &AuditHandler{service: s}
+}
+
+// AuditEntryResponse は監査ログの1件（変更前後の行をJSONで含む）
+type AuditEntryResponse struct {
+	ID         string          `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	EntityType string          `json:"entity_type" example:"event"`
+	EntityID   string          `json:"entity_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Action     string          `json:"action" example:"update"`
+	ActorID    string          `json:"actor_id" example:"organizer-1"`
+	Before     json.RawMessage `json:"before,omitempty" swaggertype:"object"`
+	After      json.RawMessage `json:"after,omitempty" swaggertype:"object"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// AuditListResponse は監査ログ一覧のレスポンス
+type AuditListResponse struct {
+	Data       []AuditEntryResponse `json:"data"`
+	Pagination PaginationResponse   `json:"pagination"`
+}
+
+func toAuditEntryResponse(e *audit.Entry) AuditEntryResponse {
+	return AuditEntryResponse{
+		ID: e.ID, EntityType: string(e.EntityType), EntityID: e.EntityID,
+		Action: string(e.Action), ActorID: e.ActorID,
+		Before: e.Before, After: e.After, CreatedAt: e.CreatedAt,
+	}
+}
+
+// List godoc
+// @Summary 監査ログを取得
+// @Description イベント・座席・予約の作成・更新・削除の履歴を新しい順に取得します。変更前後の行を before / after に含みます。
+// @Description 続きは pagination.next_cursor を cursor に指定して取得します。サポート担当者または管理者のみ取得できます
+// @Tags audit
+// @Produce json
+// @Security BearerAuth
+// @Param entity query string true "エンティティの種別" Enums(event, seat, reservation)
+// @Param id query string true "エンティティID"
+// @Param cursor query string false "前のページの next_cursor"
+// @Param limit query int false "取得件数（最大100）" default(20)
+// @Success 200 {object} AuditListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /audit [get]
+func (h *AuditHandler) List(c echo.Context) error {
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	page, err := h.service.ListEntries(c.Request().Context(), application.ListAuditEntriesInput{
+		EntityType: audit.EntityType(c.QueryParam("entity")),
+		EntityID:   c.QueryParam("id"),
+		Cursor:     c.QueryParam("cursor"),
+		Limit:      limit,
+	}, middleware.CurrentPrincipal(c))
+	if err != nil {
+		return serviceError(err, http.StatusInternalServerError)
+	}
+	resp := make([]AuditEntryResponse, len(page.Items))
+	for i, e := range page.Items {
+		resp[i] = toAuditEntryResponse(e)
+	}
+	return c.JSON(http.StatusOK, AuditListResponse{Data: resp, Pagination: toPaginationResponse(page)})
+}
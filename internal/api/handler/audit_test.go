@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/application"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/audit"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pagination"
+)
+
+// MockAuditService はAuditServiceInterfaceのモック
+type MockAuditService struct {
+	mock.Mock
+}
+
+func (m *MockAuditService) ListEntries(ctx context.Context, input application.ListAuditEntriesInput, principal auth.Principal) (*pagination.Page[*audit.Entry], error) {
+	args := m.Called(ctx, input, principal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pagination.Page[*audit.Entry]), args.Error(1)
+}
+
+func TestAuditHandler_List(t *testing.T) {
+	e := NewTestEcho()
+	support := auth.NewPrincipal("support-1", auth.RoleSupport)
+
+	t.Run("エンティティの監査ログを返す", func(t *testing.T) {
+		entries := []*audit.Entry{{
+			ID: "log-1", EntityType: audit.EntityEvent, EntityID: "event-123", Action: audit.ActionUpdate, ActorID: "org-1",
+			Before: json.RawMessage(`{"name":"旧"}`), After: json.RawMessage(`{"name":"新"}`), CreatedAt: time.Now(),
+		}}
+		page := pagination.NewPage(entries, 20, func(e *audit.Entry) pagination.Cursor {
+			return pagination.Cursor{CreatedAt: e.CreatedAt, ID: e.ID}
+		})
+		mockService := new(MockAuditService)
+		mockService.On("ListEntries", mock.Anything, application.ListAuditEntriesInput{
+			EntityType: audit.EntityEvent, EntityID: "event-123", Limit: 20,
+		}, support).Return(page, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/audit?entity=event&id=event-123&limit=20", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, support)
+
+		err := NewAuditHandler(mockService).List(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp AuditListResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Data, 1)
+		assert.Equal(t, "update", resp.Data[0].Action)
+		assert.Equal(t, "org-1", resp.Data[0].ActorID)
+		assert.JSONEq(t, `{"name":"旧"}`, string(resp.Data[0].Before))
+		assert.False(t, resp.Pagination.HasMore)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("サポート担当者以外の場合は認可エラーをそのまま返す", func(t *testing.T) {
+		mockService := new(MockAuditService)
+		mockService.On("ListEntries", mock.Anything, mock.Anything, testOrganizer).Return(nil, auth.ErrPermissionDenied)
+
+		req := httptest.NewRequest(http.MethodGet, "/audit?entity=event&id=event-123", nil)
+		c := e.NewContext(req, httptest.NewRecorder())
+		middleware.SetPrincipal(c, testOrganizer)
+
+		err := NewAuditHandler(mockService).List(c)
+
+		assert.ErrorIs(t, err, auth.ErrPermissionDenied)
+	})
+}
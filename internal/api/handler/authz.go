@@ -0,0 +1,13 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+)
+
+// isAuthorizationError は認可のエラーかを返す
+// 認可のエラーはそのまま返し、CustomHTTPErrorHandler で 401/403 に変換する
+func isAuthorizationError(err error) bool {
+	return errors.Is(err, auth.ErrUnauthenticated) || errors.Is(err, auth.ErrPermissionDenied)
+}
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api"
+)
+
+// serviceError はサービス層のエラーを HTTPError に変換する
+// ステータスはエラーコード表（api.LookupError）に従い、表にないエラーは fallback を使う
+// 元のエラーは Internal に残し、CustomHTTPErrorHandler がエラーコードの判定に使う
+func serviceError(err error, fallback int) error {
+	if isAuthorizationError(err) {
+		return err
+	}
+	status := fallback
+	if s, _, ok := api.LookupError(err); ok {
+		status = s
+	}
+	return echo.NewHTTPError(status, err.Error()).SetInternal(err)
+}
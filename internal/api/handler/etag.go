@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
+)
+
+// versionETag はエンティティのバージョン（楽観的ロック用）から ETag を作る
+func versionETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// setVersionETag はレスポンスにエンティティのバージョンの ETag を設定する
+func setVersionETag(c echo.Context, version int) {
+	c.Response().Header().Set(middleware.HeaderETag, versionETag(version))
+}
+
+// ifMatchVersion は If-Match ヘッダーからクライアントが取得したバージョンを取り出す
+// ヘッダーがない場合と "*"（存在すれば任意のバージョン）の場合は nil を返す
+// 弱い ETag（W/"..."）や形式が不正な ETag は現在のバージョンと一致し得ないため 412 を返す
+func ifMatchVersion(c echo.Context) (*int, error) {
+	h := strings.TrimSpace(c.Request().Header.Get(middleware.HeaderIfMatch))
+	if h == "" || h == "*" {
+		return nil, nil
+	}
+	if strings.Contains(h, ",") {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "If-Match には ETag を1つだけ指定してください")
+	}
+	if len(h) < 2 || h[0] != '"' || h[len(h)-1] != '"' {
+		return nil, echo.NewHTTPError(http.StatusPreconditionFailed, "If-Match の ETag が一致しません")
+	}
+	version, err := strconv.Atoi(h[1 : len(h)-1])
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusPreconditionFailed, "If-Match の ETag が一致しません")
+	}
+	return &version, nil
+}
@@ -1,15 +1,22 @@
 package handler
 
 import (
-	"errors"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/application"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
 )
 
 type EventHandler struct {
@@ -26,7 +33,87 @@ type CreateEventRequest struct {
 	Venue       string `json:"venue" example:"東京ドーム"`
 	StartAt     string `json:"start_at" validate:"required" example:"2025-12-31T18:00:00+09:00"`
 	EndAt       string `json:"end_at" validate:"required" example:"2025-12-31T21:00:00+09:00"`
-	TotalSeats  int    `json:"total_seats" validate:"required,gt=0" example:"50000"`
+	TotalSeats  int    `json:"total_seats" validate:"required_without=Layout,omitempty,gt=0" example:"50000"`
+	// 販売期間（RFC3339。省略時は作成時は制限なし、更新時は既存の値を維持）
+	SalesStartAt string `json:"sales_start_at,omitempty" example:"2025-11-01T10:00:00+09:00"`
+	SalesEndAt   string `json:"sales_end_at,omitempty" example:"2025-12-31T12:00:00+09:00"`
+	// 仮押さえ設定（省略時は作成時はデフォルト値、更新時は既存の値を維持）
+	HoldDurationSeconds    int  `json:"hold_duration_seconds,omitempty" validate:"omitempty,min=60" example:"900"`
+	MaxHoldDurationSeconds int  `json:"max_hold_duration_seconds,omitempty" validate:"omitempty,min=60" example:"1800"`
+	MaxHoldExtensions      *int `json:"max_hold_extensions,omitempty" validate:"omitempty,min=0" example:"2"`
+	// 待合室（省略時は作成時は無効、更新時は既存の値を維持）
+	WaitingRoomEnabled *bool `json:"waiting_room_enabled,omitempty" example:"true"`
+	// 返金ポリシー（開始の何秒前まで全額返金・部分返金するか。省略時は作成時はデフォルト値、更新時は既存の値を維持）
+	FullRefundBeforeSeconds *int `json:"full_refund_before_seconds,omitempty" validate:"omitempty,min=0" example:"604800"`
+	PartialRefundPercent    *int `json:"partial_refund_percent,omitempty" validate:"omitempty,min=0,max=100" example:"50"`
+	RefundCutoffSeconds     *int `json:"refund_cutoff_seconds,omitempty" validate:"omitempty,min=0" example:"86400"`
+	// 購入枚数の上限（0 は無制限。省略時は作成時は無制限、更新時は既存の値を維持）
+	MaxSeatsPerReservation *int `json:"max_seats_per_reservation,omitempty" validate:"omitempty,min=0" example:"4"`
+	MaxSeatsPerUser        *int `json:"max_seats_per_user,omitempty" validate:"omitempty,min=0" example:"8"`
+	// 座席レイアウト（作成時のみ指定可能。total_seats 省略時はレイアウトの座席数を使用）
+	Layout *SeatLayoutRequest `json:"layout,omitempty"`
+}
+
+// salesPeriod は販売期間を読み取る（省略された場合は nil）
+func (r *CreateEventRequest) salesPeriod() (startAt, endAt *time.Time, err error) {
+	if r.SalesStartAt != "" {
+		t, err := time.Parse(time.RFC3339, r.SalesStartAt)
+		if err != nil {
+			return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "販売開始時刻の形式が不正です")
+		}
+		startAt = &t
+	}
+	if r.SalesEndAt != "" {
+		t, err := time.Parse(time.RFC3339, r.SalesEndAt)
+		if err != nil {
+			return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "販売終了時刻の形式が不正です")
+		}
+		endAt = &t
+	}
+	return startAt, endAt, nil
+}
+
+// SeatLayoutRequest は会場の座席レイアウト（セクション > 列 > 座席）
+type SeatLayoutRequest struct {
+	Sections []SeatLayoutSectionRequest `json:"sections" validate:"required,min=1,dive"`
+}
+
+type SeatLayoutSectionRequest struct {
+	Name  string                 `json:"name" validate:"required,max=50" example:"ARENA"`
+	Price int                    `json:"price" validate:"min=0" example:"12000"`
+	Rows  []SeatLayoutRowRequest `json:"rows" validate:"required,min=1,dive"`
+}
+
+type SeatLayoutRowRequest struct {
+	Label string                  `json:"label" validate:"required,max=20" example:"A"`
+	Seats []SeatLayoutSeatRequest `json:"seats" validate:"required,min=1,dive"`
+}
+
+type SeatLayoutSeatRequest struct {
+	Index          int     `json:"index" validate:"required,gt=0" example:"1"`
+	X              float64 `json:"x" example:"120.5"`
+	Y              float64 `json:"y" example:"48"`
+	Accessible     bool    `json:"accessible" example:"false"`
+	ObstructedView bool    `json:"obstructed_view" example:"false"`
+}
+
+func (r *SeatLayoutRequest) toDomain() *seat.Layout {
+	layout := &seat.Layout{Sections: make([]seat.LayoutSection, len(r.Sections))}
+	for i, sec := range r.Sections {
+		rows := make([]seat.LayoutRow, len(sec.Rows))
+		for j, row := range sec.Rows {
+			seats := make([]seat.LayoutSeat, len(row.Seats))
+			for k, ls := range row.Seats {
+				seats[k] = seat.LayoutSeat{
+					Index: ls.Index, X: ls.X, Y: ls.Y,
+					Accessible: ls.Accessible, ObstructedView: ls.ObstructedView,
+				}
+			}
+			rows[j] = seat.LayoutRow{Label: row.Label, Seats: seats}
+		}
+		layout.Sections[i] = seat.LayoutSection{Name: sec.Name, Price: sec.Price, Rows: rows}
+	}
+	return layout
 }
 
 type EventResponse struct {
@@ -37,12 +124,40 @@ type EventResponse struct {
 	StartAt     string `json:"start_at" example:"2025-12-31T18:00:00+09:00"`
 	EndAt       string `json:"end_at" example:"2025-12-31T21:00:00+09:00"`
 	TotalSeats  int    `json:"total_seats" example:"50000"`
-	CreatedAt   string `json:"created_at" example:"2025-12-06T10:00:00+09:00"`
-	UpdatedAt   string `json:"updated_at" example:"2025-12-06T10:00:00+09:00"`
+	Status      string `json:"status" example:"on_sale"` // draft / published / on_sale / closed / cancelled
+	// 販売期間（未設定の場合は省略）
+	SalesStartAt string `json:"sales_start_at,omitempty" example:"2025-11-01T10:00:00+09:00"`
+	SalesEndAt   string `json:"sales_end_at,omitempty" example:"2025-12-31T12:00:00+09:00"`
+	// BookingOpen は現在予約を受け付けているか（販売中かつ販売期間内でイベント開始前）
+	BookingOpen bool `json:"booking_open" example:"true"`
+	// 仮押さえ設定
+	HoldDurationSeconds    int  `json:"hold_duration_seconds" example:"900"`
+	MaxHoldDurationSeconds int  `json:"max_hold_duration_seconds" example:"1800"`
+	MaxHoldExtensions      int  `json:"max_hold_extensions" example:"2"`
+	WaitingRoomEnabled     bool `json:"waiting_room_enabled" example:"false"`
+	// 返金ポリシー
+	FullRefundBeforeSeconds int    `json:"full_refund_before_seconds" example:"604800"`
+	PartialRefundPercent    int    `json:"partial_refund_percent" example:"50"`
+	RefundCutoffSeconds     int    `json:"refund_cutoff_seconds" example:"86400"`
+	MaxSeatsPerReservation  int    `json:"max_seats_per_reservation" example:"4"` // 0 は無制限
+	MaxSeatsPerUser         int    `json:"max_seats_per_user" example:"8"`        // 0 は無制限
+	OrganizerID             string `json:"organizer_id,omitempty" example:"organizer-1"`
+	CreatedAt               string `json:"created_at" example:"2025-12-06T10:00:00+09:00"`
+	UpdatedAt               string `json:"updated_at" example:"2025-12-06T10:00:00+09:00"`
+	// 作成済みの座席の状態ごとの数（イベント詳細のみ）
+	Seats *SeatInventoryResponse `json:"seats,omitempty"`
+}
+
+// SeatInventoryResponse は実際の座席から集計した座席数
+type SeatInventoryResponse struct {
+	Total     int `json:"total" example:"50000"`
+	Available int `json:"available" example:"42000"`
+	Reserved  int `json:"reserved" example:"3000"`
+	Confirmed int `json:"confirmed" example:"5000"`
 }
 
 func toEventResponse(e *event.Event) *EventResponse {
-	return &EventResponse{
+	resp := &EventResponse{
 		ID:          e.ID,
 		Name:        e.Name,
 		Description: e.Description,
@@ -50,20 +165,44 @@ func toEventResponse(e *event.Event) *EventResponse {
 		StartAt:     e.StartAt.Format(time.RFC3339),
 		EndAt:       e.EndAt.Format(time.RFC3339),
 		TotalSeats:  e.TotalSeats,
-		CreatedAt:   e.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:   e.UpdatedAt.Format(time.RFC3339),
+		Status:      string(e.Status),
+		BookingOpen: e.IsBookingOpen(),
+
+		HoldDurationSeconds:    int(e.HoldDuration / time.Second),
+		MaxHoldDurationSeconds: int(e.MaxHoldDuration / time.Second),
+		MaxHoldExtensions:      e.MaxHoldExtensions,
+		WaitingRoomEnabled:     e.WaitingRoomEnabled,
+
+		FullRefundBeforeSeconds: int(e.FullRefundBefore / time.Second),
+		PartialRefundPercent:    e.PartialRefundPercent,
+		RefundCutoffSeconds:     int(e.RefundCutoff / time.Second),
+		MaxSeatsPerReservation:  e.MaxSeatsPerReservation,
+		MaxSeatsPerUser:         e.MaxSeatsPerUser,
+		OrganizerID:             e.OrganizerID,
+		CreatedAt:               e.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:               e.UpdatedAt.Format(time.RFC3339),
+	}
+	if !e.SalesStartAt.IsZero() {
+		resp.SalesStartAt = e.SalesStartAt.Format(time.RFC3339)
 	}
+	if !e.SalesEndAt.IsZero() {
+		resp.SalesEndAt = e.SalesEndAt.Format(time.RFC3339)
+	}
+	return resp
 }
 
 // Create godoc
 // @Summary イベントを作成
-// @Description 新しいイベントを作成します
+// @Description 新しいイベントを作成します。layout を指定すると座席レイアウトから座席も同時に作成します。主催者（organizer）または管理者のみ作成でき、作成者がイベントの主催者になります
 // @Tags events
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param request body CreateEventRequest true "イベント情報"
 // @Success 201 {object} EventResponse
 // @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
 // @Router /events [post]
 func (h *EventHandler) Create(c echo.Context) error {
 	var req CreateEventRequest
@@ -82,6 +221,10 @@ func (h *EventHandler) Create(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "終了時刻の形式が不正です")
 	}
+	salesStartAt, salesEndAt, err := req.salesPeriod()
+	if err != nil {
+		return err
+	}
 
 	input := application.CreateEventInput{
 		Name:        req.Name,
@@ -90,73 +233,181 @@ func (h *EventHandler) Create(c echo.Context) error {
 		StartAt:     startAt,
 		EndAt:       endAt,
 		TotalSeats:  req.TotalSeats,
+
+		SalesStartAt: salesStartAt,
+		SalesEndAt:   salesEndAt,
+
+		HoldDuration:      time.Duration(req.HoldDurationSeconds) * time.Second,
+		MaxHoldDuration:   time.Duration(req.MaxHoldDurationSeconds) * time.Second,
+		MaxHoldExtensions: req.MaxHoldExtensions,
+
+		WaitingRoomEnabled: req.WaitingRoomEnabled,
+
+		FullRefundBefore:     secondsToDuration(req.FullRefundBeforeSeconds),
+		PartialRefundPercent: req.PartialRefundPercent,
+		RefundCutoff:         secondsToDuration(req.RefundCutoffSeconds),
+
+		MaxSeatsPerReservation: req.MaxSeatsPerReservation,
+		MaxSeatsPerUser:        req.MaxSeatsPerUser,
+
+		Principal: middleware.CurrentPrincipal(c),
+	}
+	if req.Layout != nil {
+		input.Layout = req.Layout.toDomain()
 	}
 
 	e, err := h.eventService.CreateEvent(c.Request().Context(), input)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		return serviceError(err, http.StatusBadRequest)
 	}
 
+	setVersionETag(c, e.Version)
 	return c.JSON(http.StatusCreated, toEventResponse(e))
 }
 
 // GetByID godoc
 // @Summary イベントを取得
-// @Description 指定IDのイベントを取得します
+// @Description 指定IDのイベントを取得します。seats には作成済みの座席を状態ごとに集計した数を返します。ETag ヘッダーにイベントのバージョンを返します（更新時に If-Match で送る）
 // @Tags events
 // @Produce json
 // @Param id path string true "イベントID"
 // @Success 200 {object} EventResponse
+// @Header 200 {string} ETag "イベントのバージョン"
 // @Failure 404 {object} map[string]string
 // @Router /events/{id} [get]
 func (h *EventHandler) GetByID(c echo.Context) error {
 	id := c.Param("id")
-	e, err := h.eventService.GetEvent(c.Request().Context(), id)
+	detail, err := h.eventService.GetEvent(c.Request().Context(), id)
 	if err != nil {
-		if errors.Is(err, event.ErrEventNotFound) {
-			return echo.NewHTTPError(http.StatusNotFound, "イベントが見つかりません")
+		return serviceError(err, http.StatusInternalServerError)
+	}
+	setVersionETag(c, detail.Event.Version)
+	resp := toEventResponse(detail.Event)
+	if detail.Seats != nil {
+		resp.Seats = &SeatInventoryResponse{
+			Total: detail.Seats.Total, Available: detail.Seats.Available,
+			Reserved: detail.Seats.Reserved, Confirmed: detail.Seats.Confirmed,
 		}
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
-	return c.JSON(http.StatusOK, toEventResponse(e))
+	return c.JSON(http.StatusOK, resp)
+}
+
+// EventListResponse はイベント一覧のレスポンス
+type EventListResponse struct {
+	Data       []*EventResponse   `json:"data"`
+	Pagination PaginationResponse `json:"pagination"`
 }
 
 // List godoc
 // @Summary イベント一覧を取得
-// @Description イベントの一覧を取得します
+// @Description イベントの一覧を作成日時の新しい順に取得します。続きは pagination.next_cursor を cursor に指定して取得します
 // @Tags events
 // @Produce json
-// @Param limit query int false "取得件数" default(20)
-// @Param offset query int false "オフセット" default(0)
-// @Success 200 {array} EventResponse
+// @Param cursor query string false "前のページの next_cursor"
+// @Param limit query int false "取得件数（最大100）" default(20)
+// @Param start_from query string false "開始時刻の下限（RFC3339、この時刻を含む）"
+// @Param start_to query string false "開始時刻の上限（RFC3339、この時刻を含まない）"
+// @Param venue query string false "会場（完全一致）"
+// @Param q query string false "イベント名の部分一致"
+// @Success 200 {object} EventListResponse
+// @Failure 400 {object} map[string]string
 // @Router /events [get]
 func (h *EventHandler) List(c echo.Context) error {
 	limit, _ := strconv.Atoi(c.QueryParam("limit"))
-	offset, _ := strconv.Atoi(c.QueryParam("offset"))
+	startFrom, err := timeQueryParam(c, "start_from", "開始時刻の下限の形式が不正です")
+	if err != nil {
+		return err
+	}
+	startTo, err := timeQueryParam(c, "start_to", "開始時刻の上限の形式が不正です")
+	if err != nil {
+		return err
+	}
 
-	events, err := h.eventService.ListEvents(c.Request().Context(), limit, offset)
+	page, err := h.eventService.ListEvents(c.Request().Context(), application.ListEventsInput{
+		StartFrom: startFrom,
+		StartTo:   startTo,
+		Venue:     c.QueryParam("venue"),
+		Query:     c.QueryParam("q"),
+		Cursor:    c.QueryParam("cursor"),
+		Limit:     limit,
+	})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return serviceError(err, http.StatusInternalServerError)
 	}
 
-	responses := make([]*EventResponse, len(events))
-	for i, e := range events {
+	responses := make([]*EventResponse, len(page.Items))
+	for i, e := range page.Items {
 		responses[i] = toEventResponse(e)
 	}
-	return c.JSON(http.StatusOK, responses)
+	return c.JSON(http.StatusOK, EventListResponse{Data: responses, Pagination: toPaginationResponse(page)})
+}
+
+// EventSearchResultResponse はイベント検索の結果
+type EventSearchResultResponse struct {
+	Event *EventResponse `json:"event"`
+	Rank  float64        `json:"rank"`
+	// Snippet は一致した箇所の抜粋（HTML エスケープ済み。一致した語を <mark> で囲む）
+	Snippet string `json:"snippet"`
+}
+
+// EventSearchResponse はイベント検索のレスポンス
+type EventSearchResponse struct {
+	Data []*EventSearchResultResponse `json:"data"`
+}
+
+// Search godoc
+// @Summary イベントを検索
+// @Description イベント名・会場・説明をキーワードで検索し、関連度の高い順に返します。空白で区切った語は全て含むものを返します
+// @Tags events
+// @Produce json
+// @Param q query string true "検索キーワード（100文字以内）"
+// @Param upcoming query bool false "開始前のイベントだけを返す"
+// @Param available query bool false "空席があるイベントだけを返す"
+// @Param limit query int false "取得件数（最大100）" default(20)
+// @Success 200 {object} EventSearchResponse
+// @Failure 400 {object} map[string]string
+// @Router /events/search [get]
+func (h *EventHandler) Search(c echo.Context) error {
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	upcoming, _ := strconv.ParseBool(c.QueryParam("upcoming"))
+	available, _ := strconv.ParseBool(c.QueryParam("available"))
+
+	results, err := h.eventService.SearchEvents(c.Request().Context(), application.SearchEventsInput{
+		Query:           c.QueryParam("q"),
+		UpcomingOnly:    upcoming,
+		HasAvailability: available,
+		Limit:           limit,
+	})
+	if err != nil {
+		return serviceError(err, http.StatusInternalServerError)
+	}
+
+	responses := make([]*EventSearchResultResponse, len(results))
+	for i, r := range results {
+		responses[i] = &EventSearchResultResponse{Event: toEventResponse(r.Event), Rank: r.Rank, Snippet: r.Snippet}
+	}
+	return c.JSON(http.StatusOK, EventSearchResponse{Data: responses})
 }
 
 // Update godoc
 // @Summary イベントを更新
-// @Description 指定IDのイベントを更新します
+// @Description 指定IDのイベントを更新します。イベントの主催者または管理者のみ更新できます。
+// @Description If-Match に取得時の ETag を指定すると、他のリクエストが先に更新していた場合は 412 を返します（strict モードでは If-Match が必須で、ない場合は 428）
 // @Tags events
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path string true "イベントID"
+// @Param If-Match header string false "取得時の ETag"
 // @Param request body CreateEventRequest true "イベント情報"
 // @Success 200 {object} EventResponse
+// @Header 200 {string} ETag "更新後のイベントのバージョン"
 // @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
 // @Failure 404 {object} map[string]string
+// @Failure 412 {object} map[string]string
+// @Failure 428 {object} map[string]string
 // @Router /events/{id} [put]
 func (h *EventHandler) Update(c echo.Context) error {
 	id := c.Param("id")
@@ -164,6 +415,9 @@ func (h *EventHandler) Update(c echo.Context) error {
 	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "リクエストの形式が不正です")
 	}
+	if req.Layout != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "座席レイアウトはイベント作成時のみ指定できます")
+	}
 	if err := c.Validate(&req); err != nil {
 		return err
 	}
@@ -176,6 +430,14 @@ func (h *EventHandler) Update(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "終了時刻の形式が不正です")
 	}
+	salesStartAt, salesEndAt, err := req.salesPeriod()
+	if err != nil {
+		return err
+	}
+	expectedVersion, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
 
 	input := application.UpdateEventInput{
 		ID:          id,
@@ -185,34 +447,285 @@ func (h *EventHandler) Update(c echo.Context) error {
 		StartAt:     startAt,
 		EndAt:       endAt,
 		TotalSeats:  req.TotalSeats,
+
+		SalesStartAt: salesStartAt,
+		SalesEndAt:   salesEndAt,
+
+		HoldDuration:      time.Duration(req.HoldDurationSeconds) * time.Second,
+		MaxHoldDuration:   time.Duration(req.MaxHoldDurationSeconds) * time.Second,
+		MaxHoldExtensions: req.MaxHoldExtensions,
+
+		WaitingRoomEnabled: req.WaitingRoomEnabled,
+
+		FullRefundBefore:     secondsToDuration(req.FullRefundBeforeSeconds),
+		PartialRefundPercent: req.PartialRefundPercent,
+		RefundCutoff:         secondsToDuration(req.RefundCutoffSeconds),
+
+		MaxSeatsPerReservation: req.MaxSeatsPerReservation,
+		MaxSeatsPerUser:        req.MaxSeatsPerUser,
+
+		ExpectedVersion: expectedVersion,
+		Principal:       middleware.CurrentPrincipal(c),
 	}
 
 	e, err := h.eventService.UpdateEvent(c.Request().Context(), input)
 	if err != nil {
-		if errors.Is(err, event.ErrEventNotFound) {
-			return echo.NewHTTPError(http.StatusNotFound, "イベントが見つかりません")
+		return serviceError(err, http.StatusBadRequest)
+	}
+	setVersionETag(c, e.Version)
+	return c.JSON(http.StatusOK, toEventResponse(e))
+}
+
+// PatchEventRequest はイベントの部分更新（JSON Merge Patch、RFC 7396）のリクエスト
+// 省略した項目は変更しない。null は値を削除できる項目（eventPatchFields）にのみ指定できる
+type PatchEventRequest struct {
+	Name                    *string `json:"name" example:"東京ドームコンサート2025"`
+	Description             *string `json:"description" example:"年末スペシャルコンサート"`
+	Venue                   *string `json:"venue" example:"東京ドーム"`
+	StartAt                 *string `json:"start_at" example:"2025-12-31T18:00:00+09:00"`
+	EndAt                   *string `json:"end_at" example:"2025-12-31T21:00:00+09:00"`
+	TotalSeats              *int    `json:"total_seats" validate:"omitempty,gt=0" example:"50000"`
+	SalesStartAt            *string `json:"sales_start_at" example:"2025-11-01T10:00:00+09:00"`
+	SalesEndAt              *string `json:"sales_end_at" example:"2025-12-31T12:00:00+09:00"`
+	HoldDurationSeconds     *int    `json:"hold_duration_seconds" validate:"omitempty,min=60" example:"900"`
+	MaxHoldDurationSeconds  *int    `json:"max_hold_duration_seconds" validate:"omitempty,min=60" example:"1800"`
+	MaxHoldExtensions       *int    `json:"max_hold_extensions" validate:"omitempty,min=0" example:"2"`
+	WaitingRoomEnabled      *bool   `json:"waiting_room_enabled" example:"true"`
+	FullRefundBeforeSeconds *int    `json:"full_refund_before_seconds" validate:"omitempty,min=0" example:"604800"`
+	PartialRefundPercent    *int    `json:"partial_refund_percent" validate:"omitempty,min=0,max=100" example:"50"`
+	RefundCutoffSeconds     *int    `json:"refund_cutoff_seconds" validate:"omitempty,min=0" example:"86400"`
+	MaxSeatsPerReservation  *int    `json:"max_seats_per_reservation" validate:"omitempty,min=0" example:"4"`
+	MaxSeatsPerUser         *int    `json:"max_seats_per_user" validate:"omitempty,min=0" example:"8"`
+}
+
+// eventPatchFields は部分更新で変更できる項目と、null（値の削除）を指定できるか
+// 説明・会場は空に、販売期間は制限なしに戻せる。それ以外の項目は null にできない
+var eventPatchFields = map[string]bool{
+	"name": false, "description": true, "venue": true,
+	"start_at": false, "end_at": false, "total_seats": false,
+	"sales_start_at": true, "sales_end_at": true,
+	"hold_duration_seconds": false, "max_hold_duration_seconds": false, "max_hold_extensions": false,
+	"waiting_room_enabled": false, "max_seats_per_reservation": false, "max_seats_per_user": false,
+	"full_refund_before_seconds": false, "partial_refund_percent": false, "refund_cutoff_seconds": false,
+}
+
+// mimeMergePatchJSON は JSON Merge Patch のメディアタイプ
+const mimeMergePatchJSON = "application/merge-patch+json"
+
+// Patch godoc
+// @Summary イベントを部分更新
+// @Description JSON Merge Patch（RFC 7396）で指定した項目だけを変更します。省略した項目は変更せず、変更後のイベント全体を検証します。
+// @Description description / venue / sales_start_at / sales_end_at は null で削除できます。座席数は作成済みの座席数より少なくできません（409）。
+// @Description If-Match に取得時の ETag を指定すると、他のリクエストが先に更新していた場合は 412 を返します。イベントの主催者または管理者のみ更新できます
+// @Tags events
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "イベントID"
+// @Param If-Match header string false "取得時の ETag"
+// @Param request body PatchEventRequest true "変更する項目"
+// @Success 200 {object} EventResponse
+// @Header 200 {string} ETag "更新後のイベントのバージョン"
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 412 {object} map[string]string
+// @Failure 415 {object} map[string]string
+// @Failure 428 {object} map[string]string
+// @Router /events/{id} [patch]
+func (h *EventHandler) Patch(c echo.Context) error {
+	mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	if mediaType != mimeMergePatchJSON && mediaType != echo.MIMEApplicationJSON {
+		return echo.NewHTTPError(http.StatusUnsupportedMediaType, "Content-Type は application/merge-patch+json を指定してください")
+	}
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "リクエストの形式が不正です")
+	}
+	// オブジェクト以外のパッチはイベント全体の置き換えになるため受け付けない
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil || fields == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "リクエストの形式が不正です")
+	}
+	for name, value := range fields {
+		nullable, ok := eventPatchFields[name]
+		if !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("%s は変更できません", name))
 		}
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		if !nullable && string(value) == "null" {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("%s に null は指定できません", name))
+		}
+	}
+	var req PatchEventRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "リクエストの形式が不正です")
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	expectedVersion, err := ifMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	input := application.PatchEventInput{
+		ID:         c.Param("id"),
+		Name:       req.Name,
+		TotalSeats: req.TotalSeats,
+
+		MaxHoldExtensions:  req.MaxHoldExtensions,
+		WaitingRoomEnabled: req.WaitingRoomEnabled,
+
+		HoldDuration:         secondsToDuration(req.HoldDurationSeconds),
+		MaxHoldDuration:      secondsToDuration(req.MaxHoldDurationSeconds),
+		FullRefundBefore:     secondsToDuration(req.FullRefundBeforeSeconds),
+		PartialRefundPercent: req.PartialRefundPercent,
+		RefundCutoff:         secondsToDuration(req.RefundCutoffSeconds),
+
+		MaxSeatsPerReservation: req.MaxSeatsPerReservation,
+		MaxSeatsPerUser:        req.MaxSeatsPerUser,
+
+		ExpectedVersion: expectedVersion,
+		Principal:       middleware.CurrentPrincipal(c),
+	}
+	// null を指定した項目は空の値で上書きする
+	input.Description = patchString(fields, "description", req.Description)
+	input.Venue = patchString(fields, "venue", req.Venue)
+	if input.StartAt, err = patchTime(fields, "start_at", req.StartAt, "開始時刻の形式が不正です"); err != nil {
+		return err
+	}
+	if input.EndAt, err = patchTime(fields, "end_at", req.EndAt, "終了時刻の形式が不正です"); err != nil {
+		return err
+	}
+	if input.SalesStartAt, err = patchTime(fields, "sales_start_at", req.SalesStartAt, "販売開始時刻の形式が不正です"); err != nil {
+		return err
+	}
+	if input.SalesEndAt, err = patchTime(fields, "sales_end_at", req.SalesEndAt, "販売終了時刻の形式が不正です"); err != nil {
+		return err
+	}
+
+	e, err := h.eventService.PatchEvent(c.Request().Context(), input)
+	if err != nil {
+		return serviceError(err, http.StatusBadRequest)
 	}
+	setVersionETag(c, e.Version)
 	return c.JSON(http.StatusOK, toEventResponse(e))
 }
 
+// patchString は部分更新の文字列の項目を読み取る（省略は nil、null は空文字）
+func patchString(fields map[string]json.RawMessage, name string, value *string) *string {
+	if _, ok := fields[name]; ok && value == nil {
+		empty := ""
+		return &empty
+	}
+	return value
+}
+
+// patchTime は部分更新の時刻の項目（RFC3339）を読み取る（省略は nil、null はゼロ値）
+func patchTime(fields map[string]json.RawMessage, name string, value *string, invalidMsg string) (*time.Time, error) {
+	if _, ok := fields[name]; !ok {
+		return nil, nil
+	}
+	if value == nil {
+		return &time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, invalidMsg)
+	}
+	return &t, nil
+}
+
 // Delete godoc
 // @Summary イベントを削除
-// @Description 指定IDのイベントを削除します
+// @Description 指定IDのイベントを削除します。イベントの主催者または管理者のみ削除できます。保留中・確定済み・返金待ちの予約がある場合は削除できないため、イベントを中止してください
 // @Tags events
+// @Security BearerAuth
 // @Param id path string true "イベントID"
 // @Success 204
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
 // @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string "保留中・確定済み・返金待ちの予約が残っている"
 // @Router /events/{id} [delete]
 func (h *EventHandler) Delete(c echo.Context) error {
 	id := c.Param("id")
-	err := h.eventService.DeleteEvent(c.Request().Context(), id)
+	err := h.eventService.DeleteEvent(c.Request().Context(), id, middleware.CurrentPrincipal(c))
 	if err != nil {
-		if errors.Is(err, event.ErrEventNotFound) {
-			return echo.NewHTTPError(http.StatusNotFound, "イベントが見つかりません")
-		}
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return serviceError(err, http.StatusInternalServerError)
 	}
 	return c.NoContent(http.StatusNoContent)
 }
+
+// Publish godoc
+// @Summary イベントを公開
+// @Description 下書きのイベントを公開し、一覧・検索に表示します。イベントの主催者または管理者のみ操作できます
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "イベントID"
+// @Success 200 {object} EventResponse
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /events/{id}/publish [post]
+func (h *EventHandler) Publish(c echo.Context) error {
+	return h.transition(c, h.eventService.PublishEvent)
+}
+
+// Open godoc
+// @Summary イベントの販売を開始
+// @Description 公開済み・販売終了のイベントの販売を開始します。販売期間内であれば予約を受け付けます。イベントの主催者または管理者のみ操作できます
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "イベントID"
+// @Success 200 {object} EventResponse
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /events/{id}/open [post]
+func (h *EventHandler) Open(c echo.Context) error {
+	return h.transition(c, h.eventService.OpenEventSales)
+}
+
+// Close godoc
+// @Summary イベントの販売を終了
+// @Description 販売中のイベントの販売を終了します。イベントの主催者または管理者のみ操作できます
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "イベントID"
+// @Success 200 {object} EventResponse
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /events/{id}/close [post]
+func (h *EventHandler) Close(c echo.Context) error {
+	return h.transition(c, h.eventService.CloseEventSales)
+}
+
+// transition はイベントの状態を変更するエンドポイントの共通処理
+func (h *EventHandler) transition(c echo.Context, fn func(context.Context, string, auth.Principal) (*event.Event, error)) error {
+	e, err := fn(c.Request().Context(), c.Param("id"), middleware.CurrentPrincipal(c))
+	if err != nil {
+		return serviceError(err, http.StatusInternalServerError)
+	}
+	setVersionETag(c, e.Version)
+	return c.JSON(http.StatusOK, toEventResponse(e))
+}
+
+// secondsToDuration は秒数の指定を time.Duration に変換する（nil の場合は nil）
+func secondsToDuration(sec *int) *time.Duration {
+	if sec == nil {
+		return nil
+	}
+	d := time.Duration(*sec) * time.Second
+	return &d
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+)
+
+type EventCancellationHandler struct {
+	service EventCancellationServiceInterface
+}
+
+func NewEventCancellationHandler(s EventCancellationServiceInterface) *EventCancellationHandler {
+	return &EventCancellationHandler{service: s}
+}
+
+// EventCancellationResponse はイベントの中止処理の進捗
+type EventCancellationResponse struct {
+	EventID                   string     `json:"event_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Status                    string     `json:"status" example:"running"`
+	TotalReservations         int        `json:"total_reservations" example:"120"`
+	ProcessedReservations     int        `json:"processed_reservations" example:"100"`
+	CancelledReservations     int        `json:"cancelled_reservations" example:"30"`
+	RefundPendingReservations int        `json:"refund_pending_reservations" example:"70"`
+	ReleasedSeats             int        `json:"released_seats" example:"180"`
+	StartedAt                 time.Time  `json:"started_at"`
+	UpdatedAt                 time.Time  `json:"updated_at"`
+	CompletedAt               *time.Time `json:"completed_at,omitempty"`
+}
+
+func toEventCancellationResponse(c *event.Cancellation) EventCancellationResponse {
+	return EventCancellationResponse{
+		EventID: c.EventID, Status: string(c.Status),
+		TotalReservations:         c.TotalReservations,
+		ProcessedReservations:     c.ProcessedReservations,
+		CancelledReservations:     c.CancelledReservations,
+		RefundPendingReservations: c.RefundPendingReservations,
+		ReleasedSeats:             c.ReleasedSeats,
+		StartedAt:                 c.StartedAt, UpdatedAt: c.UpdatedAt, CompletedAt: c.CompletedAt,
+	}
+}
+
+// Cancel godoc
+// @Summary イベントを中止
+// @Description イベントを中止し、保留中の予約のキャンセル・確定済みの予約の返金待ちへの変更・座席の解放をバックグラウンドで開始します。
+// @Description 中止したイベントは他の状態に戻せません。既に中止処理を開始している場合は進捗を返します。イベントの主催者または管理者のみ操作できます
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "イベントID"
+// @Success 202 {object} EventCancellationResponse
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /events/{id}/cancel [post]
+func (h *EventCancellationHandler) Cancel(c echo.Context) error {
+	cancellation, err := h.service.CancelEvent(c.Request().Context(), c.Param("id"), middleware.CurrentPrincipal(c))
+	if err != nil {
+		return serviceError(err, http.StatusInternalServerError)
+	}
+	return c.JSON(http.StatusAccepted, toEventCancellationResponse(cancellation))
+}
+
+// GetProgress godoc
+// @Summary イベントの中止処理の進捗を取得
+// @Description 中止したイベントの予約の取り消し・座席の解放の進捗を取得します。イベントの主催者または管理者のみ取得できます
+// @Tags events
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "イベントID"
+// @Success 200 {object} EventCancellationResponse
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /events/{id}/cancellation [get]
+func (h *EventCancellationHandler) GetProgress(c echo.Context) error {
+	cancellation, err := h.service.GetCancellation(c.Request().Context(), c.Param("id"), middleware.CurrentPrincipal(c))
+	if err != nil {
+		return serviceError(err, http.StatusInternalServerError)
+	}
+	return c.JSON(http.StatusOK, toEventCancellationResponse(cancellation))
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+)
+
+// MockEventCancellationService はEventCancellationServiceInterfaceのモック
+type MockEventCancellationService struct {
+	mock.Mock
+}
+
+func (m *MockEventCancellationService) CancelEvent(ctx context.Context, id string, principal auth.Principal) (*event.Cancellation, error) {
+	args := m.Called(ctx, id, principal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Cancellation), args.Error(1)
+}
+
+func (m *MockEventCancellationService) GetCancellation(ctx context.Context, id string, principal auth.Principal) (*event.Cancellation, error) {
+	args := m.Called(ctx, id, principal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Cancellation), args.Error(1)
+}
+
+func newEventCancellationContext(e *echo.Echo, method string, principal auth.Principal) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/events/event-123/cancel", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	middleware.SetPrincipal(c, principal)
+	c.SetParamNames("id")
+	c.SetParamValues("event-123")
+	return c, rec
+}
+
+func TestEventCancellationHandler_Cancel(t *testing.T) {
+	e := NewTestEcho()
+
+	t.Run("中止処理を開始して202を返す", func(t *testing.T) {
+		mockService := new(MockEventCancellationService)
+		mockService.On("CancelEvent", mock.Anything, "event-123", testOrganizer).Return(event.NewCancellation("event-123", 3), nil)
+
+		handler := NewEventCancellationHandler(mockService)
+		c, rec := newEventCancellationContext(e, http.MethodPost, testOrganizer)
+
+		err := handler.Cancel(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		var resp EventCancellationResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "event-123", resp.EventID)
+		assert.Equal(t, "running", resp.Status)
+		assert.Equal(t, 3, resp.TotalReservations)
+		assert.Nil(t, resp.CompletedAt)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("主催者以外の場合は認可エラーをそのまま返す", func(t *testing.T) {
+		other := auth.NewPrincipal("org-2", auth.RoleOrganizer)
+		mockService := new(MockEventCancellationService)
+		mockService.On("CancelEvent", mock.Anything, "event-123", other).Return(nil, auth.ErrPermissionDenied)
+
+		handler := NewEventCancellationHandler(mockService)
+		c, _ := newEventCancellationContext(e, http.MethodPost, other)
+
+		err := handler.Cancel(c)
+
+		assert.ErrorIs(t, err, auth.ErrPermissionDenied)
+	})
+}
+
+func TestEventCancellationHandler_GetProgress(t *testing.T) {
+	e := NewTestEcho()
+
+	t.Run("進捗を取得できる", func(t *testing.T) {
+		cancellation := event.NewCancellation("event-123", 3)
+		cancellation.RecordBatch(1, 2, 4)
+		cancellation.Complete()
+		mockService := new(MockEventCancellationService)
+		mockService.On("GetCancellation", mock.Anything, "event-123", testOrganizer).Return(cancellation, nil)
+
+		handler := NewEventCancellationHandler(mockService)
+		c, rec := newEventCancellationContext(e, http.MethodGet, testOrganizer)
+
+		err := handler.GetProgress(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp EventCancellationResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "completed", resp.Status)
+		assert.Equal(t, 3, resp.ProcessedReservations)
+		assert.Equal(t, 1, resp.CancelledReservations)
+		assert.Equal(t, 2, resp.RefundPendingReservations)
+		assert.Equal(t, 4, resp.ReleasedSeats)
+		assert.NotNil(t, resp.CompletedAt)
+	})
+
+	t.Run("中止していないイベントは404", func(t *testing.T) {
+		mockService := new(MockEventCancellationService)
+		mockService.On("GetCancellation", mock.Anything, "event-123", testOrganizer).Return(nil, event.ErrCancellationNotFound)
+
+		handler := NewEventCancellationHandler(mockService)
+		c, _ := newEventCancellationContext(e, http.MethodGet, testOrganizer)
+
+		err := handler.GetProgress(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, he.Code)
+	})
+}
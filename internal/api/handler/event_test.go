@@ -3,8 +3,10 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
@@ -14,10 +16,17 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/application"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pagination"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
 )
 
+// testOrganizer はイベントを管理する主催者
+var testOrganizer = auth.NewPrincipal("org-1", auth.RoleOrganizer)
+
 // MockEventService はEventServiceInterfaceのモック
 type MockEventService struct {
 	mock.Mock
@@ -31,20 +40,28 @@ func (m *MockEventService) CreateEvent(ctx context.Context, input application.Cr
 	return args.Get(0).(*event.Event), args.Error(1)
 }
 
-func (m *MockEventService) GetEvent(ctx context.Context, id string) (*event.Event, error) {
+func (m *MockEventService) GetEvent(ctx context.Context, id string) (*application.EventDetail, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).(*event.Event), args.Error(1)
+	return args.Get(0).(*application.EventDetail), args.Error(1)
+}
+
+func (m *MockEventService) ListEvents(ctx context.Context, input application.ListEventsInput) (*pagination.Page[*event.Event], error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pagination.Page[*event.Event]), args.Error(1)
 }
 
-func (m *MockEventService) ListEvents(ctx context.Context, limit, offset int) ([]*event.Event, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockEventService) SearchEvents(ctx context.Context, input application.SearchEventsInput) ([]*event.SearchResult, error) {
+	args := m.Called(ctx, input)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]*event.Event), args.Error(1)
+	return args.Get(0).([]*event.SearchResult), args.Error(1)
 }
 
 func (m *MockEventService) UpdateEvent(ctx context.Context, input application.UpdateEventInput) (*event.Event, error) {
@@ -55,8 +72,40 @@ func (m *MockEventService) UpdateEvent(ctx context.Context, input application.Up
 	return args.Get(0).(*event.Event), args.Error(1)
 }
 
-func (m *MockEventService) DeleteEvent(ctx context.Context, id string) error {
-	args := m.Called(ctx, id)
+func (m *MockEventService) PatchEvent(ctx context.Context, input application.PatchEventInput) (*event.Event, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) PublishEvent(ctx context.Context, id string, principal auth.Principal) (*event.Event, error) {
+	args := m.Called(ctx, id, principal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) OpenEventSales(ctx context.Context, id string, principal auth.Principal) (*event.Event, error) {
+	args := m.Called(ctx, id, principal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) CloseEventSales(ctx context.Context, id string, principal auth.Principal) (*event.Event, error) {
+	args := m.Called(ctx, id, principal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Event), args.Error(1)
+}
+
+func (m *MockEventService) DeleteEvent(ctx context.Context, id string, principal auth.Principal) error {
+	args := m.Called(ctx, id, principal)
 	return args.Error(0)
 }
 
@@ -78,8 +127,9 @@ func TestEventHandler_Create(t *testing.T) {
 			UpdatedAt:   now,
 		}
 
-		mockService.On("CreateEvent", mock.Anything, mock.AnythingOfType("application.CreateEventInput")).
-			Return(expectedEvent, nil)
+		mockService.On("CreateEvent", mock.Anything, mock.MatchedBy(func(in application.CreateEventInput) bool {
+			return in.Principal.UserID == testOrganizer.UserID
+		})).Return(expectedEvent, nil)
 
 		handler := NewEventHandler(mockService)
 
@@ -95,6 +145,7 @@ func TestEventHandler_Create(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testOrganizer)
 
 		err := handler.Create(c)
 
@@ -110,6 +161,60 @@ func TestEventHandler_Create(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 
+	t.Run("座席レイアウト付きでイベントを作成できる", func(t *testing.T) {
+		mockService := new(MockEventService)
+		now := time.Now()
+		expectedEvent := &event.Event{
+			ID: "event-123", Name: "レイアウトイベント",
+			StartAt: now, EndAt: now.Add(3 * time.Hour), TotalSeats: 3,
+			CreatedAt: now, UpdatedAt: now,
+		}
+
+		mockService.On("CreateEvent", mock.Anything, mock.MatchedBy(func(in application.CreateEventInput) bool {
+			return in.TotalSeats == 0 && in.Layout != nil && in.Layout.SeatCount() == 3 &&
+				in.Layout.Sections[0].Name == "ARENA" &&
+				in.Layout.Sections[0].Rows[0].Seats[0].Accessible
+		})).Return(expectedEvent, nil)
+
+		handler := NewEventHandler(mockService)
+
+		reqBody := `{
+			"name": "レイアウトイベント",
+			"start_at": "2025-12-31T18:00:00+09:00",
+			"end_at": "2025-12-31T21:00:00+09:00",
+			"layout": {"sections": [{"name": "ARENA", "price": 12000, "rows": [
+				{"label": "A", "seats": [{"index": 1, "x": 10, "y": 20, "accessible": true}, {"index": 2, "x": 20, "y": 20}]},
+				{"label": "B", "seats": [{"index": 1, "x": 10, "y": 30, "obstructed_view": true}]}
+			]}]}
+		}`
+		req := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.Create(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("座席数もレイアウトも指定しない場合バリデーションエラー", func(t *testing.T) {
+		mockService := new(MockEventService)
+		handler := NewEventHandler(mockService)
+
+		reqBody := `{"name": "テスト", "start_at": "2025-12-31T18:00:00+09:00", "end_at": "2025-12-31T21:00:00+09:00"}`
+		req := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.Create(c)
+
+		require.Error(t, err)
+		mockService.AssertNotCalled(t, "CreateEvent")
+	})
+
 	t.Run("不正なリクエスト形式でエラー", func(t *testing.T) {
 		mockService := new(MockEventService)
 		handler := NewEventHandler(mockService)
@@ -174,6 +279,33 @@ func TestEventHandler_Create(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, he.Code)
 		assert.Contains(t, he.Message, "終了時刻")
 	})
+
+	t.Run("不正な販売開始時刻形式でエラー", func(t *testing.T) {
+		mockService := new(MockEventService)
+		handler := NewEventHandler(mockService)
+
+		reqBody := `{
+			"name": "テストイベント",
+			"venue": "テスト会場",
+			"start_at": "2025-12-31T18:00:00+09:00",
+			"end_at": "2025-12-31T21:00:00+09:00",
+			"sales_start_at": "invalid-date",
+			"total_seats": 100
+		}`
+		req := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.Create(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, he.Code)
+		assert.Contains(t, he.Message, "販売開始時刻")
+		mockService.AssertNotCalled(t, "CreateEvent", mock.Anything, mock.Anything)
+	})
 }
 
 func TestEventHandler_GetByID(t *testing.T) {
@@ -192,9 +324,13 @@ func TestEventHandler_GetByID(t *testing.T) {
 			TotalSeats:  100,
 			CreatedAt:   now,
 			UpdatedAt:   now,
+			Version:     3,
 		}
 
-		mockService.On("GetEvent", mock.Anything, "event-123").Return(expectedEvent, nil)
+		mockService.On("GetEvent", mock.Anything, "event-123").Return(&application.EventDetail{
+			Event: expectedEvent,
+			Seats: &seat.Inventory{Total: 100, Available: 90, Reserved: 6, Confirmed: 4},
+		}, nil)
 
 		handler := NewEventHandler(mockService)
 
@@ -213,6 +349,9 @@ func TestEventHandler_GetByID(t *testing.T) {
 		err = json.Unmarshal(rec.Body.Bytes(), &resp)
 		require.NoError(t, err)
 		assert.Equal(t, "event-123", resp.ID)
+		assert.Equal(t, `"3"`, rec.Header().Get("ETag"))
+		require.NotNil(t, resp.Seats)
+		assert.Equal(t, SeatInventoryResponse{Total: 100, Available: 90, Reserved: 6, Confirmed: 4}, *resp.Seats)
 
 		mockService.AssertExpectations(t)
 	})
@@ -246,16 +385,20 @@ func TestEventHandler_List(t *testing.T) {
 	t.Run("正常にイベント一覧を取得できる", func(t *testing.T) {
 		mockService := new(MockEventService)
 		now := time.Now()
-		events := []*event.Event{
-			{ID: "event-1", Name: "イベント1", StartAt: now, EndAt: now.Add(time.Hour), CreatedAt: now, UpdatedAt: now},
-			{ID: "event-2", Name: "イベント2", StartAt: now, EndAt: now.Add(time.Hour), CreatedAt: now, UpdatedAt: now},
+		page := &pagination.Page[*event.Event]{
+			Items: []*event.Event{
+				{ID: "event-1", Name: "イベント1", StartAt: now, EndAt: now.Add(time.Hour), CreatedAt: now, UpdatedAt: now},
+				{ID: "event-2", Name: "イベント2", StartAt: now, EndAt: now.Add(time.Hour), CreatedAt: now, UpdatedAt: now},
+			},
+			NextCursor: "next",
+			Limit:      2,
 		}
 
-		mockService.On("ListEvents", mock.Anything, 0, 0).Return(events, nil)
+		mockService.On("ListEvents", mock.Anything, application.ListEventsInput{Limit: 2}).Return(page, nil)
 
 		handler := NewEventHandler(mockService)
 
-		req := httptest.NewRequest(http.MethodGet, "/events", nil)
+		req := httptest.NewRequest(http.MethodGet, "/events?limit=2", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
@@ -264,13 +407,227 @@ func TestEventHandler_List(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var resp []*EventResponse
+		var resp EventListResponse
 		err = json.Unmarshal(rec.Body.Bytes(), &resp)
 		require.NoError(t, err)
-		assert.Len(t, resp, 2)
+		assert.Len(t, resp.Data, 2)
+		assert.Equal(t, PaginationResponse{NextCursor: "next", HasMore: true, Limit: 2}, resp.Pagination)
 
 		mockService.AssertExpectations(t)
 	})
+
+	t.Run("絞り込み条件とカーソルをサービスに渡す", func(t *testing.T) {
+		mockService := new(MockEventService)
+		from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+		mockService.On("ListEvents", mock.Anything, application.ListEventsInput{
+			StartFrom: &from,
+			StartTo:   &to,
+			Venue:     "東京ドーム",
+			Query:     "ライブ",
+			Cursor:    "abc",
+		}).Return(&pagination.Page[*event.Event]{Items: []*event.Event{}, Limit: 20}, nil)
+
+		handler := NewEventHandler(mockService)
+
+		q := url.Values{}
+		q.Set("start_from", "2026-01-01T00:00:00Z")
+		q.Set("start_to", "2026-02-01T00:00:00Z")
+		q.Set("venue", "東京ドーム")
+		q.Set("q", "ライブ")
+		q.Set("cursor", "abc")
+		req := httptest.NewRequest(http.MethodGet, "/events?"+q.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.List(c)
+
+		require.NoError(t, err)
+		var resp EventListResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.NotNil(t, resp.Data)
+		assert.False(t, resp.Pagination.HasMore)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("開始時刻の形式が不正な場合400", func(t *testing.T) {
+		mockService := new(MockEventService)
+		handler := NewEventHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/events?start_from=2026-01-01", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.List(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, he.Code)
+		mockService.AssertNotCalled(t, "ListEvents", mock.Anything, mock.Anything)
+	})
+
+	t.Run("カーソルが不正な場合400", func(t *testing.T) {
+		mockService := new(MockEventService)
+		mockService.On("ListEvents", mock.Anything, application.ListEventsInput{Cursor: "invalid"}).Return(nil, pagination.ErrInvalidCursor)
+		handler := NewEventHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/events?cursor=invalid", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.List(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, he.Code)
+	})
+}
+
+func TestEventHandler_Search(t *testing.T) {
+	e := NewTestEcho()
+
+	t.Run("正常にイベントを検索できる", func(t *testing.T) {
+		mockService := new(MockEventService)
+		now := time.Now()
+		results := []*event.SearchResult{
+			{Event: &event.Event{ID: "event-1", Name: "夏のライブ", StartAt: now, EndAt: now.Add(time.Hour)}, Rank: 0.8, Snippet: "夏の<mark>ライブ</mark>"},
+		}
+		mockService.On("SearchEvents", mock.Anything, application.SearchEventsInput{
+			Query:           "ライブ",
+			UpcomingOnly:    true,
+			HasAvailability: true,
+			Limit:           5,
+		}).Return(results, nil)
+
+		handler := NewEventHandler(mockService)
+
+		q := url.Values{}
+		q.Set("q", "ライブ")
+		q.Set("upcoming", "true")
+		q.Set("available", "true")
+		q.Set("limit", "5")
+		req := httptest.NewRequest(http.MethodGet, "/events/search?"+q.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.Search(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp EventSearchResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Data, 1)
+		assert.Equal(t, "event-1", resp.Data[0].Event.ID)
+		assert.Equal(t, 0.8, resp.Data[0].Rank)
+		assert.Equal(t, "夏の<mark>ライブ</mark>", resp.Data[0].Snippet)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("キーワードが不正な場合400", func(t *testing.T) {
+		mockService := new(MockEventService)
+		mockService.On("SearchEvents", mock.Anything, application.SearchEventsInput{}).Return(nil, event.ErrInvalidSearchQuery)
+		handler := NewEventHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/events/search", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.Search(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, he.Code)
+	})
+}
+
+func TestEventHandler_Transitions(t *testing.T) {
+	e := NewTestEcho()
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		method  string
+		handle  func(h *EventHandler, c echo.Context) error
+		updated event.Status
+	}{
+		{"公開", "PublishEvent", (*EventHandler).Publish, event.StatusPublished},
+		{"販売開始", "OpenEventSales", (*EventHandler).Open, event.StatusOnSale},
+		{"販売終了", "CloseEventSales", (*EventHandler).Close, event.StatusClosed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockEventService)
+			ev := &event.Event{ID: "event-123", Name: "イベント", Status: tt.updated, StartAt: now.Add(24 * time.Hour), EndAt: now.Add(26 * time.Hour)}
+			mockService.On(tt.method, mock.Anything, "event-123", testOrganizer).Return(ev, nil)
+
+			handler := NewEventHandler(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/events/event-123", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			middleware.SetPrincipal(c, testOrganizer)
+			c.SetParamNames("id")
+			c.SetParamValues("event-123")
+
+			err := tt.handle(handler, c)
+
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var resp EventResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, string(tt.updated), resp.Status)
+			assert.Equal(t, tt.updated == event.StatusOnSale, resp.BookingOpen)
+			mockService.AssertExpectations(t)
+		})
+	}
+
+	t.Run("遷移できない状態の場合409", func(t *testing.T) {
+		mockService := new(MockEventService)
+		mockService.On("OpenEventSales", mock.Anything, "event-123", testOrganizer).
+			Return(nil, fmt.Errorf("%w: draft から on_sale には変更できません", event.ErrInvalidStatusTransition))
+
+		handler := NewEventHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/events/event-123/open", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testOrganizer)
+		c.SetParamNames("id")
+		c.SetParamValues("event-123")
+
+		err := handler.Open(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusConflict, he.Code)
+	})
+
+	t.Run("主催者以外の場合は認可エラーをそのまま返す", func(t *testing.T) {
+		other := auth.NewPrincipal("org-2", auth.RoleOrganizer)
+		mockService := new(MockEventService)
+		mockService.On("PublishEvent", mock.Anything, "event-123", other).Return(nil, auth.ErrPermissionDenied)
+
+		handler := NewEventHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/events/event-123/publish", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, other)
+		c.SetParamNames("id")
+		c.SetParamValues("event-123")
+
+		err := handler.Publish(c)
+
+		assert.ErrorIs(t, err, auth.ErrPermissionDenied)
+	})
 }
 
 func TestEventHandler_Delete(t *testing.T) {
@@ -278,13 +635,14 @@ func TestEventHandler_Delete(t *testing.T) {
 
 	t.Run("正常にイベントを削除できる", func(t *testing.T) {
 		mockService := new(MockEventService)
-		mockService.On("DeleteEvent", mock.Anything, "event-123").Return(nil)
+		mockService.On("DeleteEvent", mock.Anything, "event-123", testOrganizer).Return(nil)
 
 		handler := NewEventHandler(mockService)
 
 		req := httptest.NewRequest(http.MethodDelete, "/events/event-123", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testOrganizer)
 		c.SetParamNames("id")
 		c.SetParamValues("event-123")
 
@@ -298,13 +656,14 @@ func TestEventHandler_Delete(t *testing.T) {
 
 	t.Run("イベントが見つからない場合404", func(t *testing.T) {
 		mockService := new(MockEventService)
-		mockService.On("DeleteEvent", mock.Anything, "nonexistent").Return(event.ErrEventNotFound)
+		mockService.On("DeleteEvent", mock.Anything, "nonexistent", testOrganizer).Return(event.ErrEventNotFound)
 
 		handler := NewEventHandler(mockService)
 
 		req := httptest.NewRequest(http.MethodDelete, "/events/nonexistent", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testOrganizer)
 		c.SetParamNames("id")
 		c.SetParamValues("nonexistent")
 
@@ -317,6 +676,48 @@ func TestEventHandler_Delete(t *testing.T) {
 
 		mockService.AssertExpectations(t)
 	})
+
+	t.Run("予約が残っている場合409", func(t *testing.T) {
+		mockService := new(MockEventService)
+		mockService.On("DeleteEvent", mock.Anything, "event-123", testOrganizer).Return(event.ErrEventHasActiveReservations)
+
+		handler := NewEventHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodDelete, "/events/event-123", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testOrganizer)
+		c.SetParamNames("id")
+		c.SetParamValues("event-123")
+
+		err := handler.Delete(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusConflict, he.Code)
+	})
+
+	t.Run("主催者以外の場合は認可エラーをそのまま返す", func(t *testing.T) {
+		other := auth.NewPrincipal("org-2", auth.RoleOrganizer)
+		mockService := new(MockEventService)
+		mockService.On("DeleteEvent", mock.Anything, "event-123", other).Return(auth.ErrPermissionDenied)
+
+		handler := NewEventHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodDelete, "/events/event-123", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, other)
+		c.SetParamNames("id")
+		c.SetParamValues("event-123")
+
+		err := handler.Delete(c)
+
+		// CustomHTTPErrorHandler で 403 に変換される
+		assert.ErrorIs(t, err, auth.ErrPermissionDenied)
+		mockService.AssertExpectations(t)
+	})
 }
 
 func TestEventHandler_Update(t *testing.T) {
@@ -500,4 +901,156 @@ func TestEventHandler_Update(t *testing.T) {
 
 		mockService.AssertExpectations(t)
 	})
+
+	updateWithIfMatch := func(ifMatch string, service *MockEventService) (*httptest.ResponseRecorder, error) {
+		reqBody := `{
+			"name": "テストイベント",
+			"start_at": "2025-12-31T18:00:00+09:00",
+			"end_at": "2025-12-31T21:00:00+09:00",
+			"total_seats": 100
+		}`
+		req := httptest.NewRequest(http.MethodPut, "/events/event-123", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("If-Match", ifMatch)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("event-123")
+		return rec, NewEventHandler(service).Update(c)
+	}
+
+	t.Run("If-Match のバージョンをサービスに渡し、更新後の ETag を返す", func(t *testing.T) {
+		mockService := new(MockEventService)
+		mockService.On("UpdateEvent", mock.Anything, mock.MatchedBy(func(in application.UpdateEventInput) bool {
+			return in.ExpectedVersion != nil && *in.ExpectedVersion == 3
+		})).Return(&event.Event{ID: "event-123", Name: "テストイベント", Version: 4}, nil)
+
+		rec, err := updateWithIfMatch(`"3"`, mockService)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"4"`, rec.Header().Get("ETag"))
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("If-Match が * の場合はバージョンを確認しない", func(t *testing.T) {
+		mockService := new(MockEventService)
+		mockService.On("UpdateEvent", mock.Anything, mock.MatchedBy(func(in application.UpdateEventInput) bool {
+			return in.ExpectedVersion == nil
+		})).Return(&event.Event{ID: "event-123", Name: "テストイベント", Version: 4}, nil)
+
+		_, err := updateWithIfMatch("*", mockService)
+
+		require.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("バージョンが一致しない場合412", func(t *testing.T) {
+		mockService := new(MockEventService)
+		mockService.On("UpdateEvent", mock.Anything, mock.AnythingOfType("application.UpdateEventInput")).
+			Return(nil, event.ErrVersionMismatch)
+
+		_, err := updateWithIfMatch(`"2"`, mockService)
+
+		var he *echo.HTTPError
+		require.ErrorAs(t, err, &he)
+		assert.Equal(t, http.StatusPreconditionFailed, he.Code)
+	})
+
+	t.Run("弱い ETag や形式が不正な ETag は412", func(t *testing.T) {
+		for _, ifMatch := range []string{`W/"3"`, `3`, `"abc"`} {
+			mockService := new(MockEventService)
+
+			_, err := updateWithIfMatch(ifMatch, mockService)
+
+			var he *echo.HTTPError
+			require.ErrorAs(t, err, &he, ifMatch)
+			assert.Equal(t, http.StatusPreconditionFailed, he.Code, ifMatch)
+			mockService.AssertNotCalled(t, "UpdateEvent", mock.Anything, mock.Anything)
+		}
+	})
+}
+
+func TestEventHandler_Patch(t *testing.T) {
+	e := NewTestEcho()
+
+	patch := func(body, contentType string, service *MockEventService) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(http.MethodPatch, "/events/event-123", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, contentType)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("event-123")
+		return rec, NewEventHandler(service).Patch(c)
+	}
+
+	t.Run("指定した項目だけをサービスに渡す", func(t *testing.T) {
+		mockService := new(MockEventService)
+		mockService.On("PatchEvent", mock.Anything, mock.MatchedBy(func(in application.PatchEventInput) bool {
+			return in.ID == "event-123" &&
+				in.Name != nil && *in.Name == "新しい名前" &&
+				in.TotalSeats != nil && *in.TotalSeats == 80 &&
+				in.Description == nil && in.Venue == nil && in.StartAt == nil && in.SalesStartAt == nil &&
+				in.HoldDuration != nil && *in.HoldDuration == 10*time.Minute
+		})).Return(&event.Event{ID: "event-123", Name: "新しい名前", TotalSeats: 80, Version: 2}, nil)
+
+		rec, err := patch(`{"name": "新しい名前", "total_seats": 80, "hold_duration_seconds": 600}`, mimeMergePatchJSON, mockService)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("null を指定した項目は削除する", func(t *testing.T) {
+		mockService := new(MockEventService)
+		mockService.On("PatchEvent", mock.Anything, mock.MatchedBy(func(in application.PatchEventInput) bool {
+			return in.Description != nil && *in.Description == "" &&
+				in.SalesEndAt != nil && in.SalesEndAt.IsZero() && in.Name == nil
+		})).Return(&event.Event{ID: "event-123"}, nil)
+
+		_, err := patch(`{"description": null, "sales_end_at": null}`, echo.MIMEApplicationJSON, mockService)
+
+		require.NoError(t, err)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("不正なパッチは400", func(t *testing.T) {
+		for _, body := range []string{
+			`["name"]`,
+			`{"name": null}`,
+			`{"status": "open"}`,
+			`{"total_seats": 0}`,
+			`{"start_at": "tomorrow"}`,
+		} {
+			mockService := new(MockEventService)
+
+			_, err := patch(body, mimeMergePatchJSON, mockService)
+
+			var he *echo.HTTPError
+			require.ErrorAs(t, err, &he, body)
+			assert.Equal(t, http.StatusBadRequest, he.Code, body)
+			mockService.AssertNotCalled(t, "PatchEvent", mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("JSON 以外のメディアタイプは415", func(t *testing.T) {
+		_, err := patch(`name=x`, echo.MIMEApplicationForm, new(MockEventService))
+
+		var he *echo.HTTPError
+		require.ErrorAs(t, err, &he)
+		assert.Equal(t, http.StatusUnsupportedMediaType, he.Code)
+	})
+
+	t.Run("座席数が作成済みの座席数を下回る場合409", func(t *testing.T) {
+		mockService := new(MockEventService)
+		mockService.On("PatchEvent", mock.Anything, mock.AnythingOfType("application.PatchEventInput")).
+			Return(nil, event.ErrTotalSeatsBelowCreated)
+
+		_, err := patch(`{"total_seats": 10}`, mimeMergePatchJSON, mockService)
+
+		var he *echo.HTTPError
+		require.ErrorAs(t, err, &he)
+		assert.Equal(t, http.StatusConflict, he.Code)
+	})
 }
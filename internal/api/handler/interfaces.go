@@ -5,18 +5,36 @@ import (
 	"time"
 
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/application"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/audit"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pagination"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/payment"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pricecategory"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/queue"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/reservation"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/waitlist"
 )
 
 // EventServiceInterface はイベントサービスのインターフェース
 type EventServiceInterface interface {
 	CreateEvent(ctx context.Context, input application.CreateEventInput) (*event.Event, error)
-	GetEvent(ctx context.Context, id string) (*event.Event, error)
-	ListEvents(ctx context.Context, limit, offset int) ([]*event.Event, error)
+	GetEvent(ctx context.Context, id string) (*application.EventDetail, error)
+	ListEvents(ctx context.Context, input application.ListEventsInput) (*pagination.Page[*event.Event], error)
+	SearchEvents(ctx context.Context, input application.SearchEventsInput) ([]*event.SearchResult, error)
 	UpdateEvent(ctx context.Context, input application.UpdateEventInput) (*event.Event, error)
-	DeleteEvent(ctx context.Context, id string) error
+	PatchEvent(ctx context.Context, input application.PatchEventInput) (*event.Event, error)
+	PublishEvent(ctx context.Context, id string, principal auth.Principal) (*event.Event, error)
+	OpenEventSales(ctx context.Context, id string, principal auth.Principal) (*event.Event, error)
+	CloseEventSales(ctx context.Context, id string, principal auth.Principal) (*event.Event, error)
+	DeleteEvent(ctx context.Context, id string, principal auth.Principal) error
+}
+
+// EventCancellationServiceInterface はイベントの中止サービスのインターフェース
+type EventCancellationServiceInterface interface {
+	CancelEvent(ctx context.Context, id string, principal auth.Principal) (*event.Cancellation, error)
+	GetCancellation(ctx context.Context, id string, principal auth.Principal) (*event.Cancellation, error)
 }
 
 // SeatServiceInterface は座席サービスのインターフェース
@@ -29,12 +47,50 @@ type SeatServiceInterface interface {
 	CountAvailableSeats(ctx context.Context, eventID string) (int, error)
 }
 
+// PriceCategoryServiceInterface は価格カテゴリサービスのインターフェース
+type PriceCategoryServiceInterface interface {
+	CreatePriceCategory(ctx context.Context, input application.CreatePriceCategoryInput) (*pricecategory.PriceCategory, error)
+	GetPriceCategory(ctx context.Context, eventID, id string) (*pricecategory.PriceCategory, error)
+	ListPriceCategories(ctx context.Context, eventID string) ([]*pricecategory.PriceCategory, error)
+	UpdatePriceCategory(ctx context.Context, input application.UpdatePriceCategoryInput) (*pricecategory.PriceCategory, error)
+	DeletePriceCategory(ctx context.Context, eventID, id string, principal auth.Principal) error
+}
+
 // ReservationServiceInterface は予約サービスのインターフェース
 type ReservationServiceInterface interface {
 	CreateReservation(ctx context.Context, input application.CreateReservationInput) (*reservation.Reservation, error)
-	GetReservation(ctx context.Context, id string) (*reservation.Reservation, error)
-	GetUserReservations(ctx context.Context, userID string, limit, offset int) ([]*reservation.Reservation, error)
-	ConfirmReservation(ctx context.Context, id string) (*reservation.Reservation, error)
-	CancelReservation(ctx context.Context, id string) (*reservation.Reservation, error)
+	ReserveBestAvailable(ctx context.Context, input application.BestAvailableInput) (*reservation.Reservation, error)
+	GetReservation(ctx context.Context, id string, principal auth.Principal) (*reservation.Reservation, error)
+	GetUserReservations(ctx context.Context, input application.ListUserReservationsInput) (*pagination.Page[*reservation.Reservation], error)
+	GetEventReservations(ctx context.Context, eventID string, principal auth.Principal, limit, offset int) ([]*reservation.Reservation, error)
+	ConfirmReservation(ctx context.Context, input application.ConfirmReservationInput) (*reservation.Reservation, error)
+	ExtendReservation(ctx context.Context, id string, principal auth.Principal) (*reservation.Reservation, error)
+	CancelReservation(ctx context.Context, id string, principal auth.Principal) (*reservation.Reservation, error)
+	RefundReservation(ctx context.Context, id string, principal auth.Principal) (*reservation.Reservation, error)
+	RemoveSeats(ctx context.Context, input application.RemoveSeatsInput) (*reservation.Reservation, error)
 	CancelExpiredReservations(ctx context.Context, expireAfter time.Duration) (int, error)
 }
+
+// WaitlistServiceInterface は順番待ちサービスのインターフェース
+type WaitlistServiceInterface interface {
+	JoinWaitlist(ctx context.Context, input application.JoinWaitlistInput) (*waitlist.Entry, error)
+	GetEntry(ctx context.Context, eventID, id string, principal auth.Principal) (*waitlist.Entry, int, error)
+	LeaveWaitlist(ctx context.Context, eventID, id string, principal auth.Principal) (*waitlist.Entry, error)
+}
+
+// QueueServiceInterface は待合室サービスのインターフェース
+type QueueServiceInterface interface {
+	JoinQueue(ctx context.Context, eventID, userID string) (*queue.Ticket, error)
+	GetTicket(ctx context.Context, eventID, token string) (*queue.Ticket, error)
+}
+
+// PaymentServiceInterface は決済サービスのインターフェース
+type PaymentServiceInterface interface {
+	GetPaymentByReservation(ctx context.Context, reservationID string, principal auth.Principal) (*payment.Payment, error)
+	HandleWebhook(ctx context.Context, payload []byte, signature string) (*payment.Payment, error)
+}
+
+// AuditServiceInterface は監査ログサービスのインターフェース
+type AuditServiceInterface interface {
+	ListEntries(ctx context.Context, input application.ListAuditEntriesInput, principal auth.Principal) (*pagination.Page[*audit.Entry], error)
+}
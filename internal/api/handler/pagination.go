@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pagination"
+)
+
+// PaginationResponse は一覧レスポンスのページ情報
+type PaginationResponse struct {
+	NextCursor string `json:"next_cursor,omitempty"` // 次のページを取得する場合に cursor に指定する
+	HasMore    bool   `json:"has_more"`
+	Limit      int    `json:"limit"`
+}
+
+func toPaginationResponse[T any](p *pagination.Page[T]) PaginationResponse {
+	return PaginationResponse{NextCursor: p.NextCursor, HasMore: p.HasMore(), Limit: p.Limit}
+}
+
+// timeQueryParam は RFC3339 形式のクエリパラメータを読み取る（未指定の場合は nil）
+func timeQueryParam(c echo.Context, name, message string) (*time.Time, error) {
+	v := c.QueryParam(name)
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, message)
+	}
+	return &t, nil
+}
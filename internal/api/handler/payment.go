@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/payment"
+)
+
+type PaymentHandler struct {
+	service PaymentServiceInterface
+}
+
+func NewPaymentHandler(s PaymentServiceInterface) *PaymentHandler {
+	return &PaymentHandler{service: s}
+}
+
+type PaymentResponse struct {
+	ID                string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ReservationID     string    `json:"reservation_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Provider          string    `json:"provider" example:"fake"`
+	ProviderPaymentID string    `json:"provider_payment_id,omitempty" example:"fake_pay_550e8400"`
+	Amount            int       `json:"amount" example:"10000"`
+	Currency          string    `json:"currency" example:"JPY"`
+	Status            string    `json:"status" example:"captured"`
+	RefundedAmount    int       `json:"refunded_amount" example:"0"`
+	FailureReason     string    `json:"failure_reason,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+func toPaymentResponse(p *payment.Payment) PaymentResponse {
+	return PaymentResponse{
+		ID: p.ID, ReservationID: p.ReservationID,
+		Provider: p.Provider, ProviderPaymentID: p.ProviderPaymentID,
+		Amount: p.Amount, Currency: p.Currency,
+		Status: string(p.Status), RefundedAmount: p.RefundedAmount,
+		FailureReason: p.FailureReason, CreatedAt: p.CreatedAt, UpdatedAt: p.UpdatedAt,
+	}
+}
+
+// GetByReservation godoc
+// @Summary 予約の決済を取得
+// @Description 予約の最新の決済（状態・金額・失敗理由）を取得します
+// @Tags payments
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "予約ID"
+// @Success 200 {object} PaymentResponse
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string "決済が存在しない、または他のユーザーの予約"
+// @Router /reservations/{id}/payment [get]
+func (h *PaymentHandler) GetByReservation(c echo.Context) error {
+	principal := middleware.CurrentPrincipal(c)
+	if !principal.IsAuthenticated() {
+		return echo.NewHTTPError(http.StatusUnauthorized, "認証が必要です")
+	}
+	p, err := h.service.GetPaymentByReservation(c.Request().Context(), c.Param("id"), principal)
+	if err != nil {
+		return serviceError(err, http.StatusBadRequest)
+	}
+	return c.JSON(http.StatusOK, toPaymentResponse(p))
+}
+
+// Webhook godoc
+// @Summary 決済プロバイダーのWebhook
+// @Description プロバイダーからの通知の署名を検証し、決済の状態に反映します
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Param X-Payment-Signature header string true "Webhookの署名"
+// @Success 200 {object} PaymentResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string "署名が不正"
+// @Failure 404 {object} map[string]string
+// @Router /payments/webhook [post]
+func (h *PaymentHandler) Webhook(c echo.Context) error {
+	payload, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "無効なリクエスト")
+	}
+	p, err := h.service.HandleWebhook(c.Request().Context(), payload, c.Request().Header.Get("X-Payment-Signature"))
+	if err != nil {
+		return serviceError(err, http.StatusBadRequest)
+	}
+	return c.JSON(http.StatusOK, toPaymentResponse(p))
+}
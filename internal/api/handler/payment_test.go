@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/payment"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/reservation"
+)
+
+// MockPaymentService はPaymentServiceInterfaceのモック
+type MockPaymentService struct {
+	mock.Mock
+}
+
+func (m *MockPaymentService) GetPaymentByReservation(ctx context.Context, reservationID string, principal auth.Principal) (*payment.Payment, error) {
+	args := m.Called(ctx, reservationID, principal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payment.Payment), args.Error(1)
+}
+
+func (m *MockPaymentService) HandleWebhook(ctx context.Context, payload []byte, signature string) (*payment.Payment, error) {
+	args := m.Called(ctx, payload, signature)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payment.Payment), args.Error(1)
+}
+
+func TestPaymentHandler_GetByReservation(t *testing.T) {
+	e := NewTestEcho()
+
+	newRequest := func(principal auth.Principal) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/reservations/res-1/payment", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, principal)
+		c.SetParamNames("id")
+		c.SetParamValues("res-1")
+		return c, rec
+	}
+
+	t.Run("正常に決済を取得できる", func(t *testing.T) {
+		mockService := new(MockPaymentService)
+		mockService.On("GetPaymentByReservation", mock.Anything, "res-1", testCustomer).Return(&payment.Payment{
+			ID: "pay-1", ReservationID: "res-1", Provider: "fake", Amount: 5000, Currency: "JPY",
+			Status: payment.StatusCaptured, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+		}, nil)
+		handler := NewPaymentHandler(mockService)
+
+		c, rec := newRequest(testCustomer)
+		err := handler.GetByReservation(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp PaymentResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "captured", resp.Status)
+		assert.Equal(t, 5000, resp.Amount)
+	})
+
+	t.Run("決済がない場合404", func(t *testing.T) {
+		mockService := new(MockPaymentService)
+		mockService.On("GetPaymentByReservation", mock.Anything, "res-1", testCustomer).Return(nil, payment.ErrPaymentNotFound)
+		handler := NewPaymentHandler(mockService)
+
+		c, _ := newRequest(testCustomer)
+		err := handler.GetByReservation(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, he.Code)
+	})
+
+	t.Run("未認証の場合401", func(t *testing.T) {
+		mockService := new(MockPaymentService)
+		handler := NewPaymentHandler(mockService)
+
+		c, _ := newRequest(auth.Principal{})
+		err := handler.GetByReservation(c)
+
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, he.Code)
+		mockService.AssertNotCalled(t, "GetPaymentByReservation", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("他のユーザーの予約は404", func(t *testing.T) {
+		other := auth.NewPrincipal("user-999")
+		mockService := new(MockPaymentService)
+		mockService.On("GetPaymentByReservation", mock.Anything, "res-1", other).Return(nil, reservation.ErrReservationNotFound)
+		handler := NewPaymentHandler(mockService)
+
+		c, _ := newRequest(other)
+		err := handler.GetByReservation(c)
+
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, he.Code)
+	})
+
+	t.Run("管理者の役割をサービスに渡す", func(t *testing.T) {
+		admin := auth.NewPrincipal("admin-1", auth.RoleAdmin)
+		mockService := new(MockPaymentService)
+		mockService.On("GetPaymentByReservation", mock.Anything, "res-1", admin).Return(&payment.Payment{
+			ID: "pay-1", ReservationID: "res-1", Status: payment.StatusCaptured,
+		}, nil)
+		handler := NewPaymentHandler(mockService)
+
+		c, rec := newRequest(admin)
+		err := handler.GetByReservation(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestPaymentHandler_Webhook(t *testing.T) {
+	e := NewTestEcho()
+	body := `{"type":"payment.refunded","payment_id":"fake_pay_1"}`
+
+	newRequest := func() (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/payments/webhook", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("X-Payment-Signature", "sig")
+		rec := httptest.NewRecorder()
+		return e.NewContext(req, rec), rec
+	}
+
+	t.Run("署名付きの通知を反映できる", func(t *testing.T) {
+		mockService := new(MockPaymentService)
+		mockService.On("HandleWebhook", mock.Anything, []byte(body), "sig").Return(&payment.Payment{
+			ID: "pay-1", ReservationID: "res-1", Status: payment.StatusRefunded,
+		}, nil)
+		handler := NewPaymentHandler(mockService)
+
+		c, rec := newRequest()
+		err := handler.Webhook(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("署名が不正な場合401", func(t *testing.T) {
+		mockService := new(MockPaymentService)
+		mockService.On("HandleWebhook", mock.Anything, []byte(body), "sig").Return(nil, payment.ErrInvalidWebhookSignature)
+		handler := NewPaymentHandler(mockService)
+
+		c, _ := newRequest()
+		err := handler.Webhook(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, he.Code)
+	})
+}
@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/application"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pricecategory"
+)
+
+type PriceCategoryHandler struct {
+	service PriceCategoryServiceInterface
+}
+
+func NewPriceCategoryHandler(s PriceCategoryServiceInterface) *PriceCategoryHandler {
+	return &PriceCategoryHandler{service: s}
+}
+
+type PriceCategoryRequest struct {
+	Name     string `json:"name" validate:"required,max=100" example:"S席"`
+	Currency string `json:"currency" validate:"omitempty,len=3" example:"JPY"`
+	Amount   int    `json:"amount" validate:"min=0" example:"12000"`
+}
+
+type PriceCategoryResponse struct {
+	ID        string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	EventID   string    `json:"event_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name      string    `json:"name" example:"S席"`
+	Currency  string    `json:"currency" example:"JPY"`
+	Amount    int       `json:"amount" example:"12000"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func toPriceCategoryResponse(c *pricecategory.PriceCategory) PriceCategoryResponse {
+	return PriceCategoryResponse{
+		ID: c.ID, EventID: c.EventID, Name: c.Name,
+		Currency: c.Currency, Amount: c.Amount,
+		CreatedAt: c.CreatedAt, UpdatedAt: c.UpdatedAt,
+	}
+}
+
+// Create godoc
+// @Summary 価格カテゴリを作成
+// @Description イベントに価格カテゴリ（S席・A席など）を追加します
+// @Tags price-categories
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param event_id path string true "イベントID"
+// @Param request body PriceCategoryRequest true "価格カテゴリ情報"
+// @Success 201 {object} PriceCategoryResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string "同名のカテゴリが既に存在"
+// @Router /events/{event_id}/price-categories [post]
+func (h *PriceCategoryHandler) Create(c echo.Context) error {
+	eventID := c.Param("event_id")
+	var req PriceCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "無効なリクエスト")
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	pc, err := h.service.CreatePriceCategory(c.Request().Context(), application.CreatePriceCategoryInput{
+		EventID: eventID, Name: req.Name, Currency: req.Currency, Amount: req.Amount,
+		Principal: middleware.CurrentPrincipal(c),
+	})
+	if err != nil {
+		return serviceError(err, http.StatusBadRequest)
+	}
+	return c.JSON(http.StatusCreated, toPriceCategoryResponse(pc))
+}
+
+// List godoc
+// @Summary 価格カテゴリ一覧を取得
+// @Description イベントの価格カテゴリ一覧を金額の高い順に取得します
+// @Tags price-categories
+// @Produce json
+// @Param event_id path string true "イベントID"
+// @Success 200 {array} PriceCategoryResponse
+// @Router /events/{event_id}/price-categories [get]
+func (h *PriceCategoryHandler) List(c echo.Context) error {
+	eventID := c.Param("event_id")
+	categories, err := h.service.ListPriceCategories(c.Request().Context(), eventID)
+	if err != nil {
+		return serviceError(err, http.StatusInternalServerError)
+	}
+	resp := make([]PriceCategoryResponse, len(categories))
+	for i, pc := range categories {
+		resp[i] = toPriceCategoryResponse(pc)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetByID godoc
+// @Summary 価格カテゴリを取得
+// @Description 指定IDの価格カテゴリを取得します
+// @Tags price-categories
+// @Produce json
+// @Param event_id path string true "イベントID"
+// @Param id path string true "価格カテゴリID"
+// @Success 200 {object} PriceCategoryResponse
+// @Failure 404 {object} map[string]string
+// @Router /events/{event_id}/price-categories/{id} [get]
+func (h *PriceCategoryHandler) GetByID(c echo.Context) error {
+	pc, err := h.service.GetPriceCategory(c.Request().Context(), c.Param("event_id"), c.Param("id"))
+	if err != nil {
+		return serviceError(err, http.StatusBadRequest)
+	}
+	return c.JSON(http.StatusOK, toPriceCategoryResponse(pc))
+}
+
+// Update godoc
+// @Summary 価格カテゴリを更新
+// @Description 価格カテゴリを更新します。金額の変更は割り当て済みの座席にも反映されます
+// @Tags price-categories
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param event_id path string true "イベントID"
+// @Param id path string true "価格カテゴリID"
+// @Param request body PriceCategoryRequest true "価格カテゴリ情報"
+// @Success 200 {object} PriceCategoryResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string "同名のカテゴリが既に存在"
+// @Router /events/{event_id}/price-categories/{id} [put]
+func (h *PriceCategoryHandler) Update(c echo.Context) error {
+	var req PriceCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "無効なリクエスト")
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	pc, err := h.service.UpdatePriceCategory(c.Request().Context(), application.UpdatePriceCategoryInput{
+		ID: c.Param("id"), EventID: c.Param("event_id"),
+		Name: req.Name, Currency: req.Currency, Amount: req.Amount,
+		Principal: middleware.CurrentPrincipal(c),
+	})
+	if err != nil {
+		return serviceError(err, http.StatusBadRequest)
+	}
+	return c.JSON(http.StatusOK, toPriceCategoryResponse(pc))
+}
+
+// Delete godoc
+// @Summary 価格カテゴリを削除
+// @Description 価格カテゴリを削除します。座席に割り当て済みのカテゴリは削除できません
+// @Tags price-categories
+// @Security BearerAuth
+// @Param event_id path string true "イベントID"
+// @Param id path string true "価格カテゴリID"
+// @Success 204
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string "座席に割り当て済み"
+// @Router /events/{event_id}/price-categories/{id} [delete]
+func (h *PriceCategoryHandler) Delete(c echo.Context) error {
+	if err := h.service.DeletePriceCategory(c.Request().Context(), c.Param("event_id"), c.Param("id"), middleware.CurrentPrincipal(c)); err != nil {
+		return serviceError(err, http.StatusBadRequest)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
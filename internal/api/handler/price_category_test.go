@@ -0,0 +1,252 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/application"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pricecategory"
+)
+
+// MockPriceCategoryService はPriceCategoryServiceInterfaceのモック
+type MockPriceCategoryService struct {
+	mock.Mock
+}
+
+func (m *MockPriceCategoryService) CreatePriceCategory(ctx context.Context, input application.CreatePriceCategoryInput) (*pricecategory.PriceCategory, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pricecategory.PriceCategory), args.Error(1)
+}
+
+func (m *MockPriceCategoryService) GetPriceCategory(ctx context.Context, eventID, id string) (*pricecategory.PriceCategory, error) {
+	args := m.Called(ctx, eventID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pricecategory.PriceCategory), args.Error(1)
+}
+
+func (m *MockPriceCategoryService) ListPriceCategories(ctx context.Context, eventID string) ([]*pricecategory.PriceCategory, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pricecategory.PriceCategory), args.Error(1)
+}
+
+func (m *MockPriceCategoryService) UpdatePriceCategory(ctx context.Context, input application.UpdatePriceCategoryInput) (*pricecategory.PriceCategory, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pricecategory.PriceCategory), args.Error(1)
+}
+
+func (m *MockPriceCategoryService) DeletePriceCategory(ctx context.Context, eventID, id string, principal auth.Principal) error {
+	args := m.Called(ctx, eventID, id, principal)
+	return args.Error(0)
+}
+
+func TestPriceCategoryHandler_Create(t *testing.T) {
+	e := NewTestEcho()
+
+	t.Run("正常に作成できる", func(t *testing.T) {
+		mockService := new(MockPriceCategoryService)
+		now := time.Now()
+		mockService.On("CreatePriceCategory", mock.Anything, application.CreatePriceCategoryInput{
+			EventID: "event-123", Name: "S席", Currency: "JPY", Amount: 12000,
+			Principal: testOrganizer,
+		}).Return(&pricecategory.PriceCategory{
+			ID: "cat-1", EventID: "event-123", Name: "S席", Currency: "JPY", Amount: 12000,
+			CreatedAt: now, UpdatedAt: now,
+		}, nil)
+
+		handler := NewPriceCategoryHandler(mockService)
+
+		reqBody := `{"name":"S席","currency":"JPY","amount":12000}`
+		req := httptest.NewRequest(http.MethodPost, "/events/event-123/price-categories", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testOrganizer)
+		c.SetParamNames("event_id")
+		c.SetParamValues("event-123")
+
+		err := handler.Create(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var resp PriceCategoryResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "cat-1", resp.ID)
+		assert.Equal(t, 12000, resp.Amount)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("名前が空の場合バリデーションエラー", func(t *testing.T) {
+		mockService := new(MockPriceCategoryService)
+		handler := NewPriceCategoryHandler(mockService)
+
+		reqBody := `{"name":"","amount":12000}`
+		req := httptest.NewRequest(http.MethodPost, "/events/event-123/price-categories", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("event_id")
+		c.SetParamValues("event-123")
+
+		err := handler.Create(c)
+
+		require.Error(t, err)
+		mockService.AssertNotCalled(t, "CreatePriceCategory")
+	})
+
+	t.Run("同名カテゴリが存在する場合409", func(t *testing.T) {
+		mockService := new(MockPriceCategoryService)
+		mockService.On("CreatePriceCategory", mock.Anything, mock.Anything).Return(nil, pricecategory.ErrDuplicateName)
+
+		handler := NewPriceCategoryHandler(mockService)
+
+		reqBody := `{"name":"S席","amount":12000}`
+		req := httptest.NewRequest(http.MethodPost, "/events/event-123/price-categories", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("event_id")
+		c.SetParamValues("event-123")
+
+		err := handler.Create(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusConflict, he.Code)
+	})
+}
+
+func TestPriceCategoryHandler_List(t *testing.T) {
+	e := NewTestEcho()
+	mockService := new(MockPriceCategoryService)
+	mockService.On("ListPriceCategories", mock.Anything, "event-123").Return([]*pricecategory.PriceCategory{
+		{ID: "cat-1", EventID: "event-123", Name: "S席", Currency: "JPY", Amount: 12000},
+		{ID: "cat-2", EventID: "event-123", Name: "A席", Currency: "JPY", Amount: 8000},
+	}, nil)
+
+	handler := NewPriceCategoryHandler(mockService)
+
+	req := httptest.NewRequest(http.MethodGet, "/events/event-123/price-categories", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("event_id")
+	c.SetParamValues("event-123")
+
+	err := handler.List(c)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp []PriceCategoryResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Len(t, resp, 2)
+}
+
+func TestPriceCategoryHandler_Update_NotFound(t *testing.T) {
+	e := NewTestEcho()
+	mockService := new(MockPriceCategoryService)
+	mockService.On("UpdatePriceCategory", mock.Anything, mock.Anything).Return(nil, pricecategory.ErrPriceCategoryNotFound)
+
+	handler := NewPriceCategoryHandler(mockService)
+
+	reqBody := `{"name":"S席","amount":15000}`
+	req := httptest.NewRequest(http.MethodPut, "/events/event-123/price-categories/nonexistent", strings.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("event_id", "id")
+	c.SetParamValues("event-123", "nonexistent")
+
+	err := handler.Update(c)
+
+	require.Error(t, err)
+	he, ok := err.(*echo.HTTPError)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusNotFound, he.Code)
+}
+
+func TestPriceCategoryHandler_Delete(t *testing.T) {
+	e := NewTestEcho()
+
+	t.Run("正常に削除できる", func(t *testing.T) {
+		mockService := new(MockPriceCategoryService)
+		mockService.On("DeletePriceCategory", mock.Anything, "event-123", "cat-1", testOrganizer).Return(nil)
+
+		handler := NewPriceCategoryHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodDelete, "/events/event-123/price-categories/cat-1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testOrganizer)
+		c.SetParamNames("event_id", "id")
+		c.SetParamValues("event-123", "cat-1")
+
+		err := handler.Delete(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("座席に割り当て済みの場合409", func(t *testing.T) {
+		mockService := new(MockPriceCategoryService)
+		mockService.On("DeletePriceCategory", mock.Anything, "event-123", "cat-1", testOrganizer).Return(pricecategory.ErrPriceCategoryInUse)
+
+		handler := NewPriceCategoryHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodDelete, "/events/event-123/price-categories/cat-1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testOrganizer)
+		c.SetParamNames("event_id", "id")
+		c.SetParamValues("event-123", "cat-1")
+
+		err := handler.Delete(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusConflict, he.Code)
+	})
+
+	t.Run("主催者以外の場合は認可エラーをそのまま返す", func(t *testing.T) {
+		mockService := new(MockPriceCategoryService)
+		mockService.On("DeletePriceCategory", mock.Anything, "event-123", "cat-1", auth.Principal{}).Return(auth.ErrUnauthenticated)
+
+		handler := NewPriceCategoryHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodDelete, "/events/event-123/price-categories/cat-1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("event_id", "id")
+		c.SetParamValues("event-123", "cat-1")
+
+		err := handler.Delete(c)
+
+		assert.ErrorIs(t, err, auth.ErrUnauthenticated)
+	})
+}
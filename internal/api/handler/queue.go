@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/queue"
+)
+
+type QueueHandler struct {
+	service QueueServiceInterface
+}
+
+func NewQueueHandler(s QueueServiceInterface) *QueueHandler {
+	return &QueueHandler{service: s}
+}
+
+type QueueTicketResponse struct {
+	Token         string     `json:"token" example:"550e8400-e29b-41d4-a716-446655440000"`
+	EventID       string     `json:"event_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Status        string     `json:"status" example:"waiting"`
+	Position      int        `json:"position,omitempty" example:"120"`
+	AdmittedUntil *time.Time `json:"admitted_until,omitempty"`
+}
+
+func toQueueTicketResponse(t *queue.Ticket) QueueTicketResponse {
+	return QueueTicketResponse{
+		Token: t.Token, EventID: t.EventID, Status: string(t.Status),
+		Position: t.Position, AdmittedUntil: t.AdmittedUntil,
+	}
+}
+
+// Join godoc
+// @Summary 待合室に並ぶ
+// @Description 待合室が有効なイベントの待合室に並び、チケットを発行します。入場が許可されたら X-Queue-Token ヘッダーにトークンを付けて予約できます
+// @Tags queue
+// @Produce json
+// @Security BearerAuth
+// @Param event_id path string true "イベントID"
+// @Success 201 {object} QueueTicketResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /events/{event_id}/queue [post]
+func (h *QueueHandler) Join(c echo.Context) error {
+	userID := middleware.UserID(c)
+	if userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "認証が必要です")
+	}
+	t, err := h.service.JoinQueue(c.Request().Context(), c.Param("event_id"), userID)
+	if err != nil {
+		return serviceError(err, http.StatusBadRequest)
+	}
+	return c.JSON(http.StatusCreated, toQueueTicketResponse(t))
+}
+
+// GetByToken godoc
+// @Summary 待合室の状況を取得
+// @Description チケットの状態（waiting/admitted/expired）と入場待ちの順位、入場許可の期限を取得します
+// @Tags queue
+// @Produce json
+// @Param event_id path string true "イベントID"
+// @Param token path string true "チケットのトークン"
+// @Success 200 {object} QueueTicketResponse
+// @Failure 404 {object} map[string]string
+// @Router /events/{event_id}/queue/{token} [get]
+func (h *QueueHandler) GetByToken(c echo.Context) error {
+	t, err := h.service.GetTicket(c.Request().Context(), c.Param("event_id"), c.Param("token"))
+	if err != nil {
+		return serviceError(err, http.StatusBadRequest)
+	}
+	return c.JSON(http.StatusOK, toQueueTicketResponse(t))
+}
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/queue"
+)
+
+// MockQueueService はQueueServiceInterfaceのモック
+type MockQueueService struct {
+	mock.Mock
+}
+
+func (m *MockQueueService) JoinQueue(ctx context.Context, eventID, userID string) (*queue.Ticket, error) {
+	args := m.Called(ctx, eventID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*queue.Ticket), args.Error(1)
+}
+
+func (m *MockQueueService) GetTicket(ctx context.Context, eventID, token string) (*queue.Ticket, error) {
+	args := m.Called(ctx, eventID, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*queue.Ticket), args.Error(1)
+}
+
+func TestQueueHandler_Join(t *testing.T) {
+	e := NewTestEcho()
+
+	newRequest := func(userID string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/events/event-1/queue", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		if userID != "" {
+			middleware.SetUserID(c, userID)
+		}
+		c.SetParamNames("event_id")
+		c.SetParamValues("event-1")
+		return c, rec
+	}
+
+	t.Run("正常に待合室に並べる", func(t *testing.T) {
+		mockService := new(MockQueueService)
+		mockService.On("JoinQueue", mock.Anything, "event-1", "user-1").Return(&queue.Ticket{
+			Token: "token-1", EventID: "event-1", UserID: "user-1",
+			Status: queue.StatusWaiting, Position: 3,
+		}, nil)
+		handler := NewQueueHandler(mockService)
+
+		c, rec := newRequest("user-1")
+		err := handler.Join(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var resp QueueTicketResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "token-1", resp.Token)
+		assert.Equal(t, "waiting", resp.Status)
+		assert.Equal(t, 3, resp.Position)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("ユーザーIDがない場合401", func(t *testing.T) {
+		handler := NewQueueHandler(new(MockQueueService))
+
+		c, _ := newRequest("")
+		err := handler.Join(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, he.Code)
+	})
+
+	t.Run("待合室が無効なイベントの場合400", func(t *testing.T) {
+		mockService := new(MockQueueService)
+		mockService.On("JoinQueue", mock.Anything, "event-1", "user-1").Return(nil, queue.ErrWaitingRoomDisabled)
+		handler := NewQueueHandler(mockService)
+
+		c, _ := newRequest("user-1")
+		err := handler.Join(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, he.Code)
+	})
+
+	t.Run("イベントが見つからない場合404", func(t *testing.T) {
+		mockService := new(MockQueueService)
+		mockService.On("JoinQueue", mock.Anything, "event-1", "user-1").Return(nil, event.ErrEventNotFound)
+		handler := NewQueueHandler(mockService)
+
+		c, _ := newRequest("user-1")
+		err := handler.Join(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, he.Code)
+	})
+}
+
+func TestQueueHandler_GetByToken(t *testing.T) {
+	e := NewTestEcho()
+
+	newRequest := func() (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/events/event-1/queue/token-1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("event_id", "token")
+		c.SetParamValues("event-1", "token-1")
+		return c, rec
+	}
+
+	t.Run("入場許可済みのチケットを取得できる", func(t *testing.T) {
+		until := time.Now().Add(10 * time.Minute)
+		mockService := new(MockQueueService)
+		mockService.On("GetTicket", mock.Anything, "event-1", "token-1").Return(&queue.Ticket{
+			Token: "token-1", EventID: "event-1", UserID: "user-1",
+			Status: queue.StatusAdmitted, AdmittedUntil: &until,
+		}, nil)
+		handler := NewQueueHandler(mockService)
+
+		c, rec := newRequest()
+		err := handler.GetByToken(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp QueueTicketResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "admitted", resp.Status)
+		assert.NotNil(t, resp.AdmittedUntil)
+	})
+
+	t.Run("チケットが見つからない場合404", func(t *testing.T) {
+		mockService := new(MockQueueService)
+		mockService.On("GetTicket", mock.Anything, "event-1", "token-1").Return(nil, queue.ErrTicketNotFound)
+		handler := NewQueueHandler(mockService)
+
+		c, _ := newRequest()
+		err := handler.GetByToken(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, he.Code)
+	})
+}
@@ -1,13 +1,13 @@
 package handler
 
 import (
-	"errors"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/application"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/reservation"
 )
@@ -26,16 +26,36 @@ type CreateReservationRequest struct {
 	IdempotencyKey string   `json:"idempotency_key" validate:"required" example:"order-2025-001"`
 }
 
+type BestAvailableRequest struct {
+	EventID         string `json:"event_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Quantity        int    `json:"quantity" validate:"required,min=1,max=10" example:"2"`
+	PriceCategoryID string `json:"price_category_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Section         string `json:"section,omitempty" example:"ARENA"`
+	Adjacent        bool   `json:"adjacent" example:"true"`
+	IdempotencyKey  string `json:"idempotency_key" validate:"required" example:"order-2025-002"`
+}
+
+type ConfirmReservationRequest struct {
+	PaymentToken string `json:"payment_token" example:"tok_visa"`
+}
+
+type RemoveSeatsRequest struct {
+	SeatIDs []string `json:"seat_ids" validate:"required,min=1" example:"seat-A2"`
+}
+
 type ReservationResponse struct {
-	ID          string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	EventID     string     `json:"event_id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	UserID      string     `json:"user_id" example:"user-123"`
-	SeatIDs     []string   `json:"seat_ids" example:"seat-A1,seat-A2"`
-	Status      string     `json:"status" example:"pending"`
-	TotalAmount int        `json:"total_amount" example:"10000"`
-	ExpiresAt   time.Time  `json:"expires_at"`
-	ConfirmedAt *time.Time `json:"confirmed_at,omitempty"`
-	CreatedAt   time.Time  `json:"created_at"`
+	ID             string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	EventID        string     `json:"event_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID         string     `json:"user_id" example:"user-123"`
+	SeatIDs        []string   `json:"seat_ids" example:"seat-A1,seat-A2"`
+	Status         string     `json:"status" example:"pending"`
+	TotalAmount    int        `json:"total_amount" example:"10000"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	ExtensionCount int        `json:"extension_count" example:"0"`
+	ConfirmedAt    *time.Time `json:"confirmed_at,omitempty"`
+	RefundedAmount int        `json:"refunded_amount,omitempty" example:"10000"`
+	RefundedAt     *time.Time `json:"refunded_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
 }
 
 func toReservationResponse(r *reservation.Reservation) ReservationResponse {
@@ -43,27 +63,33 @@ func toReservationResponse(r *reservation.Reservation) ReservationResponse {
 		ID: r.ID, EventID: r.EventID, UserID: r.UserID,
 		SeatIDs: r.SeatIDs, Status: string(r.Status),
 		TotalAmount: r.TotalAmount, ExpiresAt: r.ExpiresAt,
-		ConfirmedAt: r.ConfirmedAt, CreatedAt: r.CreatedAt,
+		ExtensionCount: r.ExtensionCount,
+		ConfirmedAt:    r.ConfirmedAt, CreatedAt: r.CreatedAt,
+		RefundedAmount: r.RefundedAmount, RefundedAt: r.RefundedAt,
 	}
 }
 
 // Create godoc
 // @Summary 予約を作成
-// @Description 座席を仮押さえします（15分間有効）
+// @Description 座席を仮押さえします（有効期間はイベントの仮押さえ設定に従う。デフォルト15分）
 // @Tags reservations
 // @Accept json
 // @Produce json
-// @Param X-User-ID header string true "ユーザーID"
+// @Security BearerAuth
+// @Param X-Queue-Token header string false "待合室のチケット（待合室が有効なイベントで必須）"
 // @Param request body CreateReservationRequest true "予約情報"
 // @Success 201 {object} ReservationResponse
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
-// @Failure 409 {object} map[string]string "座席が既に予約済み"
+// @Failure 403 {object} map[string]string "待合室からの入場が許可されていない"
+// @Failure 409 {object} map[string]string "座席が既に予約済み、または購入枚数の上限を超過"
+// @Failure 423 {object} map[string]string "他のユーザーが同じ座席を処理中"
+// @Failure 429 {object} map[string]string "リクエスト数の上限を超過（Retry-After ヘッダーを参照）"
 // @Router /reservations [post]
 func (h *ReservationHandler) Create(c echo.Context) error {
-	userID := c.Request().Header.Get("X-User-ID")
+	userID := middleware.UserID(c)
 	if userID == "" {
-		return echo.NewHTTPError(http.StatusUnauthorized, "ユーザーIDが必要です")
+		return echo.NewHTTPError(http.StatusUnauthorized, "認証が必要です")
 	}
 	var req CreateReservationRequest
 	if err := c.Bind(&req); err != nil {
@@ -74,9 +100,49 @@ func (h *ReservationHandler) Create(c echo.Context) error {
 	}
 	r, err := h.service.CreateReservation(c.Request().Context(), application.CreateReservationInput{
 		EventID: req.EventID, UserID: userID, SeatIDs: req.SeatIDs, IdempotencyKey: req.IdempotencyKey,
+		QueueToken: c.Request().Header.Get("X-Queue-Token"),
+	})
+	if err != nil {
+		return serviceError(err, http.StatusBadRequest)
+	}
+	return c.JSON(http.StatusCreated, toReservationResponse(r))
+}
+
+// BestAvailable godoc
+// @Summary 最適な座席を自動で予約
+// @Description 条件（価格カテゴリ・セクション・連続席）に合う最適な空席ブロックを選んで仮押さえします
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param X-Queue-Token header string false "待合室のチケット（待合室が有効なイベントで必須）"
+// @Param request body BestAvailableRequest true "予約条件"
+// @Success 201 {object} ReservationResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string "待合室からの入場が許可されていない"
+// @Failure 409 {object} map[string]string "条件に合う空席がない、または購入枚数の上限を超過"
+// @Failure 429 {object} map[string]string "リクエスト数の上限を超過（Retry-After ヘッダーを参照）"
+// @Router /reservations/best-available [post]
+func (h *ReservationHandler) BestAvailable(c echo.Context) error {
+	userID := middleware.UserID(c)
+	if userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "認証が必要です")
+	}
+	var req BestAvailableRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "無効なリクエスト")
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	r, err := h.service.ReserveBestAvailable(c.Request().Context(), application.BestAvailableInput{
+		EventID: req.EventID, UserID: userID, Quantity: req.Quantity,
+		PriceCategoryID: req.PriceCategoryID, Section: req.Section, Adjacent: req.Adjacent,
+		IdempotencyKey: req.IdempotencyKey, QueueToken: c.Request().Header.Get("X-Queue-Token"),
 	})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		return serviceError(err, http.StatusBadRequest)
 	}
 	return c.JSON(http.StatusCreated, toReservationResponse(r))
 }
@@ -86,43 +152,88 @@ func (h *ReservationHandler) Create(c echo.Context) error {
 // @Description 指定IDの予約を取得します
 // @Tags reservations
 // @Produce json
+// @Security BearerAuth
 // @Param id path string true "予約ID"
 // @Success 200 {object} ReservationResponse
-// @Failure 404 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string "存在しない、または他のユーザーの予約"
 // @Router /reservations/{id} [get]
 func (h *ReservationHandler) GetByID(c echo.Context) error {
+	principal := middleware.CurrentPrincipal(c)
+	if !principal.IsAuthenticated() {
+		return echo.NewHTTPError(http.StatusUnauthorized, "認証が必要です")
+	}
 	id := c.Param("id")
-	r, err := h.service.GetReservation(c.Request().Context(), id)
+	r, err := h.service.GetReservation(c.Request().Context(), id, principal)
 	if err != nil {
-		if errors.Is(err, reservation.ErrReservationNotFound) {
-			return echo.NewHTTPError(http.StatusNotFound, err.Error())
-		}
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return serviceError(err, http.StatusInternalServerError)
 	}
 	return c.JSON(http.StatusOK, toReservationResponse(r))
 }
 
+// ReservationListResponse は予約一覧のレスポンス
+type ReservationListResponse struct {
+	Data       []ReservationResponse `json:"data"`
+	Pagination PaginationResponse    `json:"pagination"`
+}
+
 // GetUserReservations godoc
 // @Summary ユーザーの予約一覧を取得
-// @Description ログインユーザーの予約一覧を取得します
+// @Description ログインユーザーの予約一覧を作成日時の新しい順に取得します。続きは pagination.next_cursor を cursor に指定して取得します
 // @Tags reservations
 // @Produce json
-// @Param X-User-ID header string true "ユーザーID"
-// @Param limit query int false "取得件数" default(20)
-// @Param offset query int false "オフセット" default(0)
-// @Success 200 {array} ReservationResponse
+// @Security BearerAuth
+// @Param cursor query string false "前のページの next_cursor"
+// @Param limit query int false "取得件数（最大100）" default(20)
+// @Param status query string false "予約の状態" Enums(pending, confirmed, cancelled, refund_pending, refunded)
+// @Param event_id query string false "イベントID"
+// @Success 200 {object} ReservationListResponse
+// @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
 // @Router /reservations [get]
 func (h *ReservationHandler) GetUserReservations(c echo.Context) error {
-	userID := c.Request().Header.Get("X-User-ID")
+	userID := middleware.UserID(c)
 	if userID == "" {
-		return echo.NewHTTPError(http.StatusUnauthorized, "ユーザーIDが必要です")
+		return echo.NewHTTPError(http.StatusUnauthorized, "認証が必要です")
+	}
+	limit, _ := strconv.Atoi(c.QueryParam("limit"))
+	page, err := h.service.GetUserReservations(c.Request().Context(), application.ListUserReservationsInput{
+		UserID:  userID,
+		Status:  reservation.Status(c.QueryParam("status")),
+		EventID: c.QueryParam("event_id"),
+		Cursor:  c.QueryParam("cursor"),
+		Limit:   limit,
+	})
+	if err != nil {
+		return serviceError(err, http.StatusInternalServerError)
 	}
+	resp := make([]ReservationResponse, len(page.Items))
+	for i, r := range page.Items {
+		resp[i] = toReservationResponse(r)
+	}
+	return c.JSON(http.StatusOK, ReservationListResponse{Data: resp, Pagination: toPaginationResponse(page)})
+}
+
+// GetEventReservations godoc
+// @Summary イベントの予約一覧を取得
+// @Description イベントの予約一覧を取得します。イベントの主催者または管理者のみ取得できます
+// @Tags reservations
+// @Produce json
+// @Security BearerAuth
+// @Param event_id path string true "イベントID"
+// @Param limit query int false "取得件数" default(20)
+// @Param offset query int false "オフセット" default(0)
+// @Success 200 {array} ReservationResponse
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /events/{event_id}/reservations [get]
+func (h *ReservationHandler) GetEventReservations(c echo.Context) error {
 	limit, _ := strconv.Atoi(c.QueryParam("limit"))
 	offset, _ := strconv.Atoi(c.QueryParam("offset"))
-	reservations, err := h.service.GetUserReservations(c.Request().Context(), userID, limit, offset)
+	reservations, err := h.service.GetEventReservations(c.Request().Context(), c.Param("event_id"), middleware.CurrentPrincipal(c), limit, offset)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return serviceError(err, http.StatusInternalServerError)
 	}
 	resp := make([]ReservationResponse, len(reservations))
 	for i, r := range reservations {
@@ -133,22 +244,99 @@ func (h *ReservationHandler) GetUserReservations(c echo.Context) error {
 
 // Confirm godoc
 // @Summary 予約を確定
-// @Description 仮押さえ中の予約を確定します
+// @Description 決済の売上確定後に、仮押さえ中の予約を確定します
 // @Tags reservations
+// @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path string true "予約ID"
+// @Param request body ConfirmReservationRequest false "決済情報"
 // @Success 200 {object} ReservationResponse
 // @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 402 {object} map[string]string "決済が拒否された"
+// @Failure 404 {object} map[string]string "存在しない、または他のユーザーの予約"
+// @Failure 504 {object} map[string]string "決済プロバイダーのタイムアウト"
 // @Router /reservations/{id}/confirm [post]
 func (h *ReservationHandler) Confirm(c echo.Context) error {
+	principal := middleware.CurrentPrincipal(c)
+	if !principal.IsAuthenticated() {
+		return echo.NewHTTPError(http.StatusUnauthorized, "認証が必要です")
+	}
+	id := c.Param("id")
+	var req ConfirmReservationRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "無効なリクエスト")
+	}
+	r, err := h.service.ConfirmReservation(c.Request().Context(), application.ConfirmReservationInput{
+		ReservationID: id, PaymentToken: req.PaymentToken, Principal: principal,
+	})
+	if err != nil {
+		return serviceError(err, http.StatusBadRequest)
+	}
+	return c.JSON(http.StatusOK, toReservationResponse(r))
+}
+
+// Extend godoc
+// @Summary 仮押さえを延長
+// @Description 保留中予約の有効期限をイベントの仮押さえ設定に従って延長します
+// @Tags reservations
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "予約ID"
+// @Success 200 {object} ReservationResponse
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string "存在しない、または他のユーザーの予約"
+// @Failure 409 {object} map[string]string "延長回数・最大期間の上限超過、期限切れ"
+// @Router /reservations/{id}/extend [post]
+func (h *ReservationHandler) Extend(c echo.Context) error {
+	principal := middleware.CurrentPrincipal(c)
+	if !principal.IsAuthenticated() {
+		return echo.NewHTTPError(http.StatusUnauthorized, "認証が必要です")
+	}
 	id := c.Param("id")
-	r, err := h.service.ConfirmReservation(c.Request().Context(), id)
+	r, err := h.service.ExtendReservation(c.Request().Context(), id, principal)
+	if err != nil {
+		return serviceError(err, http.StatusBadRequest)
+	}
+	return c.JSON(http.StatusOK, toReservationResponse(r))
+}
+
+// RemoveSeats godoc
+// @Summary 予約から座席を外す
+// @Description 保留中または確定済みの予約から指定した座席だけを解放し、合計金額を再計算します（確定済みの場合は減額分を返金ポリシーに従って返金）
+// @Tags reservations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "予約ID"
+// @Param request body RemoveSeatsRequest true "外す座席"
+// @Success 200 {object} ReservationResponse
+// @Failure 400 {object} map[string]string "予約に含まれない座席、全座席の指定、キャンセル済み・期限切れの予約"
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string "存在しない、または他のユーザーの予約"
+// @Failure 409 {object} map[string]string "確定済みの予約で返金期間を過ぎている"
+// @Failure 504 {object} map[string]string "決済プロバイダーがタイムアウト"
+// @Router /reservations/{id}/seats/remove [post]
+func (h *ReservationHandler) RemoveSeats(c echo.Context) error {
+	principal := middleware.CurrentPrincipal(c)
+	if !principal.IsAuthenticated() {
+		return echo.NewHTTPError(http.StatusUnauthorized, "認証が必要です")
+	}
+	var req RemoveSeatsRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "リクエストの形式が不正です")
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	r, err := h.service.RemoveSeats(c.Request().Context(), application.RemoveSeatsInput{
+		ReservationID: c.Param("id"),
+		SeatIDs:       req.SeatIDs,
+		Principal:     principal,
+	})
 	if err != nil {
-		if errors.Is(err, reservation.ErrReservationNotFound) {
-			return echo.NewHTTPError(http.StatusNotFound, err.Error())
-		}
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		return serviceError(err, http.StatusBadRequest)
 	}
 	return c.JSON(http.StatusOK, toReservationResponse(r))
 }
@@ -158,19 +346,49 @@ func (h *ReservationHandler) Confirm(c echo.Context) error {
 // @Description 予約をキャンセルし、座席を解放します
 // @Tags reservations
 // @Produce json
+// @Security BearerAuth
 // @Param id path string true "予約ID"
 // @Success 200 {object} ReservationResponse
 // @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string "存在しない、または他のユーザーの予約"
 // @Router /reservations/{id}/cancel [post]
 func (h *ReservationHandler) Cancel(c echo.Context) error {
+	principal := middleware.CurrentPrincipal(c)
+	if !principal.IsAuthenticated() {
+		return echo.NewHTTPError(http.StatusUnauthorized, "認証が必要です")
+	}
+	id := c.Param("id")
+	r, err := h.service.CancelReservation(c.Request().Context(), id, principal)
+	if err != nil {
+		return serviceError(err, http.StatusBadRequest)
+	}
+	return c.JSON(http.StatusOK, toReservationResponse(r))
+}
+
+// Refund godoc
+// @Summary 予約を返金
+// @Description 確定済みの予約をイベントの返金ポリシーに従って返金し、座席を解放します（開始が近いほど返金額が減り、締切後は返金できません）
+// @Tags reservations
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "予約ID"
+// @Success 200 {object} ReservationResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string "存在しない、または他のユーザーの予約"
+// @Failure 409 {object} map[string]string "予約が確定済みでない、返金済み、または返金の受付期間外"
+// @Failure 504 {object} map[string]string "決済プロバイダーがタイムアウト"
+// @Router /reservations/{id}/refund [post]
+func (h *ReservationHandler) Refund(c echo.Context) error {
+	principal := middleware.CurrentPrincipal(c)
+	if !principal.IsAuthenticated() {
+		return echo.NewHTTPError(http.StatusUnauthorized, "認証が必要です")
+	}
 	id := c.Param("id")
-	r, err := h.service.CancelReservation(c.Request().Context(), id)
+	r, err := h.service.RefundReservation(c.Request().Context(), id, principal)
 	if err != nil {
-		if errors.Is(err, reservation.ErrReservationNotFound) {
-			return echo.NewHTTPError(http.StatusNotFound, err.Error())
-		}
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		return serviceError(err, http.StatusBadRequest)
 	}
 	return c.JSON(http.StatusOK, toReservationResponse(r))
 }
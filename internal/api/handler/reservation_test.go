@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -15,8 +16,15 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/application"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pagination"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/payment"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/queue"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/reservation"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
 )
 
 // MockReservationService はReservationServiceInterfaceのモック
@@ -32,32 +40,72 @@ func (m *MockReservationService) CreateReservation(ctx context.Context, input ap
 	return args.Get(0).(*reservation.Reservation), args.Error(1)
 }
 
-func (m *MockReservationService) GetReservation(ctx context.Context, id string) (*reservation.Reservation, error) {
-	args := m.Called(ctx, id)
+func (m *MockReservationService) ReserveBestAvailable(ctx context.Context, input application.BestAvailableInput) (*reservation.Reservation, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reservation.Reservation), args.Error(1)
+}
+
+func (m *MockReservationService) GetReservation(ctx context.Context, id string, principal auth.Principal) (*reservation.Reservation, error) {
+	args := m.Called(ctx, id, principal)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*reservation.Reservation), args.Error(1)
 }
 
-func (m *MockReservationService) GetUserReservations(ctx context.Context, userID string, limit, offset int) ([]*reservation.Reservation, error) {
-	args := m.Called(ctx, userID, limit, offset)
+func (m *MockReservationService) GetUserReservations(ctx context.Context, input application.ListUserReservationsInput) (*pagination.Page[*reservation.Reservation], error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pagination.Page[*reservation.Reservation]), args.Error(1)
+}
+
+func (m *MockReservationService) GetEventReservations(ctx context.Context, eventID string, principal auth.Principal, limit, offset int) ([]*reservation.Reservation, error) {
+	args := m.Called(ctx, eventID, principal, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*reservation.Reservation), args.Error(1)
 }
 
-func (m *MockReservationService) ConfirmReservation(ctx context.Context, id string) (*reservation.Reservation, error) {
-	args := m.Called(ctx, id)
+func (m *MockReservationService) ConfirmReservation(ctx context.Context, input application.ConfirmReservationInput) (*reservation.Reservation, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reservation.Reservation), args.Error(1)
+}
+
+func (m *MockReservationService) ExtendReservation(ctx context.Context, id string, principal auth.Principal) (*reservation.Reservation, error) {
+	args := m.Called(ctx, id, principal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reservation.Reservation), args.Error(1)
+}
+
+func (m *MockReservationService) CancelReservation(ctx context.Context, id string, principal auth.Principal) (*reservation.Reservation, error) {
+	args := m.Called(ctx, id, principal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reservation.Reservation), args.Error(1)
+}
+
+func (m *MockReservationService) RefundReservation(ctx context.Context, id string, principal auth.Principal) (*reservation.Reservation, error) {
+	args := m.Called(ctx, id, principal)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*reservation.Reservation), args.Error(1)
 }
 
-func (m *MockReservationService) CancelReservation(ctx context.Context, id string) (*reservation.Reservation, error) {
-	args := m.Called(ctx, id)
+func (m *MockReservationService) RemoveSeats(ctx context.Context, input application.RemoveSeatsInput) (*reservation.Reservation, error) {
+	args := m.Called(ctx, input)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -69,6 +117,9 @@ func (m *MockReservationService) CancelExpiredReservations(ctx context.Context,
 	return args.Int(0), args.Error(1)
 }
 
+// testCustomer はテストで使う予約（UserID: user-123）の所有者
+var testCustomer = auth.NewPrincipal("user-123")
+
 func TestReservationHandler_Create(t *testing.T) {
 	e := NewTestEcho()
 
@@ -100,9 +151,9 @@ func TestReservationHandler_Create(t *testing.T) {
 		}`
 		req := httptest.NewRequest(http.MethodPost, "/reservations", strings.NewReader(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
-		req.Header.Set("X-User-ID", "user-123")
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
+		middleware.SetUserID(c, "user-123")
 
 		err := handler.Create(c)
 
@@ -125,7 +176,7 @@ func TestReservationHandler_Create(t *testing.T) {
 		reqBody := `{"event_id": "event-123", "seat_ids": ["seat-1"], "idempotency_key": "idem-key"}`
 		req := httptest.NewRequest(http.MethodPost, "/reservations", strings.NewReader(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
-		// X-User-ID ヘッダーなし
+		// 未認証
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
@@ -143,16 +194,196 @@ func TestReservationHandler_Create(t *testing.T) {
 
 		req := httptest.NewRequest(http.MethodPost, "/reservations", strings.NewReader("invalid"))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
-		req.Header.Set("X-User-ID", "user-123")
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
+		middleware.SetUserID(c, "user-123")
+
+		err := handler.Create(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, he.Code)
+	})
+
+	t.Run("待合室の入場許可がない場合403", func(t *testing.T) {
+		mockService := new(MockReservationService)
+		mockService.On("CreateReservation", mock.Anything, application.CreateReservationInput{
+			EventID:        "event-123",
+			UserID:         "user-123",
+			SeatIDs:        []string{"seat-1"},
+			IdempotencyKey: "idem-key",
+			QueueToken:     "token-1",
+		}).Return(nil, queue.ErrNotAdmitted)
+		handler := NewReservationHandler(mockService)
+
+		reqBody := `{"event_id": "event-123", "seat_ids": ["seat-1"], "idempotency_key": "idem-key"}`
+		req := httptest.NewRequest(http.MethodPost, "/reservations", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("X-Queue-Token", "token-1")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetUserID(c, "user-123")
+
+		err := handler.Create(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusForbidden, he.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("他のユーザーが同じ座席を処理中の場合423", func(t *testing.T) {
+		mockService := new(MockReservationService)
+		mockService.On("CreateReservation", mock.Anything, mock.Anything).Return(nil, seat.ErrSeatLockContention)
+		handler := NewReservationHandler(mockService)
+
+		reqBody := `{"event_id": "event-123", "seat_ids": ["seat-1"], "idempotency_key": "idem-key"}`
+		req := httptest.NewRequest(http.MethodPost, "/reservations", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetUserID(c, "user-123")
+
+		err := handler.Create(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusLocked, he.Code)
+		assert.ErrorIs(t, err, seat.ErrSeatLockContention)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("購入枚数の上限を超える場合409", func(t *testing.T) {
+		mockService := new(MockReservationService)
+		mockService.On("CreateReservation", mock.Anything, mock.AnythingOfType("application.CreateReservationInput")).
+			Return(nil, fmt.Errorf("%w: 1人あたり4席までです（予約済み4席）", event.ErrPurchaseLimitExceeded))
+		handler := NewReservationHandler(mockService)
+
+		reqBody := `{"event_id": "event-123", "seat_ids": ["seat-1"], "idempotency_key": "idem-key"}`
+		req := httptest.NewRequest(http.MethodPost, "/reservations", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetUserID(c, "user-123")
 
 		err := handler.Create(c)
 
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusConflict, he.Code)
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestReservationHandler_BestAvailable(t *testing.T) {
+	e := NewTestEcho()
+
+	t.Run("正常に最適座席を予約できる", func(t *testing.T) {
+		mockService := new(MockReservationService)
+		now := time.Now()
+		expectedReservation := &reservation.Reservation{
+			ID:          "res-123",
+			EventID:     "event-123",
+			UserID:      "user-123",
+			SeatIDs:     []string{"seat-1", "seat-2"},
+			Status:      reservation.StatusPending,
+			TotalAmount: 10000,
+			ExpiresAt:   now.Add(15 * time.Minute),
+			CreatedAt:   now,
+		}
+
+		mockService.On("ReserveBestAvailable", mock.Anything, application.BestAvailableInput{
+			EventID:        "event-123",
+			UserID:         "user-123",
+			Quantity:       2,
+			Section:        "ARENA",
+			Adjacent:       true,
+			IdempotencyKey: "idem-key",
+		}).Return(expectedReservation, nil)
+
+		handler := NewReservationHandler(mockService)
+
+		reqBody := `{"event_id": "event-123", "quantity": 2, "section": "ARENA", "adjacent": true, "idempotency_key": "idem-key"}`
+		req := httptest.NewRequest(http.MethodPost, "/reservations/best-available", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetUserID(c, "user-123")
+
+		err := handler.BestAvailable(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var resp ReservationResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &resp)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"seat-1", "seat-2"}, resp.SeatIDs)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("ユーザーIDがない場合401", func(t *testing.T) {
+		mockService := new(MockReservationService)
+		handler := NewReservationHandler(mockService)
+
+		reqBody := `{"event_id": "event-123", "quantity": 2, "idempotency_key": "idem-key"}`
+		req := httptest.NewRequest(http.MethodPost, "/reservations/best-available", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		err := handler.BestAvailable(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, he.Code)
+	})
+
+	t.Run("座席数が0の場合400", func(t *testing.T) {
+		mockService := new(MockReservationService)
+		handler := NewReservationHandler(mockService)
+
+		reqBody := `{"event_id": "event-123", "quantity": 0, "idempotency_key": "idem-key"}`
+		req := httptest.NewRequest(http.MethodPost, "/reservations/best-available", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetUserID(c, "user-123")
+
+		err := handler.BestAvailable(c)
+
 		require.Error(t, err)
 		he, ok := err.(*echo.HTTPError)
 		require.True(t, ok)
 		assert.Equal(t, http.StatusBadRequest, he.Code)
+		mockService.AssertNotCalled(t, "ReserveBestAvailable")
+	})
+
+	t.Run("連続した空席がない場合409", func(t *testing.T) {
+		mockService := new(MockReservationService)
+		mockService.On("ReserveBestAvailable", mock.Anything, mock.AnythingOfType("application.BestAvailableInput")).
+			Return(nil, seat.ErrNoAdjacentSeats)
+		handler := NewReservationHandler(mockService)
+
+		reqBody := `{"event_id": "event-123", "quantity": 4, "adjacent": true, "idempotency_key": "idem-key"}`
+		req := httptest.NewRequest(http.MethodPost, "/reservations/best-available", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetUserID(c, "user-123")
+
+		err := handler.BestAvailable(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusConflict, he.Code)
 	})
 }
 
@@ -174,13 +405,14 @@ func TestReservationHandler_GetByID(t *testing.T) {
 			UpdatedAt:   now,
 		}
 
-		mockService.On("GetReservation", mock.Anything, "res-123").Return(expectedReservation, nil)
+		mockService.On("GetReservation", mock.Anything, "res-123", testCustomer).Return(expectedReservation, nil)
 
 		handler := NewReservationHandler(mockService)
 
 		req := httptest.NewRequest(http.MethodGet, "/reservations/res-123", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testCustomer)
 		c.SetParamNames("id")
 		c.SetParamValues("res-123")
 
@@ -194,13 +426,14 @@ func TestReservationHandler_GetByID(t *testing.T) {
 
 	t.Run("予約が見つからない場合404", func(t *testing.T) {
 		mockService := new(MockReservationService)
-		mockService.On("GetReservation", mock.Anything, "nonexistent").Return(nil, reservation.ErrReservationNotFound)
+		mockService.On("GetReservation", mock.Anything, "nonexistent", testCustomer).Return(nil, reservation.ErrReservationNotFound)
 
 		handler := NewReservationHandler(mockService)
 
 		req := httptest.NewRequest(http.MethodGet, "/reservations/nonexistent", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testCustomer)
 		c.SetParamNames("id")
 		c.SetParamValues("nonexistent")
 
@@ -226,28 +459,54 @@ func TestReservationHandler_GetUserReservations(t *testing.T) {
 			{ID: "res-2", EventID: "event-2", UserID: "user-123", SeatIDs: []string{"seat-2"}, Status: reservation.StatusConfirmed, ExpiresAt: now.Add(15 * time.Minute), CreatedAt: now, UpdatedAt: now},
 		}
 
-		mockService.On("GetUserReservations", mock.Anything, "user-123", 0, 0).Return(reservations, nil)
+		mockService.On("GetUserReservations", mock.Anything, application.ListUserReservationsInput{
+			UserID:  "user-123",
+			Status:  reservation.StatusConfirmed,
+			EventID: "event-1",
+			Cursor:  "abc",
+			Limit:   10,
+		}).Return(&pagination.Page[*reservation.Reservation]{Items: reservations, Limit: 10}, nil)
 
 		handler := NewReservationHandler(mockService)
 
-		req := httptest.NewRequest(http.MethodGet, "/reservations", nil)
-		req.Header.Set("X-User-ID", "user-123")
+		req := httptest.NewRequest(http.MethodGet, "/reservations?status=confirmed&event_id=event-1&cursor=abc&limit=10", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
+		middleware.SetUserID(c, "user-123")
 
 		err := handler.GetUserReservations(c)
 
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		var resp []ReservationResponse
+		var resp ReservationListResponse
 		err = json.Unmarshal(rec.Body.Bytes(), &resp)
 		require.NoError(t, err)
-		assert.Len(t, resp, 2)
+		assert.Len(t, resp.Data, 2)
+		assert.Equal(t, PaginationResponse{HasMore: false, Limit: 10}, resp.Pagination)
 
 		mockService.AssertExpectations(t)
 	})
 
+	t.Run("不正な状態の場合400", func(t *testing.T) {
+		mockService := new(MockReservationService)
+		mockService.On("GetUserReservations", mock.Anything, application.ListUserReservationsInput{UserID: "user-123", Status: "unknown"}).
+			Return(nil, reservation.ErrInvalidStatus)
+		handler := NewReservationHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/reservations?status=unknown", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetUserID(c, "user-123")
+
+		err := handler.GetUserReservations(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, he.Code)
+	})
+
 	t.Run("ユーザーIDがない場合401", func(t *testing.T) {
 		mockService := new(MockReservationService)
 		handler := NewReservationHandler(mockService)
@@ -265,6 +524,65 @@ func TestReservationHandler_GetUserReservations(t *testing.T) {
 	})
 }
 
+func TestReservationHandler_GetEventReservations(t *testing.T) {
+	e := NewTestEcho()
+
+	newRequest := func(p auth.Principal) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/events/event-1/reservations?limit=10", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, p)
+		c.SetParamNames("event_id")
+		c.SetParamValues("event-1")
+		return c, rec
+	}
+
+	t.Run("主催者はイベントの予約一覧を取得できる", func(t *testing.T) {
+		now := time.Now()
+		reservations := []*reservation.Reservation{
+			{ID: "res-1", EventID: "event-1", UserID: "user-1", SeatIDs: []string{"seat-1"}, Status: reservation.StatusConfirmed, ExpiresAt: now, CreatedAt: now, UpdatedAt: now},
+		}
+		mockService := new(MockReservationService)
+		mockService.On("GetEventReservations", mock.Anything, "event-1", testOrganizer, 10, 0).Return(reservations, nil)
+		handler := NewReservationHandler(mockService)
+
+		c, rec := newRequest(testOrganizer)
+		err := handler.GetEventReservations(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp []ReservationResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Len(t, resp, 1)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("権限がない場合は認可エラーをそのまま返す", func(t *testing.T) {
+		mockService := new(MockReservationService)
+		mockService.On("GetEventReservations", mock.Anything, "event-1", testCustomer, 10, 0).Return(nil, auth.ErrPermissionDenied)
+		handler := NewReservationHandler(mockService)
+
+		c, _ := newRequest(testCustomer)
+		err := handler.GetEventReservations(c)
+
+		assert.ErrorIs(t, err, auth.ErrPermissionDenied)
+	})
+
+	t.Run("イベントが見つからない場合404", func(t *testing.T) {
+		mockService := new(MockReservationService)
+		mockService.On("GetEventReservations", mock.Anything, "event-1", testOrganizer, 10, 0).Return(nil, event.ErrEventNotFound)
+		handler := NewReservationHandler(mockService)
+
+		c, _ := newRequest(testOrganizer)
+		err := handler.GetEventReservations(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, he.Code)
+	})
+}
+
 func TestReservationHandler_Confirm(t *testing.T) {
 	e := NewTestEcho()
 
@@ -285,13 +603,17 @@ func TestReservationHandler_Confirm(t *testing.T) {
 			UpdatedAt:   now,
 		}
 
-		mockService.On("ConfirmReservation", mock.Anything, "res-123").Return(expectedReservation, nil)
+		mockService.On("ConfirmReservation", mock.Anything, application.ConfirmReservationInput{
+			ReservationID: "res-123", PaymentToken: "tok_visa", Principal: testCustomer,
+		}).Return(expectedReservation, nil)
 
 		handler := NewReservationHandler(mockService)
 
-		req := httptest.NewRequest(http.MethodPost, "/reservations/res-123/confirm", nil)
+		req := httptest.NewRequest(http.MethodPost, "/reservations/res-123/confirm", strings.NewReader(`{"payment_token": "tok_visa"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testCustomer)
 		c.SetParamNames("id")
 		c.SetParamValues("res-123")
 
@@ -310,13 +632,14 @@ func TestReservationHandler_Confirm(t *testing.T) {
 
 	t.Run("予約が見つからない場合404", func(t *testing.T) {
 		mockService := new(MockReservationService)
-		mockService.On("ConfirmReservation", mock.Anything, "nonexistent").Return(nil, reservation.ErrReservationNotFound)
+		mockService.On("ConfirmReservation", mock.Anything, application.ConfirmReservationInput{ReservationID: "nonexistent", Principal: testCustomer}).Return(nil, reservation.ErrReservationNotFound)
 
 		handler := NewReservationHandler(mockService)
 
 		req := httptest.NewRequest(http.MethodPost, "/reservations/nonexistent/confirm", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testCustomer)
 		c.SetParamNames("id")
 		c.SetParamValues("nonexistent")
 
@@ -332,13 +655,15 @@ func TestReservationHandler_Confirm(t *testing.T) {
 
 	t.Run("確定できない状態の場合400", func(t *testing.T) {
 		mockService := new(MockReservationService)
-		mockService.On("ConfirmReservation", mock.Anything, "res-123").Return(nil, errors.New("予約がpending状態ではありません"))
+		mockService.On("ConfirmReservation", mock.Anything, application.ConfirmReservationInput{ReservationID: "res-123", Principal: testCustomer}).
+			Return(nil, errors.New("予約がpending状態ではありません"))
 
 		handler := NewReservationHandler(mockService)
 
 		req := httptest.NewRequest(http.MethodPost, "/reservations/res-123/confirm", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testCustomer)
 		c.SetParamNames("id")
 		c.SetParamValues("res-123")
 
@@ -351,6 +676,130 @@ func TestReservationHandler_Confirm(t *testing.T) {
 
 		mockService.AssertExpectations(t)
 	})
+
+	t.Run("決済の失敗をステータスに変換する", func(t *testing.T) {
+		tests := []struct {
+			err  error
+			code int
+		}{
+			{payment.ErrPaymentDeclined, http.StatusPaymentRequired},
+			{payment.ErrPaymentTimeout, http.StatusGatewayTimeout},
+			{payment.ErrPaymentMethodRequired, http.StatusBadRequest},
+		}
+		for _, tt := range tests {
+			mockService := new(MockReservationService)
+			mockService.On("ConfirmReservation", mock.Anything, application.ConfirmReservationInput{
+				ReservationID: "res-123", PaymentToken: "tok", Principal: testCustomer,
+			}).Return(nil, tt.err)
+			handler := NewReservationHandler(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/reservations/res-123/confirm", strings.NewReader(`{"payment_token": "tok"}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			middleware.SetPrincipal(c, testCustomer)
+			c.SetParamNames("id")
+			c.SetParamValues("res-123")
+
+			err := handler.Confirm(c)
+
+			require.Error(t, err)
+			he, ok := err.(*echo.HTTPError)
+			require.True(t, ok)
+			assert.Equal(t, tt.code, he.Code, tt.err.Error())
+		}
+	})
+}
+
+func TestReservationHandler_Extend(t *testing.T) {
+	e := NewTestEcho()
+
+	t.Run("正常に仮押さえを延長できる", func(t *testing.T) {
+		mockService := new(MockReservationService)
+		now := time.Now()
+		expectedReservation := &reservation.Reservation{
+			ID:             "res-123",
+			EventID:        "event-123",
+			UserID:         "user-123",
+			SeatIDs:        []string{"seat-1"},
+			Status:         reservation.StatusPending,
+			TotalAmount:    5000,
+			ExpiresAt:      now.Add(30 * time.Minute),
+			ExtensionCount: 1,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+
+		mockService.On("ExtendReservation", mock.Anything, "res-123", testCustomer).Return(expectedReservation, nil)
+
+		handler := NewReservationHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/reservations/res-123/extend", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testCustomer)
+		c.SetParamNames("id")
+		c.SetParamValues("res-123")
+
+		err := handler.Extend(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp ReservationResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &resp)
+		require.NoError(t, err)
+		assert.Equal(t, "pending", resp.Status)
+		assert.Equal(t, 1, resp.ExtensionCount)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("予約が見つからない場合404", func(t *testing.T) {
+		mockService := new(MockReservationService)
+		mockService.On("ExtendReservation", mock.Anything, "nonexistent", testCustomer).Return(nil, reservation.ErrReservationNotFound)
+
+		handler := NewReservationHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/reservations/nonexistent/extend", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testCustomer)
+		c.SetParamNames("id")
+		c.SetParamValues("nonexistent")
+
+		err := handler.Extend(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, he.Code)
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("延長回数の上限に達している場合409", func(t *testing.T) {
+		mockService := new(MockReservationService)
+		mockService.On("ExtendReservation", mock.Anything, "res-123", testCustomer).Return(nil, reservation.ErrHoldExtensionLimitReached)
+
+		handler := NewReservationHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/reservations/res-123/extend", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testCustomer)
+		c.SetParamNames("id")
+		c.SetParamValues("res-123")
+
+		err := handler.Extend(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusConflict, he.Code)
+
+		mockService.AssertExpectations(t)
+	})
 }
 
 func TestReservationHandler_Cancel(t *testing.T) {
@@ -371,13 +820,14 @@ func TestReservationHandler_Cancel(t *testing.T) {
 			UpdatedAt:   now,
 		}
 
-		mockService.On("CancelReservation", mock.Anything, "res-123").Return(expectedReservation, nil)
+		mockService.On("CancelReservation", mock.Anything, "res-123", testCustomer).Return(expectedReservation, nil)
 
 		handler := NewReservationHandler(mockService)
 
 		req := httptest.NewRequest(http.MethodPost, "/reservations/res-123/cancel", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testCustomer)
 		c.SetParamNames("id")
 		c.SetParamValues("res-123")
 
@@ -396,13 +846,14 @@ func TestReservationHandler_Cancel(t *testing.T) {
 
 	t.Run("予約が見つからない場合404", func(t *testing.T) {
 		mockService := new(MockReservationService)
-		mockService.On("CancelReservation", mock.Anything, "nonexistent").Return(nil, reservation.ErrReservationNotFound)
+		mockService.On("CancelReservation", mock.Anything, "nonexistent", testCustomer).Return(nil, reservation.ErrReservationNotFound)
 
 		handler := NewReservationHandler(mockService)
 
 		req := httptest.NewRequest(http.MethodPost, "/reservations/nonexistent/cancel", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testCustomer)
 		c.SetParamNames("id")
 		c.SetParamValues("nonexistent")
 
@@ -416,3 +867,244 @@ func TestReservationHandler_Cancel(t *testing.T) {
 		mockService.AssertExpectations(t)
 	})
 }
+
+func TestReservationHandler_Ownership(t *testing.T) {
+	e := NewTestEcho()
+
+	newContext := func(method, path string, principal *auth.Principal) echo.Context {
+		// 座席の取り外しの本文（他のハンドラーは本文を読まない）
+		req := httptest.NewRequest(method, path, strings.NewReader(`{"seat_ids":["seat-1"]}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		c := e.NewContext(req, httptest.NewRecorder())
+		if principal != nil {
+			middleware.SetPrincipal(c, *principal)
+		}
+		c.SetParamNames("id")
+		c.SetParamValues("res-123")
+		return c
+	}
+
+	t.Run("未認証の場合401", func(t *testing.T) {
+		mockService := new(MockReservationService)
+		handler := NewReservationHandler(mockService)
+
+		for name, call := range map[string]func(echo.Context) error{
+			"GetByID": handler.GetByID, "Confirm": handler.Confirm, "Cancel": handler.Cancel,
+			"Extend": handler.Extend, "Refund": handler.Refund, "RemoveSeats": handler.RemoveSeats,
+		} {
+			err := call(newContext(http.MethodPost, "/reservations/res-123", nil))
+
+			he, ok := err.(*echo.HTTPError)
+			require.True(t, ok, name)
+			assert.Equal(t, http.StatusUnauthorized, he.Code, name)
+		}
+		mockService.AssertNotCalled(t, "GetReservation", mock.Anything, mock.Anything, mock.Anything)
+		mockService.AssertNotCalled(t, "ExtendReservation", mock.Anything, mock.Anything, mock.Anything)
+		mockService.AssertNotCalled(t, "RefundReservation", mock.Anything, mock.Anything, mock.Anything)
+		mockService.AssertNotCalled(t, "RemoveSeats", mock.Anything, mock.Anything)
+	})
+
+	t.Run("他のユーザーの予約は404", func(t *testing.T) {
+		other := auth.NewPrincipal("user-999")
+		mockService := new(MockReservationService)
+		mockService.On("GetReservation", mock.Anything, "res-123", other).Return(nil, reservation.ErrReservationNotFound)
+		mockService.On("CancelReservation", mock.Anything, "res-123", other).Return(nil, reservation.ErrReservationNotFound)
+		mockService.On("ExtendReservation", mock.Anything, "res-123", other).Return(nil, reservation.ErrReservationNotFound)
+		mockService.On("RefundReservation", mock.Anything, "res-123", other).Return(nil, reservation.ErrReservationNotFound)
+		mockService.On("RemoveSeats", mock.Anything, application.RemoveSeatsInput{
+			ReservationID: "res-123", SeatIDs: []string{"seat-1"}, Principal: other,
+		}).Return(nil, reservation.ErrReservationNotFound)
+		handler := NewReservationHandler(mockService)
+
+		for name, call := range map[string]func(echo.Context) error{
+			"GetByID": handler.GetByID, "Cancel": handler.Cancel,
+			"Extend": handler.Extend, "Refund": handler.Refund, "RemoveSeats": handler.RemoveSeats,
+		} {
+			err := call(newContext(http.MethodPost, "/reservations/res-123", &other))
+
+			he, ok := err.(*echo.HTTPError)
+			require.True(t, ok, name)
+			assert.Equal(t, http.StatusNotFound, he.Code, name)
+		}
+	})
+
+	t.Run("管理者の役割をサービスに渡す", func(t *testing.T) {
+		admin := auth.NewPrincipal("admin-1", auth.RoleAdmin)
+		mockService := new(MockReservationService)
+		mockService.On("CancelReservation", mock.Anything, "res-123", admin).Return(&reservation.Reservation{
+			ID: "res-123", UserID: "user-123", Status: reservation.StatusCancelled,
+		}, nil)
+		mockService.On("ExtendReservation", mock.Anything, "res-123", admin).Return(&reservation.Reservation{
+			ID: "res-123", UserID: "user-123", Status: reservation.StatusPending,
+		}, nil)
+		mockService.On("RefundReservation", mock.Anything, "res-123", admin).Return(&reservation.Reservation{
+			ID: "res-123", UserID: "user-123", Status: reservation.StatusRefunded,
+		}, nil)
+		mockService.On("RemoveSeats", mock.Anything, application.RemoveSeatsInput{
+			ReservationID: "res-123", SeatIDs: []string{"seat-1"}, Principal: admin,
+		}).Return(&reservation.Reservation{
+			ID: "res-123", UserID: "user-123", Status: reservation.StatusConfirmed,
+		}, nil)
+		handler := NewReservationHandler(mockService)
+
+		for name, call := range map[string]func(echo.Context) error{
+			"Cancel": handler.Cancel, "Extend": handler.Extend,
+			"Refund": handler.Refund, "RemoveSeats": handler.RemoveSeats,
+		} {
+			err := call(newContext(http.MethodPost, "/reservations/res-123", &admin))
+
+			require.NoError(t, err, name)
+		}
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestReservationHandler_Refund(t *testing.T) {
+	e := NewTestEcho()
+
+	t.Run("正常に予約を返金できる", func(t *testing.T) {
+		mockService := new(MockReservationService)
+		now := time.Now()
+		refunded := &reservation.Reservation{
+			ID: "res-123", EventID: "event-123", UserID: "user-123",
+			SeatIDs: []string{"seat-1"}, Status: reservation.StatusRefunded,
+			TotalAmount: 5000, RefundedAmount: 2500, RefundedAt: &now,
+			ExpiresAt: now, CreatedAt: now, UpdatedAt: now,
+		}
+		mockService.On("RefundReservation", mock.Anything, "res-123", testCustomer).Return(refunded, nil)
+
+		handler := NewReservationHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/reservations/res-123/refund", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testCustomer)
+		c.SetParamNames("id")
+		c.SetParamValues("res-123")
+
+		err := handler.Refund(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp ReservationResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "refunded", resp.Status)
+		assert.Equal(t, 2500, resp.RefundedAmount)
+		assert.NotNil(t, resp.RefundedAt)
+
+		mockService.AssertExpectations(t)
+	})
+
+	for _, tc := range []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"予約が見つからない場合404", reservation.ErrReservationNotFound, http.StatusNotFound},
+		{"確定前の予約は409", reservation.ErrReservationNotConfirmed, http.StatusConflict},
+		{"返金済みの予約は409", reservation.ErrReservationAlreadyRefunded, http.StatusConflict},
+		{"返金締切後は409", reservation.ErrRefundPeriodEnded, http.StatusConflict},
+		{"決済プロバイダーのタイムアウトは504", payment.ErrPaymentTimeout, http.StatusGatewayTimeout},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := new(MockReservationService)
+			mockService.On("RefundReservation", mock.Anything, "res-123", testCustomer).Return(nil, tc.err)
+
+			handler := NewReservationHandler(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/reservations/res-123/refund", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			middleware.SetPrincipal(c, testCustomer)
+			c.SetParamNames("id")
+			c.SetParamValues("res-123")
+
+			err := handler.Refund(c)
+
+			require.Error(t, err)
+			he, ok := err.(*echo.HTTPError)
+			require.True(t, ok)
+			assert.Equal(t, tc.code, he.Code)
+		})
+	}
+}
+
+func TestReservationHandler_RemoveSeats(t *testing.T) {
+	e := NewTestEcho()
+
+	newContext := func(body string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/reservations/res-123/seats/remove", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testCustomer)
+		c.SetParamNames("id")
+		c.SetParamValues("res-123")
+		return c, rec
+	}
+
+	t.Run("指定した座席を外せる", func(t *testing.T) {
+		mockService := new(MockReservationService)
+		now := time.Now()
+		updated := &reservation.Reservation{
+			ID: "res-123", EventID: "event-123", UserID: "user-123",
+			SeatIDs: []string{"seat-1"}, Status: reservation.StatusPending,
+			TotalAmount: 5000, ExpiresAt: now.Add(10 * time.Minute), CreatedAt: now, UpdatedAt: now,
+		}
+		mockService.On("RemoveSeats", mock.Anything, application.RemoveSeatsInput{
+			ReservationID: "res-123", SeatIDs: []string{"seat-2"}, Principal: testCustomer,
+		}).Return(updated, nil)
+
+		handler := NewReservationHandler(mockService)
+		c, rec := newContext(`{"seat_ids":["seat-2"]}`)
+
+		err := handler.RemoveSeats(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp ReservationResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, []string{"seat-1"}, resp.SeatIDs)
+		assert.Equal(t, 5000, resp.TotalAmount)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("座席未指定は400", func(t *testing.T) {
+		handler := NewReservationHandler(new(MockReservationService))
+		c, _ := newContext(`{"seat_ids":[]}`)
+
+		err := handler.RemoveSeats(c)
+
+		require.Error(t, err)
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, he.Code)
+	})
+
+	for _, tc := range []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"予約が見つからない場合404", reservation.ErrReservationNotFound, http.StatusNotFound},
+		{"予約に含まれない座席は400", reservation.ErrSeatNotInReservation, http.StatusBadRequest},
+		{"全ての座席は400", reservation.ErrCannotRemoveAllSeats, http.StatusBadRequest},
+		{"決済プロバイダーのタイムアウトは504", payment.ErrPaymentTimeout, http.StatusGatewayTimeout},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := new(MockReservationService)
+			mockService.On("RemoveSeats", mock.Anything, mock.Anything).Return(nil, tc.err)
+
+			handler := NewReservationHandler(mockService)
+			c, _ := newContext(`{"seat_ids":["seat-2"]}`)
+
+			err := handler.RemoveSeats(c)
+
+			require.Error(t, err)
+			he, ok := err.(*echo.HTTPError)
+			require.True(t, ok)
+			assert.Equal(t, tc.code, he.Code)
+		})
+	}
+}
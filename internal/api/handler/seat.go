@@ -5,6 +5,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/application"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
 )
@@ -17,33 +18,87 @@ func NewSeatHandler(s SeatServiceInterface) *SeatHandler {
 	return &SeatHandler{service: s}
 }
 
+// 価格カテゴリを指定した場合、price は省略でき、カテゴリの金額が使用される
 type CreateSeatRequest struct {
-	SeatNumber string `json:"seat_number" validate:"required"`
-	Price      int    `json:"price" validate:"required,min=0"`
+	SeatNumber      string `json:"seat_number" validate:"required"`
+	Price           int    `json:"price" validate:"required_without=PriceCategoryID,min=0"`
+	PriceCategoryID string `json:"price_category_id,omitempty"`
 }
 
 type CreateBulkSeatsRequest struct {
-	Prefix string `json:"prefix" validate:"required"`
-	Count  int    `json:"count" validate:"required,min=1,max=1000"`
-	Price  int    `json:"price" validate:"required,min=0"`
+	Prefix          string `json:"prefix" validate:"required"`
+	Count           int    `json:"count" validate:"required,min=1,max=1000"`
+	Price           int    `json:"price" validate:"required_without=PriceCategoryID,min=0"`
+	PriceCategoryID string `json:"price_category_id,omitempty"`
 }
 
 type SeatResponse struct {
-	ID         string  `json:"id"`
-	EventID    string  `json:"event_id"`
-	SeatNumber string  `json:"seat_number"`
-	Status     string  `json:"status"`
-	Price      int     `json:"price"`
-	ReservedBy *string `json:"reserved_by,omitempty"`
+	ID              string  `json:"id"`
+	EventID         string  `json:"event_id"`
+	SeatNumber      string  `json:"seat_number"`
+	Status          string  `json:"status"`
+	Price           int     `json:"price"`
+	PriceCategoryID *string `json:"price_category_id,omitempty"`
+	Section         string  `json:"section,omitempty"`
+	Row             string  `json:"row,omitempty"`
+	SeatIndex       int     `json:"seat_index,omitempty"`
+	X               float64 `json:"x"`
+	Y               float64 `json:"y"`
+	Accessible      bool    `json:"accessible"`
+	ObstructedView  bool    `json:"obstructed_view"`
+	ReservedBy      *string `json:"reserved_by,omitempty"`
 }
 
 func toSeatResponse(s *seat.Seat) SeatResponse {
 	return SeatResponse{
 		ID: s.ID, EventID: s.EventID, SeatNumber: s.SeatNumber,
-		Status: string(s.Status), Price: s.Price, ReservedBy: s.ReservedBy,
+		Status: string(s.Status), Price: s.Price, PriceCategoryID: s.PriceCategoryID,
+		Section: s.Section, Row: s.Row, SeatIndex: s.SeatIndex, X: s.X, Y: s.Y,
+		Accessible: s.Accessible, ObstructedView: s.ObstructedView,
+		ReservedBy: s.ReservedBy,
 	}
 }
 
+// SeatMapResponse は座席表描画用にセクション・列でグループ化した座席一覧
+type SeatMapResponse struct {
+	EventID  string                   `json:"event_id"`
+	Sections []SeatMapSectionResponse `json:"sections"`
+}
+
+type SeatMapSectionResponse struct {
+	Name string               `json:"name"`
+	Rows []SeatMapRowResponse `json:"rows"`
+}
+
+type SeatMapRowResponse struct {
+	Label string         `json:"label"`
+	Seats []SeatResponse `json:"seats"`
+}
+
+// toSeatMapResponse は座席をセクション・列ごとにグループ化する（座席の並び順を維持）
+func toSeatMapResponse(eventID string, seats []*seat.Seat) SeatMapResponse {
+	resp := SeatMapResponse{EventID: eventID, Sections: []SeatMapSectionResponse{}}
+	sectionIdx := make(map[string]int)
+	rowIdx := make(map[[2]string]int)
+	for _, s := range seats {
+		si, ok := sectionIdx[s.Section]
+		if !ok {
+			si = len(resp.Sections)
+			sectionIdx[s.Section] = si
+			resp.Sections = append(resp.Sections, SeatMapSectionResponse{Name: s.Section})
+		}
+		key := [2]string{s.Section, s.Row}
+		ri, ok := rowIdx[key]
+		if !ok {
+			ri = len(resp.Sections[si].Rows)
+			rowIdx[key] = ri
+			resp.Sections[si].Rows = append(resp.Sections[si].Rows, SeatMapRowResponse{Label: s.Row})
+		}
+		resp.Sections[si].Rows[ri].Seats = append(resp.Sections[si].Rows[ri].Seats, toSeatResponse(s))
+	}
+	return resp
+}
+
 func (h *SeatHandler) GetByEvent(c echo.Context) error {
 	eventID := c.Param("event_id")
 	availableOnly := c.QueryParam("available") == "true"
@@ -55,7 +110,10 @@ func (h *SeatHandler) GetByEvent(c echo.Context) error {
 		seats, err = h.service.GetSeatsByEvent(c.Request().Context(), eventID)
 	}
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return serviceError(err, http.StatusInternalServerError)
+	}
+	if c.QueryParam("view") == "layout" {
+		return c.JSON(http.StatusOK, toSeatMapResponse(eventID, seats))
 	}
 	resp := make([]SeatResponse, len(seats))
 	for i, s := range seats {
@@ -75,9 +133,10 @@ func (h *SeatHandler) Create(c echo.Context) error {
 	}
 	s, err := h.service.CreateSeat(c.Request().Context(), application.CreateSeatInput{
 		EventID: eventID, SeatNumber: req.SeatNumber, Price: req.Price,
+		PriceCategoryID: req.PriceCategoryID, Principal: middleware.CurrentPrincipal(c),
 	})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		return serviceError(err, http.StatusBadRequest)
 	}
 	return c.JSON(http.StatusCreated, toSeatResponse(s))
 }
@@ -93,9 +152,10 @@ func (h *SeatHandler) CreateBulk(c echo.Context) error {
 	}
 	seats, err := h.service.CreateBulkSeats(c.Request().Context(), application.CreateBulkSeatsInput{
 		EventID: eventID, Prefix: req.Prefix, Count: req.Count, Price: req.Price,
+		PriceCategoryID: req.PriceCategoryID, Principal: middleware.CurrentPrincipal(c),
 	})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		return serviceError(err, http.StatusBadRequest)
 	}
 	resp := make([]SeatResponse, len(seats))
 	for i, s := range seats {
@@ -108,8 +168,9 @@ func (h *SeatHandler) GetByID(c echo.Context) error {
 	id := c.Param("id")
 	s, err := h.service.GetSeat(c.Request().Context(), id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		return serviceError(err, http.StatusNotFound)
 	}
+	setVersionETag(c, s.Version)
 	return c.JSON(http.StatusOK, toSeatResponse(s))
 }
 
@@ -117,7 +178,7 @@ func (h *SeatHandler) CountAvailable(c echo.Context) error {
 	eventID := c.Param("event_id")
 	count, err := h.service.CountAvailableSeats(c.Request().Context(), eventID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return serviceError(err, http.StatusInternalServerError)
 	}
 	return c.JSON(http.StatusOK, map[string]int{"count": count})
 }
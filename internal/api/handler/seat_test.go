@@ -14,7 +14,9 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/application"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
 )
 
@@ -133,6 +135,46 @@ func TestSeatHandler_GetByEvent(t *testing.T) {
 
 		mockService.AssertExpectations(t)
 	})
+
+	t.Run("view=layoutでセクション・列ごとに取得できる", func(t *testing.T) {
+		mockService := new(MockSeatService)
+		seats := []*seat.Seat{
+			{ID: "seat-1", EventID: "event-123", SeatNumber: "ARENA-A-1", Section: "ARENA", Row: "A", SeatIndex: 1, X: 10, Y: 20, Accessible: true, Status: seat.StatusAvailable},
+			{ID: "seat-2", EventID: "event-123", SeatNumber: "ARENA-A-2", Section: "ARENA", Row: "A", SeatIndex: 2, X: 20, Y: 20, Status: seat.StatusReserved},
+			{ID: "seat-3", EventID: "event-123", SeatNumber: "ARENA-B-1", Section: "ARENA", Row: "B", SeatIndex: 1, X: 10, Y: 30, ObstructedView: true, Status: seat.StatusAvailable},
+			{ID: "seat-4", EventID: "event-123", SeatNumber: "STAND-1-1", Section: "STAND", Row: "1", SeatIndex: 1, X: 10, Y: 100, Status: seat.StatusAvailable},
+		}
+
+		mockService.On("GetSeatsByEvent", mock.Anything, "event-123").Return(seats, nil)
+
+		handler := NewSeatHandler(mockService)
+
+		req := httptest.NewRequest(http.MethodGet, "/events/event-123/seats?view=layout", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("event_id")
+		c.SetParamValues("event-123")
+
+		err := handler.GetByEvent(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp SeatMapResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &resp)
+		require.NoError(t, err)
+		require.Len(t, resp.Sections, 2)
+		assert.Equal(t, "ARENA", resp.Sections[0].Name)
+		require.Len(t, resp.Sections[0].Rows, 2)
+		assert.Equal(t, "A", resp.Sections[0].Rows[0].Label)
+		require.Len(t, resp.Sections[0].Rows[0].Seats, 2)
+		assert.True(t, resp.Sections[0].Rows[0].Seats[0].Accessible)
+		assert.Equal(t, 20.0, resp.Sections[0].Rows[0].Seats[1].X)
+		assert.True(t, resp.Sections[0].Rows[1].Seats[0].ObstructedView)
+		assert.Equal(t, "STAND", resp.Sections[1].Name)
+
+		mockService.AssertExpectations(t)
+	})
 }
 
 func TestSeatHandler_Create(t *testing.T) {
@@ -151,8 +193,9 @@ func TestSeatHandler_Create(t *testing.T) {
 			UpdatedAt:  now,
 		}
 
-		mockService.On("CreateSeat", mock.Anything, mock.AnythingOfType("application.CreateSeatInput")).
-			Return(expectedSeat, nil)
+		mockService.On("CreateSeat", mock.Anything, mock.MatchedBy(func(in application.CreateSeatInput) bool {
+			return in.EventID == "event-123" && in.Principal.UserID == testOrganizer.UserID
+		})).Return(expectedSeat, nil)
 
 		handler := NewSeatHandler(mockService)
 
@@ -161,6 +204,7 @@ func TestSeatHandler_Create(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testOrganizer)
 		c.SetParamNames("event_id")
 		c.SetParamValues("event-123")
 
@@ -196,6 +240,28 @@ func TestSeatHandler_Create(t *testing.T) {
 		require.True(t, ok)
 		assert.Equal(t, http.StatusBadRequest, he.Code)
 	})
+
+	t.Run("主催者以外の場合は認可エラーをそのまま返す", func(t *testing.T) {
+		customer := auth.NewPrincipal("user-1")
+		mockService := new(MockSeatService)
+		mockService.On("CreateSeat", mock.Anything, mock.AnythingOfType("application.CreateSeatInput")).
+			Return(nil, auth.ErrPermissionDenied)
+		handler := NewSeatHandler(mockService)
+
+		reqBody := `{"seat_number": "A-1", "price": 5000}`
+		req := httptest.NewRequest(http.MethodPost, "/events/event-123/seats", strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, customer)
+		c.SetParamNames("event_id")
+		c.SetParamValues("event-123")
+
+		err := handler.Create(c)
+
+		assert.ErrorIs(t, err, auth.ErrPermissionDenied)
+		mockService.AssertExpectations(t)
+	})
 }
 
 func TestSeatHandler_CreateBulk(t *testing.T) {
@@ -209,8 +275,9 @@ func TestSeatHandler_CreateBulk(t *testing.T) {
 			{ID: "seat-2", EventID: "event-123", SeatNumber: "A-2", Status: seat.StatusAvailable, Price: 5000, CreatedAt: now, UpdatedAt: now},
 		}
 
-		mockService.On("CreateBulkSeats", mock.Anything, mock.AnythingOfType("application.CreateBulkSeatsInput")).
-			Return(seats, nil)
+		mockService.On("CreateBulkSeats", mock.Anything, mock.MatchedBy(func(in application.CreateBulkSeatsInput) bool {
+			return in.Count == 2 && in.Principal.UserID == testOrganizer.UserID
+		})).Return(seats, nil)
 
 		handler := NewSeatHandler(mockService)
 
@@ -219,6 +286,7 @@ func TestSeatHandler_CreateBulk(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
+		middleware.SetPrincipal(c, testOrganizer)
 		c.SetParamNames("event_id")
 		c.SetParamValues("event-123")
 
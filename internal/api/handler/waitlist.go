@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/application"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/waitlist"
+)
+
+type WaitlistHandler struct {
+	service WaitlistServiceInterface
+}
+
+func NewWaitlistHandler(s WaitlistServiceInterface) *WaitlistHandler {
+	return &WaitlistHandler{service: s}
+}
+
+type JoinWaitlistRequest struct {
+	Quantity int `json:"quantity" validate:"required,min=1,max=10" example:"2"`
+}
+
+type WaitlistEntryResponse struct {
+	ID            string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	EventID       string     `json:"event_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID        string     `json:"user_id" example:"user-123"`
+	Quantity      int        `json:"quantity" example:"2"`
+	Status        string     `json:"status" example:"waiting"`
+	Position      int        `json:"position,omitempty" example:"3"`
+	ReservationID *string    `json:"reservation_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	OfferedAt     *time.Time `json:"offered_at,omitempty"`
+	SkipReason    *string    `json:"skip_reason,omitempty" example:"購入枚数の上限を超えています"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+func toWaitlistEntryResponse(e *waitlist.Entry, position int) WaitlistEntryResponse {
+	return WaitlistEntryResponse{
+		ID: e.ID, EventID: e.EventID, UserID: e.UserID,
+		Quantity: e.Quantity, Status: string(e.Status), Position: position,
+		ReservationID: e.ReservationID, OfferedAt: e.OfferedAt, SkipReason: e.SkipReason,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+// Join godoc
+// @Summary 順番待ちに登録
+// @Description 売り切れイベントの順番待ちに登録します。座席が解放されると先頭から順に仮押さえ予約がオファーされます
+// @Tags waitlist
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param event_id path string true "イベントID"
+// @Param request body JoinWaitlistRequest true "希望枚数"
+// @Success 201 {object} WaitlistEntryResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string "登録済み、または空席あり"
+// @Router /events/{event_id}/waitlist [post]
+func (h *WaitlistHandler) Join(c echo.Context) error {
+	userID := middleware.UserID(c)
+	if userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "認証が必要です")
+	}
+	var req JoinWaitlistRequest
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "無効なリクエスト")
+	}
+	if err := c.Validate(&req); err != nil {
+		return err
+	}
+	entry, err := h.service.JoinWaitlist(c.Request().Context(), application.JoinWaitlistInput{
+		EventID: c.Param("event_id"), UserID: userID, Quantity: req.Quantity,
+	})
+	if err != nil {
+		return serviceError(err, http.StatusBadRequest)
+	}
+	return c.JSON(http.StatusCreated, toWaitlistEntryResponse(entry, 0))
+}
+
+// GetByID godoc
+// @Summary 順番待ちの状況を取得
+// @Description 順番待ちエントリの状態と待機順位、オファー中の予約IDを取得します（登録したユーザー本人と管理者のみ）
+// @Tags waitlist
+// @Produce json
+// @Security BearerAuth
+// @Param event_id path string true "イベントID"
+// @Param id path string true "順番待ちエントリID"
+// @Success 200 {object} WaitlistEntryResponse
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string "存在しない、または他のユーザーのエントリ"
+// @Router /events/{event_id}/waitlist/{id} [get]
+func (h *WaitlistHandler) GetByID(c echo.Context) error {
+	principal := middleware.CurrentPrincipal(c)
+	if !principal.IsAuthenticated() {
+		return echo.NewHTTPError(http.StatusUnauthorized, "認証が必要です")
+	}
+	entry, position, err := h.service.GetEntry(c.Request().Context(), c.Param("event_id"), c.Param("id"), principal)
+	if err != nil {
+		return serviceError(err, http.StatusBadRequest)
+	}
+	return c.JSON(http.StatusOK, toWaitlistEntryResponse(entry, position))
+}
+
+// Leave godoc
+// @Summary 順番待ちを取り消す
+// @Description オファー前の順番待ちを取り消します（登録したユーザー本人のみ）。オファー後は仮押さえ予約のキャンセルで辞退します
+// @Tags waitlist
+// @Produce json
+// @Security BearerAuth
+// @Param event_id path string true "イベントID"
+// @Param id path string true "順番待ちエントリID"
+// @Success 200 {object} WaitlistEntryResponse
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string "存在しない、または他のユーザーのエントリ"
+// @Failure 409 {object} map[string]string "待機中ではない"
+// @Router /events/{event_id}/waitlist/{id} [delete]
+func (h *WaitlistHandler) Leave(c echo.Context) error {
+	principal := middleware.CurrentPrincipal(c)
+	if !principal.IsAuthenticated() {
+		return echo.NewHTTPError(http.StatusUnauthorized, "認証が必要です")
+	}
+	entry, err := h.service.LeaveWaitlist(c.Request().Context(), c.Param("event_id"), c.Param("id"), principal)
+	if err != nil {
+		return serviceError(err, http.StatusBadRequest)
+	}
+	return c.JSON(http.StatusOK, toWaitlistEntryResponse(entry, 0))
+}
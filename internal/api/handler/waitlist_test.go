@@ -0,0 +1,244 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/api/middleware"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/application"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/waitlist"
+)
+
+// MockWaitlistService はWaitlistServiceInterfaceのモック
+type MockWaitlistService struct {
+	mock.Mock
+}
+
+func (m *MockWaitlistService) JoinWaitlist(ctx context.Context, input application.JoinWaitlistInput) (*waitlist.Entry, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*waitlist.Entry), args.Error(1)
+}
+
+func (m *MockWaitlistService) GetEntry(ctx context.Context, eventID, id string, principal auth.Principal) (*waitlist.Entry, int, error) {
+	args := m.Called(ctx, eventID, id, principal)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).(*waitlist.Entry), args.Int(1), args.Error(2)
+}
+
+func (m *MockWaitlistService) LeaveWaitlist(ctx context.Context, eventID, id string, principal auth.Principal) (*waitlist.Entry, error) {
+	args := m.Called(ctx, eventID, id, principal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*waitlist.Entry), args.Error(1)
+}
+
+func TestWaitlistHandler_Join(t *testing.T) {
+	e := NewTestEcho()
+
+	newRequest := func(body, userID string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodPost, "/events/event-1/waitlist", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		if userID != "" {
+			middleware.SetUserID(c, userID)
+		}
+		c.SetParamNames("event_id")
+		c.SetParamValues("event-1")
+		return c, rec
+	}
+
+	t.Run("正常に順番待ちに登録できる", func(t *testing.T) {
+		mockService := new(MockWaitlistService)
+		mockService.On("JoinWaitlist", mock.Anything, application.JoinWaitlistInput{
+			EventID: "event-1", UserID: "user-1", Quantity: 2,
+		}).Return(&waitlist.Entry{
+			ID: "entry-1", EventID: "event-1", UserID: "user-1", Quantity: 2,
+			Status: waitlist.StatusWaiting, CreatedAt: time.Now(),
+		}, nil)
+		handler := NewWaitlistHandler(mockService)
+
+		c, rec := newRequest(`{"quantity": 2}`, "user-1")
+		err := handler.Join(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var resp WaitlistEntryResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "entry-1", resp.ID)
+		assert.Equal(t, "waiting", resp.Status)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("ユーザーIDがない場合401", func(t *testing.T) {
+		handler := NewWaitlistHandler(new(MockWaitlistService))
+
+		c, _ := newRequest(`{"quantity": 2}`, "")
+		err := handler.Join(c)
+
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, he.Code)
+	})
+
+	t.Run("希望枚数が上限超過の場合400", func(t *testing.T) {
+		handler := NewWaitlistHandler(new(MockWaitlistService))
+
+		c, _ := newRequest(`{"quantity": 11}`, "user-1")
+		err := handler.Join(c)
+
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusBadRequest, he.Code)
+	})
+
+	t.Run("空席がある場合409", func(t *testing.T) {
+		mockService := new(MockWaitlistService)
+		mockService.On("JoinWaitlist", mock.Anything, mock.AnythingOfType("application.JoinWaitlistInput")).
+			Return(nil, waitlist.ErrSeatsAvailable)
+		handler := NewWaitlistHandler(mockService)
+
+		c, _ := newRequest(`{"quantity": 2}`, "user-1")
+		err := handler.Join(c)
+
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusConflict, he.Code)
+	})
+}
+
+func TestWaitlistHandler_GetByID(t *testing.T) {
+	e := NewTestEcho()
+
+	newRequest := func(p auth.Principal) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodGet, "/events/event-1/waitlist/entry-1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		if p.IsAuthenticated() {
+			middleware.SetPrincipal(c, p)
+		}
+		c.SetParamNames("event_id", "id")
+		c.SetParamValues("event-1", "entry-1")
+		return c, rec
+	}
+
+	t.Run("待機順位を返す", func(t *testing.T) {
+		mockService := new(MockWaitlistService)
+		mockService.On("GetEntry", mock.Anything, "event-1", "entry-1", testCustomer).Return(&waitlist.Entry{
+			ID: "entry-1", EventID: "event-1", UserID: "user-123", Quantity: 2, Status: waitlist.StatusWaiting,
+		}, 3, nil)
+		handler := NewWaitlistHandler(mockService)
+
+		c, rec := newRequest(testCustomer)
+		err := handler.GetByID(c)
+
+		require.NoError(t, err)
+		var resp WaitlistEntryResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, 3, resp.Position)
+		assert.Nil(t, resp.ReservationID)
+	})
+
+	t.Run("未認証の場合401", func(t *testing.T) {
+		mockService := new(MockWaitlistService)
+		handler := NewWaitlistHandler(mockService)
+
+		c, _ := newRequest(auth.Principal{})
+		err := handler.GetByID(c)
+
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, he.Code)
+		mockService.AssertNotCalled(t, "GetEntry")
+	})
+
+	t.Run("エントリが見つからない場合404", func(t *testing.T) {
+		mockService := new(MockWaitlistService)
+		mockService.On("GetEntry", mock.Anything, "event-1", "entry-1", testCustomer).Return(nil, 0, waitlist.ErrEntryNotFound)
+		handler := NewWaitlistHandler(mockService)
+
+		c, _ := newRequest(testCustomer)
+		err := handler.GetByID(c)
+
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusNotFound, he.Code)
+	})
+}
+
+func TestWaitlistHandler_Leave(t *testing.T) {
+	e := NewTestEcho()
+
+	newRequest := func(p auth.Principal) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(http.MethodDelete, "/events/event-1/waitlist/entry-1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		if p.IsAuthenticated() {
+			middleware.SetPrincipal(c, p)
+		}
+		c.SetParamNames("event_id", "id")
+		c.SetParamValues("event-1", "entry-1")
+		return c, rec
+	}
+
+	t.Run("順番待ちを取り消せる", func(t *testing.T) {
+		mockService := new(MockWaitlistService)
+		mockService.On("LeaveWaitlist", mock.Anything, "event-1", "entry-1", testCustomer).Return(&waitlist.Entry{
+			ID: "entry-1", EventID: "event-1", UserID: "user-123", Quantity: 2, Status: waitlist.StatusCancelled,
+		}, nil)
+		handler := NewWaitlistHandler(mockService)
+
+		c, rec := newRequest(testCustomer)
+		err := handler.Leave(c)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp WaitlistEntryResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, "cancelled", resp.Status)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("未認証の場合401", func(t *testing.T) {
+		mockService := new(MockWaitlistService)
+		handler := NewWaitlistHandler(mockService)
+
+		c, _ := newRequest(auth.Principal{})
+		err := handler.Leave(c)
+
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusUnauthorized, he.Code)
+		mockService.AssertNotCalled(t, "LeaveWaitlist")
+	})
+
+	t.Run("オファー後の場合409", func(t *testing.T) {
+		mockService := new(MockWaitlistService)
+		mockService.On("LeaveWaitlist", mock.Anything, "event-1", "entry-1", testCustomer).Return(nil, waitlist.ErrEntryNotWaiting)
+		handler := NewWaitlistHandler(mockService)
+
+		c, _ := newRequest(testCustomer)
+		err := handler.Leave(c)
+
+		he, ok := err.(*echo.HTTPError)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusConflict, he.Code)
+	})
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/ja"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	entranslations "github.com/go-playground/validator/v10/translations/en"
+	jatranslations "github.com/go-playground/validator/v10/translations/ja"
+	"golang.org/x/text/language"
+)
+
+// Language はエラーメッセージの言語
+type Language string
+
+// 対応している言語
+const (
+	LanguageJA Language = "ja"
+	LanguageEN Language = "en"
+)
+
+// DefaultLanguage は Accept-Language がない、または対応していない言語の場合に使う言語
+// ドメインのエラーメッセージは日本語で書かれているため日本語をデフォルトにする
+const DefaultLanguage = LanguageJA
+
+// supportedLanguages は languageMatcher に渡すタグと同じ順序で並べる
+var supportedLanguages = []Language{LanguageJA, LanguageEN}
+
+var languageMatcher = language.NewMatcher([]language.Tag{language.Japanese, language.English})
+
+// NegotiateLanguage は Accept-Language ヘッダーから応答に使う言語を決める
+// q 値の優先度に従い、対応している言語がない場合は DefaultLanguage を返す
+func NegotiateLanguage(acceptLanguage string) Language {
+	if acceptLanguage == "" {
+		return DefaultLanguage
+	}
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return DefaultLanguage
+	}
+	_, idx, confidence := languageMatcher.Match(tags...)
+	if confidence == language.No {
+		return DefaultLanguage
+	}
+	return supportedLanguages[idx]
+}
+
+// newUniversalTranslator はバリデーションエラーを翻訳する UniversalTranslator を作り、validate に既定の翻訳を登録する
+// 翻訳は Translator ごとに1回しか登録できないため、バリデーターごとに作る
+func newUniversalTranslator(v *validator.Validate) (*ut.UniversalTranslator, error) {
+	uni := ut.New(ja.New(), ja.New(), en.New())
+	jaTrans, _ := uni.GetTranslator(string(LanguageJA))
+	if err := jatranslations.RegisterDefaultTranslations(v, jaTrans); err != nil {
+		return nil, err
+	}
+	enTrans, _ := uni.GetTranslator(string(LanguageEN))
+	if err := entranslations.RegisterDefaultTranslations(v, enTrans); err != nil {
+		return nil, err
+	}
+	return uni, nil
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateLanguage(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		want           Language
+	}{
+		{"ヘッダーなし", "", LanguageJA},
+		{"日本語", "ja-JP", LanguageJA},
+		{"英語", "en-US", LanguageEN},
+		{"q値の高い英語を優先", "ja;q=0.5,en;q=0.9", LanguageEN},
+		{"対応する言語を優先", "fr-FR,en;q=0.8", LanguageEN},
+		{"未対応の言語のみ", "fr-FR", LanguageJA},
+		{"不正なヘッダー", ";;;", LanguageJA},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NegotiateLanguage(tt.acceptLanguage))
+		})
+	}
+}
+
+func TestMessageCatalog(t *testing.T) {
+	t.Run("コード表の全てのエラーコードに翻訳がある", func(t *testing.T) {
+		for _, m := range errorCatalog {
+			for _, lang := range supportedLanguages {
+				_, ok := localizedMessage(lang, m.code)
+				assert.True(t, ok, "%s の %s の翻訳がありません", m.code, lang)
+			}
+		}
+	})
+
+	t.Run("日本語のメッセージはドメインのエラーと一致する", func(t *testing.T) {
+		for _, m := range errorCatalog {
+			msg, _ := localizedMessage(LanguageJA, m.code)
+			assert.Equal(t, m.err.Error(), msg, m.code)
+		}
+	})
+
+	t.Run("言語ごとの翻訳の数が一致する", func(t *testing.T) {
+		assert.Equal(t, len(messageCatalog[LanguageJA]), len(messageCatalog[LanguageEN]))
+	})
+}
@@ -0,0 +1,240 @@
+package api
+
+// messageCatalog はエラーコードごとの言語別メッセージ
+// 日本語はドメインのエラー変数のメッセージと揃える（言語を追加する場合は全てのエラーコードを翻訳する）
+var messageCatalog = map[Language]map[ErrorCode]string{
+	LanguageJA: {
+		// 汎用
+		CodeInvalidRequest:       "無効なリクエスト",
+		CodeValidationFailed:     "入力内容に誤りがあります",
+		CodeUnauthenticated:      "認証が必要です",
+		CodePermissionDenied:     "この操作を行う権限がありません",
+		CodeNotFound:             "見つかりません",
+		CodeMethodNotAllowed:     "許可されていないメソッドです",
+		CodeConflict:             "リクエストが現在の状態と競合しています",
+		CodeRateLimited:          "リクエスト数の上限を超えました",
+		CodeInternal:             "内部サーバーエラー",
+		CodeServiceUnavailable:   "サービスを一時的に利用できません",
+		CodeGatewayTimeout:       "外部サービスの応答がタイムアウトしました",
+		CodeInvalidCursor:        "カーソルが不正です",
+		CodePreconditionFailed:   "リソースが指定された ETag から変更されています",
+		CodePreconditionRequired: "If-Match ヘッダーが必要です",
+
+		// イベント
+		CodeEventNotFound:          "イベントが見つかりません",
+		CodeEventNameRequired:      "イベント名は必須です",
+		CodeInvalidTotalSeats:      "座席数は1以上である必要があります",
+		CodeInvalidEventTime:       "終了時刻は開始時刻より後である必要があります",
+		CodeEventNotOpen:           "イベントの予約受付期間外です",
+		CodeOptimisticLockConflict: "楽観的ロックの競合が発生しました",
+		CodeInvalidHoldPolicy:      "仮押さえ設定が不正です",
+		CodeInvalidRefundPolicy:    "返金ポリシーが不正です",
+		CodeInvalidPurchaseLimit:   "購入枚数の上限設定が不正です",
+		CodePurchaseLimitExceeded:  "購入枚数の上限を超えています",
+		CodeEventIDRequired:        "イベントIDは必須です",
+		CodeInvalidSearchQuery:     "検索キーワードは1文字以上100文字以下で指定してください",
+		CodeEventInvalidTransition: "イベントの状態を変更できません",
+		CodeInvalidSalesPeriod:     "販売期間が不正です",
+		CodeCancellationNotFound:   "イベントの中止処理が見つかりません",
+		CodeEventVersionMismatch:   "イベントは他のリクエストによって更新されています",
+		CodeTotalSeatsBelowCreated: "座席数は作成済みの座席数より少なくできません",
+		CodeEventHasReservations:   "予約が残っているイベントは削除できません。イベントを中止してください",
+		CodeEventCancelled:         "イベントは中止されています",
+
+		// 座席
+		CodeSeatNotFound:          "座席が見つかりません",
+		CodeSeatNotAvailable:      "座席は予約できません",
+		CodeSeatNotReserved:       "座席は予約されていません",
+		CodeSeatAlreadyReserved:   "座席は既に予約されています",
+		CodeSeatNumberRequired:    "座席番号は必須です",
+		CodeInvalidPrice:          "価格は0以上である必要があります",
+		CodeEmptyLayout:           "座席レイアウトに座席が含まれていません",
+		CodeInvalidLayout:         "座席レイアウトが不正です",
+		CodeLayoutExceedsCapacity: "座席レイアウトの座席数がイベントの総座席数を超えています",
+		CodeSeatCapacityExceeded:  "作成済みの座席数がイベントの総座席数を超えます",
+		CodeInvalidQuantity:       "座席数は1以上である必要があります",
+		CodeInsufficientSeats:     "条件に合う空席が不足しています",
+		CodeNoAdjacentSeats:       "条件に合う連続した空席がありません",
+		CodeLockContention:        "座席が他のユーザーによって処理中です",
+		CodeLockUnavailable:       "座席のロックを取得できません",
+
+		// 予約
+		CodeReservationNotFound:         "予約が見つかりません",
+		CodeReservationNotPending:       "予約は保留中ではありません",
+		CodeReservationExpired:          "予約の有効期限が切れています",
+		CodeReservationAlreadyCancelled: "予約は既にキャンセルされています",
+		CodeReservationAlreadyConfirmed: "予約は既に確定されています",
+		CodeReservationNotConfirmed:     "予約は確定されていません",
+		CodeReservationAlreadyRefunded:  "予約は既に返金されています",
+		CodeUserIDRequired:              "ユーザーIDは必須です",
+		CodeSeatIDsRequired:             "座席IDは必須です",
+		CodeIdempotencyKeyRequired:      "冪等性キーは必須です",
+		CodeIdempotencyKeyConflict:      "同じ冪等性キーの予約が既に存在します",
+		CodeHoldExtensionLimitReached:   "仮押さえの延長回数の上限に達しています",
+		CodeHoldMaxDurationReached:      "仮押さえの最大期間に達しています",
+		CodeRefundPeriodEnded:           "返金の受付期間を過ぎています",
+		CodeSeatNotInReservation:        "指定された座席は予約に含まれていません",
+		CodeCannotRemoveAllSeats:        "全ての座席を外すことはできません。予約をキャンセルしてください",
+		CodeInvalidReservationStatus:    "予約の状態が不正です",
+		CodeReservationRefundPending:    "予約はイベントの中止により返金待ちです",
+
+		// 価格カテゴリ
+		CodePriceCategoryNotFound:     "価格カテゴリが見つかりません",
+		CodePriceCategoryNameRequired: "価格カテゴリ名は必須です",
+		CodeInvalidCurrency:           "通貨コードが不正です",
+		CodeInvalidAmount:             "金額は0以上である必要があります",
+		CodePriceCategoryDuplicate:    "同じ名前の価格カテゴリが既に存在します",
+		CodePriceCategoryInUse:        "座席に割り当てられている価格カテゴリは削除できません",
+		CodePriceCategoryMismatch:     "価格カテゴリが別のイベントに属しています",
+		CodeCurrencyMismatch:          "異なる通貨の価格カテゴリを同一予約に含めることはできません",
+
+		// 待合室
+		CodeQueueTicketNotFound: "待合室のチケットが見つかりません",
+		CodeQueueNotAdmitted:    "待合室からの入場が許可されていません",
+		CodeWaitingRoomDisabled: "このイベントでは待合室は利用できません",
+
+		// 順番待ち
+		CodeWaitlistEntryNotFound:   "順番待ちエントリが見つかりません",
+		CodeWaitlistInvalidQuantity: "座席数は1以上10以下である必要があります",
+		CodeWaitlistAlreadyWaiting:  "既に順番待ちに登録されています",
+		CodeWaitlistSeatsAvailable:  "空席があるため順番待ちは不要です",
+		CodeWaitlistEntryNotWaiting: "順番待ちエントリは待機中ではありません",
+		CodeWaitlistEntryNotOffered: "順番待ちエントリはオファー中ではありません",
+		CodeWaitlistEntryClosed:     "順番待ちエントリは既に終了しています",
+
+		// 決済
+		CodePaymentNotFound:          "決済が見つかりません",
+		CodeReservationIDRequired:    "予約IDは必須です",
+		CodeInvalidPaymentAmount:     "決済金額が不正です",
+		CodePaymentMethodRequired:    "決済手段が必要です",
+		CodePaymentDeclined:          "決済が拒否されました",
+		CodePaymentTimeout:           "決済プロバイダーの応答がタイムアウトしました",
+		CodePaymentInvalidTransition: "決済の状態を変更できません",
+		CodeInvalidWebhookSignature:  "Webhookの署名が不正です",
+		CodeUnknownWebhookEvent:      "不明なWebhookイベントです",
+
+		// 監査ログ
+		CodeInvalidAuditEntity:    "監査ログのエンティティの種別が不正です",
+		CodeAuditEntityIDRequired: "監査ログのエンティティIDは必須です",
+	},
+	LanguageEN: {
+		// 汎用
+		CodeInvalidRequest:       "Invalid request",
+		CodeValidationFailed:     "The request contains invalid fields",
+		CodeUnauthenticated:      "Authentication is required",
+		CodePermissionDenied:     "You do not have permission to perform this operation",
+		CodeNotFound:             "Not found",
+		CodeMethodNotAllowed:     "Method not allowed",
+		CodeConflict:             "The request conflicts with the current state",
+		CodeRateLimited:          "Too many requests",
+		CodeInternal:             "Internal server error",
+		CodeServiceUnavailable:   "The service is temporarily unavailable",
+		CodeGatewayTimeout:       "An upstream service timed out",
+		CodeInvalidCursor:        "Invalid cursor",
+		CodePreconditionFailed:   "The resource has changed since the given ETag",
+		CodePreconditionRequired: "The If-Match header is required",
+
+		// イベント
+		CodeEventNotFound:          "Event not found",
+		CodeEventNameRequired:      "Event name is required",
+		CodeInvalidTotalSeats:      "Total seats must be at least 1",
+		CodeInvalidEventTime:       "End time must be after start time",
+		CodeEventNotOpen:           "The event is not open for booking",
+		CodeOptimisticLockConflict: "The resource was modified by another request",
+		CodeInvalidHoldPolicy:      "Invalid hold settings",
+		CodeInvalidRefundPolicy:    "Invalid refund policy",
+		CodeInvalidPurchaseLimit:   "Invalid purchase limit settings",
+		CodePurchaseLimitExceeded:  "The purchase limit has been exceeded",
+		CodeEventIDRequired:        "Event ID is required",
+		CodeInvalidSearchQuery:     "The search query must be between 1 and 100 characters",
+		CodeEventInvalidTransition: "The event status cannot be changed",
+		CodeInvalidSalesPeriod:     "Invalid sales period",
+		CodeCancellationNotFound:   "Event cancellation not found",
+		CodeEventVersionMismatch:   "The event was modified by another request",
+		CodeTotalSeatsBelowCreated: "The total seats cannot be less than the number of seats already created",
+		CodeEventHasReservations:   "An event with remaining reservations cannot be deleted. Cancel the event instead",
+		CodeEventCancelled:         "The event has been cancelled",
+
+		// 座席
+		CodeSeatNotFound:          "Seat not found",
+		CodeSeatNotAvailable:      "The seat is not available",
+		CodeSeatNotReserved:       "The seat is not reserved",
+		CodeSeatAlreadyReserved:   "The seat is already reserved",
+		CodeSeatNumberRequired:    "Seat number is required",
+		CodeInvalidPrice:          "Price must be 0 or greater",
+		CodeEmptyLayout:           "The seat layout contains no seats",
+		CodeInvalidLayout:         "Invalid seat layout",
+		CodeLayoutExceedsCapacity: "The seat layout has more seats than the event's total seats",
+		CodeSeatCapacityExceeded:  "Adding these seats would exceed the event's total seats",
+		CodeInvalidQuantity:       "Quantity must be at least 1",
+		CodeInsufficientSeats:     "Not enough seats match the criteria",
+		CodeNoAdjacentSeats:       "No adjacent seats match the criteria",
+		CodeLockContention:        "The seats are being processed by another user",
+		CodeLockUnavailable:       "Unable to lock the seats",
+
+		// 予約
+		CodeReservationNotFound:         "Reservation not found",
+		CodeReservationNotPending:       "The reservation is not pending",
+		CodeReservationExpired:          "The reservation has expired",
+		CodeReservationAlreadyCancelled: "The reservation is already cancelled",
+		CodeReservationAlreadyConfirmed: "The reservation is already confirmed",
+		CodeReservationNotConfirmed:     "The reservation is not confirmed",
+		CodeReservationAlreadyRefunded:  "The reservation is already refunded",
+		CodeUserIDRequired:              "User ID is required",
+		CodeSeatIDsRequired:             "Seat IDs are required",
+		CodeIdempotencyKeyRequired:      "Idempotency key is required",
+		CodeIdempotencyKeyConflict:      "A reservation with the same idempotency key already exists",
+		CodeHoldExtensionLimitReached:   "The hold extension limit has been reached",
+		CodeHoldMaxDurationReached:      "The maximum hold duration has been reached",
+		CodeRefundPeriodEnded:           "The refund period has ended",
+		CodeSeatNotInReservation:        "The specified seats are not part of the reservation",
+		CodeCannotRemoveAllSeats:        "Cannot remove all seats. Cancel the reservation instead",
+		CodeInvalidReservationStatus:    "Invalid reservation status",
+		CodeReservationRefundPending:    "The reservation is awaiting a refund because the event was cancelled",
+
+		// 価格カテゴリ
+		CodePriceCategoryNotFound:     "Price category not found",
+		CodePriceCategoryNameRequired: "Price category name is required",
+		CodeInvalidCurrency:           "Invalid currency code",
+		CodeInvalidAmount:             "Amount must be 0 or greater",
+		CodePriceCategoryDuplicate:    "A price category with the same name already exists",
+		CodePriceCategoryInUse:        "The price category is assigned to seats and cannot be deleted",
+		CodePriceCategoryMismatch:     "The price category belongs to another event",
+		CodeCurrencyMismatch:          "A reservation cannot mix price categories with different currencies",
+
+		// 待合室
+		CodeQueueTicketNotFound: "Waiting room ticket not found",
+		CodeQueueNotAdmitted:    "You have not been admitted from the waiting room",
+		CodeWaitingRoomDisabled: "The waiting room is not enabled for this event",
+
+		// 順番待ち
+		CodeWaitlistEntryNotFound:   "Waitlist entry not found",
+		CodeWaitlistInvalidQuantity: "Quantity must be between 1 and 10",
+		CodeWaitlistAlreadyWaiting:  "You are already on the waitlist",
+		CodeWaitlistSeatsAvailable:  "Seats are available, so the waitlist is not needed",
+		CodeWaitlistEntryNotWaiting: "The waitlist entry is not waiting",
+		CodeWaitlistEntryNotOffered: "The waitlist entry has no active offer",
+		CodeWaitlistEntryClosed:     "The waitlist entry is already closed",
+
+		// 決済
+		CodePaymentNotFound:          "Payment not found",
+		CodeReservationIDRequired:    "Reservation ID is required",
+		CodeInvalidPaymentAmount:     "Invalid payment amount",
+		CodePaymentMethodRequired:    "A payment method is required",
+		CodePaymentDeclined:          "The payment was declined",
+		CodePaymentTimeout:           "The payment provider timed out",
+		CodePaymentInvalidTransition: "The payment status cannot be changed",
+		CodeInvalidWebhookSignature:  "Invalid webhook signature",
+		CodeUnknownWebhookEvent:      "Unknown webhook event",
+
+		// 監査ログ
+		CodeInvalidAuditEntity:    "Invalid audit entity type",
+		CodeAuditEntityIDRequired: "The audit entity ID is required",
+	},
+}
+
+// localizedMessage はエラーコードに対応するメッセージを返す（翻訳がない場合は ok=false）
+func localizedMessage(lang Language, code ErrorCode) (string, bool) {
+	msg, ok := messageCatalog[lang][code]
+	return msg, ok
+}
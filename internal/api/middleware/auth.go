@@ -0,0 +1,234 @@
+package middleware
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/audit"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+)
+
+// principalContextKey は検証済みの呼び出し元（JWT の sub と roles）を保持するコンテキストキー
+const principalContextKey = "principal"
+
+// ローカル開発用にユーザーを直接指定するヘッダー
+const (
+	UserIDHeader    = "X-User-ID"
+	UserRolesHeader = "X-User-Roles" // カンマ区切りの役割
+)
+
+// rolesClaim は役割を表すJWTのクレーム名
+const rolesClaim = "roles"
+
+// 認証エラー
+var (
+	ErrInvalidToken   = errors.New("無効な認証トークンです")
+	ErrSubjectMissing = errors.New("認証トークンにユーザーID（sub）がありません")
+)
+
+// AuthConfig はJWT認証の設定
+type AuthConfig struct {
+	// HMACSecret は HS256 の署名検証に使う共有シークレット（空の場合 HS256 を受け付けない）
+	HMACSecret []byte
+	// RSAPublicKeys は RS256 の署名検証に使う公開鍵（キーは JWT ヘッダーの kid）
+	// 公開鍵が1つだけの場合は kid が一致しなくてもその鍵で検証する
+	RSAPublicKeys map[string]*rsa.PublicKey
+	// Issuer / Audience が設定されている場合は iss / aud を検証する
+	Issuer   string
+	Audience string
+	// AllowUserIDHeader は Authorization ヘッダーがない場合に X-User-ID を信頼する（ローカル開発用）
+	// 役割は付与しないため、ヘッダーで指定したユーザーは一般の購入者として扱う
+	AllowUserIDHeader bool
+	// AllowUserRolesHeader は AllowUserIDHeader の場合に X-User-Roles の役割も信頼する
+	// 誰でも管理者を名乗れるため、ローカル開発・E2Eテスト以外では有効にしないこと
+	AllowUserRolesHeader bool
+}
+
+// JWTAuth はBearerトークンを検証し、subject をユーザーIDとしてコンテキストに設定する
+// トークンがないリクエストはそのまま通し、ユーザーIDが必要なハンドラーが 401 を返す
+func JWTAuth(cfg AuthConfig) echo.MiddlewareFunc {
+	var methods []string
+	if len(cfg.HMACSecret) > 0 {
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+	if len(cfg.RSAPublicKeys) > 0 {
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+	opts := []jwt.ParserOption{jwt.WithValidMethods(methods), jwt.WithExpirationRequired()}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	parser := jwt.NewParser(opts...)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			authorization := c.Request().Header.Get(echo.HeaderAuthorization)
+			if authorization == "" {
+				if cfg.AllowUserIDHeader {
+					if userID := c.Request().Header.Get(UserIDHeader); userID != "" {
+						var roles []auth.Role
+						if cfg.AllowUserRolesHeader {
+							roles = parseRoles(c.Request().Header.Get(UserRolesHeader))
+						}
+						SetPrincipal(c, auth.NewPrincipal(userID, roles...))
+					}
+				}
+				return next(c)
+			}
+
+			tokenString, ok := strings.CutPrefix(authorization, "Bearer ")
+			if !ok {
+				return unauthorized(c, ErrInvalidToken)
+			}
+			claims := jwt.MapClaims{}
+			if _, err := parser.ParseWithClaims(tokenString, claims, cfg.keyFunc); err != nil {
+				return unauthorized(c, ErrInvalidToken)
+			}
+			subject, err := claims.GetSubject()
+			if err != nil || subject == "" {
+				return unauthorized(c, ErrSubjectMissing)
+			}
+			SetPrincipal(c, auth.NewPrincipal(subject, rolesFromClaims(claims)...))
+			return next(c)
+		}
+	}
+}
+
+// keyFunc はトークンのアルゴリズムと kid から検証に使う鍵を選ぶ
+func (cfg AuthConfig) keyFunc(token *jwt.Token) (interface{}, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		return cfg.HMACSecret, nil
+	case jwt.SigningMethodRS256.Alg():
+		kid, _ := token.Header["kid"].(string)
+		if key, ok := cfg.RSAPublicKeys[kid]; ok {
+			return key, nil
+		}
+		if len(cfg.RSAPublicKeys) == 1 {
+			for _, key := range cfg.RSAPublicKeys {
+				return key, nil
+			}
+		}
+		return nil, fmt.Errorf("公開鍵が見つかりません: kid=%s", kid)
+	}
+	return nil, fmt.Errorf("未対応の署名アルゴリズム: %s", token.Method.Alg())
+}
+
+// unauthorized は 401 を返す
+func unauthorized(c echo.Context, err error) error {
+	c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+	return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+}
+
+// rolesFromClaims は roles クレーム（文字列の配列）から役割を取り出す
+func rolesFromClaims(claims jwt.MapClaims) []auth.Role {
+	values, _ := claims[rolesClaim].([]interface{})
+	roles := make([]auth.Role, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok && s != "" {
+			roles = append(roles, auth.Role(s))
+		}
+	}
+	return roles
+}
+
+// parseRoles はカンマ区切りの役割を分割する
+func parseRoles(header string) []auth.Role {
+	var roles []auth.Role
+	for _, s := range strings.Split(header, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			roles = append(roles, auth.Role(s))
+		}
+	}
+	return roles
+}
+
+// CurrentPrincipal は認証済みの呼び出し元を返す（未認証の場合はゼロ値）
+func CurrentPrincipal(c echo.Context) auth.Principal {
+	p, _ := c.Get(principalContextKey).(auth.Principal)
+	return p
+}
+
+// UserID は認証済みのユーザーIDを返す（未認証の場合は空文字）
+func UserID(c echo.Context) string {
+	return CurrentPrincipal(c).UserID
+}
+
+// SetPrincipal は認証済みの呼び出し元をコンテキストに設定する
+// リポジトリが監査ログに実行者を記録できるよう、リクエストのコンテキストにもユーザーIDを設定する
+func SetPrincipal(c echo.Context, p auth.Principal) {
+	c.Set(principalContextKey, p)
+	c.SetRequest(c.Request().WithContext(audit.WithActor(c.Request().Context(), p.UserID)))
+}
+
+// SetUserID は役割を持たないユーザーを認証済みの呼び出し元として設定する
+func SetUserID(c echo.Context, userID string) {
+	SetPrincipal(c, auth.NewPrincipal(userID))
+}
+
+// LoadRSAPublicKeyFile はPEM形式のRSA公開鍵を読み込む
+func LoadRSAPublicKeyFile(path string) (*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return jwt.ParseRSAPublicKeyFromPEM(data)
+}
+
+// jwks はJWKSファイルの形式
+type jwks struct {
+	Keys []struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		Alg string `json:"alg"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	} `json:"keys"`
+}
+
+// LoadJWKSFile はローカルのJWKSファイルからRSA公開鍵を kid ごとに読み込む
+// RSA 以外の鍵と署名用途（use=sig）以外の鍵は無視する
+func LoadJWKSFile(path string) (map[string]*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var set jwks
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("JWKSの形式が不正です: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("JWKSの鍵が不正です: kid=%s: %w", k.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("JWKSの鍵が不正です: kid=%s: %w", k.Kid, err)
+		}
+		keys[k.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("JWKSにRSA公開鍵がありません")
+	}
+	return keys, nil
+}
@@ -0,0 +1,260 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/audit"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+)
+
+var testSecret = []byte("test-secret")
+
+func signHS256(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(testSecret)
+	require.NoError(t, err)
+	return s
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString(key)
+	require.NoError(t, err)
+	return s
+}
+
+func validClaims(sub string) jwt.MapClaims {
+	return jwt.MapClaims{"sub": sub, "exp": time.Now().Add(time.Hour).Unix()}
+}
+
+// serveWithAuth はJWTAuthを通したリクエストを実行し、ハンドラーが受け取ったユーザーIDを返す
+func serveWithAuth(cfg AuthConfig, headers map[string]string) (*httptest.ResponseRecorder, string) {
+	e := echo.New()
+	e.Use(JWTAuth(cfg))
+	var userID string
+	e.GET("/test", func(c echo.Context) error {
+		userID = UserID(c)
+		return c.String(http.StatusOK, "ok")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec, userID
+}
+
+func TestJWTAuth_HS256(t *testing.T) {
+	cfg := AuthConfig{HMACSecret: testSecret}
+
+	tests := []struct {
+		name       string
+		token      string
+		wantStatus int
+		wantUserID string
+	}{
+		{"有効なトークン", signHS256(t, validClaims("user-1")), http.StatusOK, "user-1"},
+		{"期限切れ", signHS256(t, jwt.MapClaims{"sub": "user-1", "exp": time.Now().Add(-time.Minute).Unix()}), http.StatusUnauthorized, ""},
+		{"exp なし", signHS256(t, jwt.MapClaims{"sub": "user-1"}), http.StatusUnauthorized, ""},
+		{"sub なし", signHS256(t, jwt.MapClaims{"exp": time.Now().Add(time.Hour).Unix()}), http.StatusUnauthorized, ""},
+		{"署名が不正", signHS256(t, validClaims("user-1")) + "x", http.StatusUnauthorized, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, userID := serveWithAuth(cfg, map[string]string{echo.HeaderAuthorization: "Bearer " + tt.token})
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, tt.wantUserID, userID)
+		})
+	}
+
+	t.Run("Bearer以外の形式は401", func(t *testing.T) {
+		rec, _ := serveWithAuth(cfg, map[string]string{echo.HeaderAuthorization: "Basic dXNlcjpwYXNz"})
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Header().Get(echo.HeaderWWWAuthenticate), "Bearer")
+	})
+
+	t.Run("issuer / audience を検証する", func(t *testing.T) {
+		cfg := AuthConfig{HMACSecret: testSecret, Issuer: "https://auth.example.com", Audience: "ticket-api"}
+		claims := validClaims("user-1")
+		claims["iss"] = "https://auth.example.com"
+		claims["aud"] = "ticket-api"
+
+		rec, userID := serveWithAuth(cfg, map[string]string{echo.HeaderAuthorization: "Bearer " + signHS256(t, claims)})
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "user-1", userID)
+
+		claims["aud"] = "other-api"
+		rec, _ = serveWithAuth(cfg, map[string]string{echo.HeaderAuthorization: "Bearer " + signHS256(t, claims)})
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestJWTAuth_RS256(t *testing.T) {
+	key1, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	key2, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	t.Run("kid に一致する公開鍵で検証する", func(t *testing.T) {
+		cfg := AuthConfig{RSAPublicKeys: map[string]*rsa.PublicKey{"k1": &key1.PublicKey, "k2": &key2.PublicKey}}
+
+		rec, userID := serveWithAuth(cfg, map[string]string{echo.HeaderAuthorization: "Bearer " + signRS256(t, key2, "k2", validClaims("user-2"))})
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "user-2", userID)
+
+		rec, _ = serveWithAuth(cfg, map[string]string{echo.HeaderAuthorization: "Bearer " + signRS256(t, key2, "k1", validClaims("user-2"))})
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("HS256 の鍵がない場合 HS256 のトークンは受け付けない", func(t *testing.T) {
+		cfg := AuthConfig{RSAPublicKeys: map[string]*rsa.PublicKey{"": &key1.PublicKey}}
+
+		rec, _ := serveWithAuth(cfg, map[string]string{echo.HeaderAuthorization: "Bearer " + signHS256(t, validClaims("user-1"))})
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestJWTAuth_Roles(t *testing.T) {
+	serve := func(cfg AuthConfig, headers map[string]string) auth.Principal {
+		e := echo.New()
+		e.Use(JWTAuth(cfg))
+		var p auth.Principal
+		e.GET("/test", func(c echo.Context) error {
+			p = CurrentPrincipal(c)
+			return c.String(http.StatusOK, "ok")
+		})
+		req := httptest.NewRequest(http.MethodGet, "/test", nil)
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		e.ServeHTTP(httptest.NewRecorder(), req)
+		return p
+	}
+
+	t.Run("roles クレームから役割を取り出す", func(t *testing.T) {
+		claims := validClaims("admin-1")
+		claims["roles"] = []string{"admin"}
+
+		p := serve(AuthConfig{HMACSecret: testSecret}, map[string]string{echo.HeaderAuthorization: "Bearer " + signHS256(t, claims)})
+
+		assert.Equal(t, "admin-1", p.UserID)
+		assert.True(t, p.IsAdmin())
+	})
+
+	t.Run("役割ヘッダーを許可した互換モードではX-User-Rolesを使う", func(t *testing.T) {
+		p := serve(AuthConfig{AllowUserIDHeader: true, AllowUserRolesHeader: true}, map[string]string{UserIDHeader: "admin-1", UserRolesHeader: "customer, admin"})
+
+		assert.Equal(t, []auth.Role{"customer", auth.RoleAdmin}, p.Roles)
+	})
+
+	t.Run("役割ヘッダーを許可していなければX-User-Rolesは無視する", func(t *testing.T) {
+		p := serve(AuthConfig{AllowUserIDHeader: true}, map[string]string{UserIDHeader: "user-1", UserRolesHeader: "admin"})
+
+		assert.Equal(t, "user-1", p.UserID)
+		assert.Empty(t, p.Roles)
+		assert.False(t, p.IsAdmin())
+	})
+}
+
+func TestJWTAuth_AuditActor(t *testing.T) {
+	e := echo.New()
+	e.Use(JWTAuth(AuthConfig{HMACSecret: testSecret}))
+	var actor string
+	e.GET("/test", func(c echo.Context) error {
+		actor = audit.ActorFromContext(c.Request().Context())
+		return c.String(http.StatusOK, "ok")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+signHS256(t, validClaims("user-1")))
+	e.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "user-1", actor, "監査ログの実行者としてリクエストのコンテキストに設定する")
+
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/test", nil))
+	assert.Equal(t, audit.SystemActor, actor)
+}
+
+func TestJWTAuth_UserIDHeader(t *testing.T) {
+	t.Run("互換モードではX-User-IDを使う", func(t *testing.T) {
+		rec, userID := serveWithAuth(AuthConfig{AllowUserIDHeader: true}, map[string]string{UserIDHeader: "user-1"})
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "user-1", userID)
+	})
+
+	t.Run("互換モードでなければX-User-IDは無視する", func(t *testing.T) {
+		rec, userID := serveWithAuth(AuthConfig{HMACSecret: testSecret}, map[string]string{UserIDHeader: "user-1"})
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, userID)
+	})
+
+	t.Run("トークンがあればX-User-IDより優先する", func(t *testing.T) {
+		cfg := AuthConfig{HMACSecret: testSecret, AllowUserIDHeader: true}
+		rec, userID := serveWithAuth(cfg, map[string]string{
+			echo.HeaderAuthorization: "Bearer " + signHS256(t, validClaims("user-1")),
+			UserIDHeader:             "user-2",
+		})
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "user-1", userID)
+	})
+}
+
+func TestLoadRSAPublicKeyFile(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "public.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+	got, err := LoadRSAPublicKeyFile(path)
+
+	require.NoError(t, err)
+	assert.True(t, key.PublicKey.Equal(got))
+}
+
+func TestLoadJWKSFile(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	n := base64.RawURLEncoding.EncodeToString(key.N.Bytes())
+	e := base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	jwksJSON := fmt.Sprintf(`{"keys":[
+		{"kty":"RSA","kid":"k1","use":"sig","alg":"RS256","n":%q,"e":%q},
+		{"kty":"EC","kid":"ec","crv":"P-256","x":"","y":""}
+	]}`, n, e)
+	require.NoError(t, os.WriteFile(path, []byte(jwksJSON), 0o600))
+
+	keys, err := LoadJWKSFile(path)
+
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.True(t, key.PublicKey.Equal(keys["k1"]))
+}
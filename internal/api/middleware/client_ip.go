@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// NewIPExtractor は c.RealIP() でクライアントIPを求める方法を返す
+// 信頼するプロキシが未設定の場合は接続元のアドレスを使い、クライアントが送る X-Forwarded-For で
+// IPごとのレート制限を回避できないようにする。設定時は信頼するプロキシを経由した X-Forwarded-For だけを使う
+func NewIPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+	// ループバック・プライベートアドレスも設定した範囲に含まれる場合だけ信頼する
+	options := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, proxy := range trustedProxies {
+		ipNet, err := parseTrustedProxy(proxy)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
+
+// parseTrustedProxy はCIDRまたは単一のIPアドレスをIP範囲に変換する
+func parseTrustedProxy(proxy string) (*net.IPNet, error) {
+	if !strings.Contains(proxy, "/") {
+		ip := net.ParseIP(proxy)
+		if ip == nil {
+			return nil, fmt.Errorf("信頼するプロキシのIPアドレスが不正です: %s", proxy)
+		}
+		bits := 8 * net.IPv6len
+		if ip.To4() != nil {
+			ip, bits = ip.To4(), 8*net.IPv4len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, ipNet, err := net.ParseCIDR(proxy)
+	if err != nil {
+		return nil, fmt.Errorf("信頼するプロキシのIP範囲が不正です: %s", proxy)
+	}
+	return ipNet, nil
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func realIP(t *testing.T, trustedProxies []string, remoteAddr, xff string) string {
+	t.Helper()
+	extractor, err := NewIPExtractor(trustedProxies)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = remoteAddr + ":12345"
+	if xff != "" {
+		req.Header.Set(echo.HeaderXForwardedFor, xff)
+	}
+	return extractor(req)
+}
+
+func TestNewIPExtractor(t *testing.T) {
+	t.Run("信頼するプロキシが未設定の場合はX-Forwarded-Forを無視する", func(t *testing.T) {
+		assert.Equal(t, "192.0.2.1", realIP(t, nil, "192.0.2.1", "203.0.113.9"))
+		// プライベートアドレスからの接続でも偽装したヘッダーは使わない
+		assert.Equal(t, "10.0.0.5", realIP(t, nil, "10.0.0.5", "203.0.113.9"))
+	})
+
+	t.Run("信頼するプロキシを経由した場合はX-Forwarded-Forのクライアントを使う", func(t *testing.T) {
+		proxies := []string{"10.0.0.0/8"}
+
+		assert.Equal(t, "203.0.113.9", realIP(t, proxies, "10.0.0.5", "203.0.113.9"))
+		// クライアントが先頭に付け足したアドレスは信頼しない
+		assert.Equal(t, "203.0.113.9", realIP(t, proxies, "10.0.0.5", "198.51.100.1, 203.0.113.9"))
+	})
+
+	t.Run("信頼しない接続元のX-Forwarded-Forは無視する", func(t *testing.T) {
+		assert.Equal(t, "192.0.2.1", realIP(t, []string{"10.0.0.0/8"}, "192.0.2.1", "203.0.113.9"))
+		// 範囲外のプライベートアドレスも信頼しない
+		assert.Equal(t, "172.16.0.1", realIP(t, []string{"10.0.0.0/8"}, "172.16.0.1", "203.0.113.9"))
+	})
+
+	t.Run("単一のIPアドレスを指定できる", func(t *testing.T) {
+		assert.Equal(t, "203.0.113.9", realIP(t, []string{"192.0.2.10"}, "192.0.2.10", "203.0.113.9"))
+		assert.Equal(t, "192.0.2.11", realIP(t, []string{"192.0.2.10"}, "192.0.2.11", "203.0.113.9"))
+	})
+
+	t.Run("不正なIP範囲はエラー", func(t *testing.T) {
+		_, err := NewIPExtractor([]string{"10.0.0.0/33"})
+		assert.Error(t, err)
+		_, err = NewIPExtractor([]string{"proxy.internal"})
+		assert.Error(t, err)
+	})
+}
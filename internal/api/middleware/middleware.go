@@ -27,5 +27,7 @@ func SetupMiddleware(e *echo.Echo) {
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{"*"},
 		AllowMethods: []string{echo.GET, echo.HEAD, echo.PUT, echo.PATCH, echo.POST, echo.DELETE},
+		// ブラウザから ETag を読み取り、If-Match で送り返せるようにする
+		ExposeHeaders: []string{HeaderETag},
 	}))
 }
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 楽観的ロック（条件付きリクエスト）で使うヘッダー
+const (
+	HeaderETag    = "ETag"
+	HeaderIfMatch = "If-Match"
+)
+
+// RequireIfMatch は If-Match ヘッダーのない更新リクエストを 428 Precondition Required で拒否する（strict モード）
+// 取得した ETag を送らずに上書きする「後勝ち」の更新を防ぐ。ETag の比較はハンドラーが行う
+func RequireIfMatch() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().Header.Get(HeaderIfMatch) == "" {
+				return echo.NewHTTPError(http.StatusPreconditionRequired, "If-Match ヘッダーが必要です")
+			}
+			return next(c)
+		}
+	}
+}
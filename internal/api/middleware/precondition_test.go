@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireIfMatch(t *testing.T) {
+	e := echo.New()
+	e.PUT("/events/:id", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}, RequireIfMatch())
+
+	t.Run("If-Match があれば通す", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/events/event-1", nil)
+		req.Header.Set(HeaderIfMatch, `"3"`)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("If-Match がなければ428", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/events/event-1", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	})
+}
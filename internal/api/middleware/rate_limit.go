@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+
+	redisinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/redis"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/logger"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/metrics"
+)
+
+// レート制限の状態を返すヘッダー
+const (
+	RateLimitLimitHeader     = "X-RateLimit-Limit"
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+)
+
+// RateLimitRule はルートグループごとのレート制限
+type RateLimitRule struct {
+	Name   string // Redis のキーとメトリクスのラベルに使うルール名
+	Limit  int    // Window あたりの最大リクエスト数
+	Window time.Duration
+}
+
+// RateLimit はルールごとにリクエスト数を制限するミドルウェア
+// 認証済みのリクエストはユーザーID、未認証のリクエストはクライアントIPごとに数える（JWTAuth の後に適用すること）。
+// 複数インスタンスで上限を共有するため、判定は Redis で行う。Redis に障害がある場合は制限せずに通す
+func RateLimit(limiter redisinfra.RateLimiterInterface, rule RateLimitRule, m *metrics.Metrics) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			keyType, id := "ip", c.RealIP()
+			if userID := UserID(c); userID != "" {
+				keyType, id = "user", userID
+			}
+
+			res, err := limiter.Allow(c.Request().Context(), fmt.Sprintf("%s:%s:%s", rule.Name, keyType, id), rule.Limit, rule.Window)
+			if err != nil {
+				logger.Warn("レート制限の判定に失敗（制限せずに処理を継続）", zap.String("rule", rule.Name), zap.Error(err))
+				return next(c)
+			}
+
+			header := c.Response().Header()
+			header.Set(RateLimitLimitHeader, strconv.Itoa(rule.Limit))
+			header.Set(RateLimitRemainingHeader, strconv.Itoa(res.Remaining))
+			if !res.Allowed {
+				header.Set(echo.HeaderRetryAfter, strconv.Itoa(retryAfterSeconds(res.RetryAfter)))
+				if m != nil {
+					m.RateLimitedRequestsTotal.WithLabelValues(rule.Name, keyType).Inc()
+				}
+				return echo.NewHTTPError(http.StatusTooManyRequests, "リクエストが多すぎます。しばらくしてから再度お試しください")
+			}
+			return next(c)
+		}
+	}
+}
+
+// retryAfterSeconds は Retry-After ヘッダーに設定する秒数（切り上げ、最低1秒）を返す
+func retryAfterSeconds(d time.Duration) int {
+	s := int(math.Ceil(d.Seconds()))
+	if s < 1 {
+		return 1
+	}
+	return s
+}
@@ -0,0 +1,130 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	redisinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/redis"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/metrics"
+)
+
+// fakeRateLimiter はキーごとの受付回数をメモリで数えるテスト用のレート制限
+type fakeRateLimiter struct {
+	counts map[string]int
+	err    error
+}
+
+func (f *fakeRateLimiter) Allow(_ context.Context, key string, limit int, window time.Duration) (*redisinfra.RateLimitResult, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.counts[key] >= limit {
+		return &redisinfra.RateLimitResult{RetryAfter: window}, nil
+	}
+	f.counts[key]++
+	return &redisinfra.RateLimitResult{Allowed: true, Remaining: limit - f.counts[key]}, nil
+}
+
+func newRateLimitedEcho(limiter redisinfra.RateLimiterInterface, m *metrics.Metrics) *echo.Echo {
+	e := echo.New()
+	e.Use(JWTAuth(AuthConfig{AllowUserIDHeader: true}))
+	e.Use(RateLimit(limiter, RateLimitRule{Name: "api", Limit: 2, Window: 90 * time.Second}, m))
+	e.GET("/test", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+	return e
+}
+
+func serveRateLimited(e *echo.Echo, userID, ip string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	if userID != "" {
+		req.Header.Set(UserIDHeader, userID)
+	}
+	req.RemoteAddr = ip + ":12345"
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+// rateLimitedCount は拒否したリクエスト数をラベルごとに集計する
+func rateLimitedCount(t *testing.T, reg *prometheus.Registry, keyType string) float64 {
+	t.Helper()
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	var total float64
+	for _, f := range families {
+		if f.GetName() != "http_rate_limited_requests_total" {
+			continue
+		}
+		for _, metric := range f.GetMetric() {
+			for _, l := range metric.GetLabel() {
+				if l.GetName() == "key_type" && l.GetValue() == keyType {
+					total += metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return total
+}
+
+func TestRateLimit(t *testing.T) {
+	t.Run("上限を超えると429とRetry-Afterを返す", func(t *testing.T) {
+		reg := prometheus.NewRegistry()
+		m := metrics.NewWithRegistry(reg)
+		e := newRateLimitedEcho(&fakeRateLimiter{counts: map[string]int{}}, m)
+
+		rec := serveRateLimited(e, "user-1", "192.0.2.1")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "2", rec.Header().Get(RateLimitLimitHeader))
+		assert.Equal(t, "1", rec.Header().Get(RateLimitRemainingHeader))
+		assert.Equal(t, http.StatusOK, serveRateLimited(e, "user-1", "192.0.2.1").Code)
+
+		rec = serveRateLimited(e, "user-1", "192.0.2.1")
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "90", rec.Header().Get(echo.HeaderRetryAfter))
+		assert.Equal(t, float64(1), rateLimitedCount(t, reg, "user"))
+	})
+
+	t.Run("ユーザーごとに数える", func(t *testing.T) {
+		e := newRateLimitedEcho(&fakeRateLimiter{counts: map[string]int{}}, nil)
+		serveRateLimited(e, "user-1", "192.0.2.1")
+		serveRateLimited(e, "user-1", "192.0.2.1")
+
+		// 同じIPでも別のユーザーは制限されない
+		assert.Equal(t, http.StatusOK, serveRateLimited(e, "user-2", "192.0.2.1").Code)
+	})
+
+	t.Run("未認証のリクエストはIPごとに数える", func(t *testing.T) {
+		reg := prometheus.NewRegistry()
+		m := metrics.NewWithRegistry(reg)
+		e := newRateLimitedEcho(&fakeRateLimiter{counts: map[string]int{}}, m)
+		serveRateLimited(e, "", "192.0.2.1")
+		serveRateLimited(e, "", "192.0.2.1")
+
+		assert.Equal(t, http.StatusTooManyRequests, serveRateLimited(e, "", "192.0.2.1").Code)
+		assert.Equal(t, http.StatusOK, serveRateLimited(e, "", "192.0.2.2").Code)
+		assert.Equal(t, float64(1), rateLimitedCount(t, reg, "ip"))
+	})
+
+	t.Run("Redisに障害がある場合は制限しない", func(t *testing.T) {
+		e := newRateLimitedEcho(&fakeRateLimiter{err: errors.New("connection refused")}, nil)
+
+		assert.Equal(t, http.StatusOK, serveRateLimited(e, "user-1", "192.0.2.1").Code)
+	})
+}
+
+func TestRetryAfterSeconds(t *testing.T) {
+	assert.Equal(t, 1, retryAfterSeconds(0))
+	assert.Equal(t, 1, retryAfterSeconds(200*time.Millisecond))
+	assert.Equal(t, 2, retryAfterSeconds(1500*time.Millisecond))
+	assert.Equal(t, 60, retryAfterSeconds(time.Minute))
+}
@@ -1,6 +1,13 @@
 package api
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 )
@@ -8,17 +15,75 @@ import (
 // CustomValidator はEcho用のカスタムバリデーター
 type CustomValidator struct {
 	validator *validator.Validate
+	uni       *ut.UniversalTranslator
 }
 
 // NewValidator は新しいバリデーターを作成する
+// エラーの項目名はクライアントが送る JSON のキーで返し、メッセージは日本語と英語に翻訳できるようにする
 func NewValidator() *CustomValidator {
-	return &CustomValidator{validator: validator.New()}
+	v := validator.New()
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name := strings.SplitN(f.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return f.Name
+		}
+		return name
+	})
+	uni, err := newUniversalTranslator(v)
+	if err != nil {
+		// 既定の翻訳の登録は入力に依存しないため、失敗するのはプログラムの誤り
+		panic(fmt.Sprintf("バリデーションの翻訳の登録に失敗: %v", err))
+	}
+	return &CustomValidator{validator: v, uni: uni}
 }
 
 // Validate はリクエストのバリデーションを実行する
+// 項目ごとの詳細は CustomHTTPErrorHandler が Internal の ValidationError から言語に合わせて組み立てる
 func (cv *CustomValidator) Validate(i interface{}) error {
-	if err := cv.validator.Struct(i); err != nil {
-		return echo.NewHTTPError(400, err.Error())
+	err := cv.validator.Struct(i)
+	if err == nil {
+		return nil
+	}
+	msg, _ := localizedMessage(DefaultLanguage, CodeValidationFailed)
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return echo.NewHTTPError(http.StatusBadRequest, msg).SetInternal(err)
+	}
+	return echo.NewHTTPError(http.StatusBadRequest, msg).SetInternal(&ValidationError{errs: errs, uni: cv.uni})
+}
+
+// ValidationError はバリデーションエラーと、その翻訳に使う UniversalTranslator を保持する
+type ValidationError struct {
+	errs validator.ValidationErrors
+	uni  *ut.UniversalTranslator
+}
+
+func (e *ValidationError) Error() string { return e.errs.Error() }
+
+func (e *ValidationError) Unwrap() error { return e.errs }
+
+// FieldError は項目単位のバリデーションエラー
+type FieldError struct {
+	Field   string `json:"field" example:"seat_ids"`
+	Rule    string `json:"rule" example:"min"`
+	Param   string `json:"param,omitempty" example:"1"`
+	Message string `json:"message" example:"seat_idsは1つ以上の項目を含まなければなりません"`
+}
+
+// Fields は項目単位のエラーを指定した言語のメッセージで返す
+// 項目名はリクエスト構造体名を除いたパス（例: layout.sections[0].name）
+func (e *ValidationError) Fields(lang Language) []FieldError {
+	trans, _ := e.uni.GetTranslator(string(lang))
+	fields := make([]FieldError, len(e.errs))
+	for i, fe := range e.errs {
+		field := fe.Namespace()
+		if idx := strings.Index(field, "."); idx >= 0 {
+			field = field[idx+1:]
+		}
+		fields[i] = FieldError{Field: field, Rule: fe.Tag(), Param: fe.Param(), Message: fe.Translate(trans)}
 	}
-	return nil
+	return fields
 }
@@ -0,0 +1,59 @@
+package application
+
+import (
+	"context"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/audit"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pagination"
+)
+
+// AuditService はイベント・座席・予約の変更履歴（監査ログ）を参照する
+type AuditService struct {
+	auditRepo audit.Repository
+}
+
+// NewAuditService は監査ログのサービスを作成する
+func NewAuditService(ar audit.Repository) *AuditService {
+	return &AuditService{auditRepo: ar}
+}
+
+// ListAuditEntriesInput は監査ログを参照するエンティティとページの位置
+type ListAuditEntriesInput struct {
+	EntityType audit.EntityType
+	EntityID   string
+
+	Cursor string // 前のページの NextCursor（空の場合は先頭ページ）
+	Limit  int
+}
+
+// ListEntries はエンティティの監査ログを新しい順に1ページ分取得する（サポート担当者または管理者のみ）
+func (s *AuditService) ListEntries(ctx context.Context, input ListAuditEntriesInput, principal auth.Principal) (*pagination.Page[*audit.Entry], error) {
+	if err := principal.RequireSupport(); err != nil {
+		return nil, err
+	}
+	if !input.EntityType.IsValid() {
+		return nil, audit.ErrInvalidEntityType
+	}
+	if input.EntityID == "" {
+		return nil, audit.ErrEntityIDRequired
+	}
+	after, err := pagination.DecodeCursor(input.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit := pagination.NormalizeLimit(input.Limit)
+	// 次のページの有無を判定するため1件多く取得する
+	entries, err := s.auditRepo.List(ctx, audit.ListFilter{
+		EntityType: input.EntityType,
+		EntityID:   input.EntityID,
+		After:      after,
+		Limit:      limit + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pagination.NewPage(entries, limit, func(e *audit.Entry) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: e.CreatedAt, ID: e.ID}
+	}), nil
+}
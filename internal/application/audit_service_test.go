@@ -0,0 +1,71 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/audit"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+)
+
+// MockAuditRepository implements audit.Repository
+type MockAuditRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuditRepository) List(ctx context.Context, filter audit.ListFilter) ([]*audit.Entry, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*audit.Entry), args.Error(1)
+}
+
+var testSupport = auth.NewPrincipal("support-1", auth.RoleSupport)
+
+func TestAuditService_ListEntries(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("エンティティの監査ログを取得できる", func(t *testing.T) {
+		repo := new(MockAuditRepository)
+		entries := []*audit.Entry{
+			{ID: "550e8400-e29b-41d4-a716-446655440002", EntityType: audit.EntityEvent, EntityID: "event-1", Action: audit.ActionUpdate, CreatedAt: time.Now()},
+			{ID: "550e8400-e29b-41d4-a716-446655440001", EntityType: audit.EntityEvent, EntityID: "event-1", Action: audit.ActionCreate, CreatedAt: time.Now().Add(-time.Minute)},
+		}
+		repo.On("List", ctx, audit.ListFilter{EntityType: audit.EntityEvent, EntityID: "event-1", Limit: 2}).Return(entries, nil)
+
+		page, err := NewAuditService(repo).ListEntries(ctx, ListAuditEntriesInput{
+			EntityType: audit.EntityEvent, EntityID: "event-1", Limit: 1,
+		}, testSupport)
+
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 1)
+		assert.True(t, page.HasMore())
+	})
+
+	t.Run("サポート担当者・管理者以外は参照できない", func(t *testing.T) {
+		repo := new(MockAuditRepository)
+
+		_, err := NewAuditService(repo).ListEntries(ctx, ListAuditEntriesInput{
+			EntityType: audit.EntityEvent, EntityID: "event-1",
+		}, testOrganizer)
+
+		assert.ErrorIs(t, err, auth.ErrPermissionDenied)
+		repo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+	})
+
+	t.Run("不正な条件はエラー", func(t *testing.T) {
+		svc := NewAuditService(new(MockAuditRepository))
+
+		_, err := svc.ListEntries(ctx, ListAuditEntriesInput{EntityType: "payment", EntityID: "p-1"}, testSupport)
+		assert.ErrorIs(t, err, audit.ErrInvalidEntityType)
+
+		_, err = svc.ListEntries(ctx, ListAuditEntriesInput{EntityType: audit.EntitySeat}, testSupport)
+		assert.ErrorIs(t, err, audit.ErrEntityIDRequired)
+	})
+}
@@ -44,8 +44,8 @@ func TestBenchmark_LargeScaleSeats(t *testing.T) {
 	reservationRepo := postgres.NewReservationRepository(db)
 	txManager := postgres.NewTxManager(db)
 
-	eventService := NewEventService(eventRepo)
-	seatService := NewSeatService(seatRepo, eventRepo, nil)
+	eventService := NewEventService(eventRepo, seatRepo, reservationRepo)
+	seatService := NewSeatService(seatRepo, eventRepo, nil, nil)
 	reservationService := NewReservationService(txManager, reservationRepo, seatRepo, eventRepo, lockManager, nil)
 
 	cleanup := func() {
@@ -70,8 +70,10 @@ func TestBenchmark_LargeScaleSeats(t *testing.T) {
 			StartAt:    time.Now().Add(60 * 24 * time.Hour),
 			EndAt:      time.Now().Add(60*24*time.Hour + 4*time.Hour),
 			TotalSeats: totalSeats,
+			Principal:  testOrganizer,
 		})
 		require.NoError(t, err)
+		openEventSales(t, ctx, eventService, event.ID)
 
 		// 2. 10万座席を一括作成（バッチ処理で高速化）
 		t.Log("=== 10万座席の一括作成開始 ===")
@@ -83,10 +85,11 @@ func TestBenchmark_LargeScaleSeats(t *testing.T) {
 		for batch := 0; batch < numBatches; batch++ {
 			prefix := fmt.Sprintf("SEC%02d", batch+1)
 			_, err = seatService.CreateBulkSeats(ctx, CreateBulkSeatsInput{
-				EventID: event.ID,
-				Prefix:  prefix,
-				Count:   batchSize,
-				Price:   5000 + (batch * 1000), // セクションごとに価格を変える
+				EventID:   event.ID,
+				Prefix:    prefix,
+				Count:     batchSize,
+				Price:     5000 + (batch * 1000), // セクションごとに価格を変える
+				Principal: testOrganizer,
 			})
 			require.NoError(t, err)
 
@@ -220,21 +223,22 @@ func BenchmarkSeatQueries(b *testing.B) {
 
 	eventRepo := postgres.NewEventRepository(db)
 	seatRepo := postgres.NewSeatRepository(db)
-	seatService := NewSeatService(seatRepo, eventRepo, nil)
+	seatService := NewSeatService(seatRepo, eventRepo, nil, nil)
 
 	ctx := context.Background()
 
 	// テストデータ準備
-	event, _ := NewEventService(eventRepo).CreateEvent(ctx, CreateEventInput{
+	event, _ := NewEventService(eventRepo, seatRepo, nil).CreateEvent(ctx, CreateEventInput{
 		Name:       "ベンチマーク用イベント",
 		Venue:      "テスト会場",
 		StartAt:    time.Now().Add(30 * 24 * time.Hour),
 		EndAt:      time.Now().Add(30*24*time.Hour + 2*time.Hour),
 		TotalSeats: 1000,
+		Principal:  testOrganizer,
 	})
 
 	seatService.CreateBulkSeats(ctx, CreateBulkSeatsInput{
-		EventID: event.ID, Prefix: "BENCH", Count: 1000, Price: 5000,
+		EventID: event.ID, Prefix: "BENCH", Count: 1000, Price: 5000, Principal: testOrganizer,
 	})
 
 	b.Run("CountAvailableSeats", func(b *testing.B) {
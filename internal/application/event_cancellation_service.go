@@ -0,0 +1,249 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/outbox"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/reservation"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/transaction"
+	redisinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/redis"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/logger"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/metrics"
+)
+
+// EventCancellationService はイベントを中止し、予約の取り消しと座席の解放を行う
+// 予約はバッチごとに別トランザクションで処理し、進捗を event_cancellations に記録する
+type EventCancellationService struct {
+	txManager        transaction.Manager
+	eventRepo        event.Repository
+	cancellationRepo event.CancellationRepository
+	reservationRepo  reservation.Repository
+	seatRepo         seat.Repository
+	seatCache        redisinfra.SeatCacheInterface
+	outboxRepo       outbox.Repository
+	batchSize        int
+}
+
+// EventCancellationOption はEventCancellationServiceの任意の依存を設定する
+type EventCancellationOption func(*EventCancellationService)
+
+// WithCancellationOutbox は予約の取り消しをドメインイベントとしてアウトボックスに記録する
+func WithCancellationOutbox(or outbox.Repository) EventCancellationOption {
+	return func(s *EventCancellationService) { s.outboxRepo = or }
+}
+
+// NewEventCancellationService はイベントの中止サービスを作成する
+// 1トランザクションで処理する予約・座席の数は batchSize 件まで
+func NewEventCancellationService(txm transaction.Manager, er event.Repository, cr event.CancellationRepository, rr reservation.Repository, sr seat.Repository, cache redisinfra.SeatCacheInterface, batchSize int, opts ...EventCancellationOption) *EventCancellationService {
+	s := &EventCancellationService{
+		txManager: txm, eventRepo: er, cancellationRepo: cr, reservationRepo: rr, seatRepo: sr,
+		seatCache: cache, batchSize: batchSize,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// CancelEvent はイベントを中止し、予約の取り消しを開始する（イベントの主催者または管理者のみ）
+// 予約の取り消しと座席の解放は ProcessPending で進める。既に中止処理を開始している場合はその進捗を返す
+func (s *EventCancellationService) CancelEvent(ctx context.Context, id string, principal auth.Principal) (*event.Cancellation, error) {
+	e, err := authorizeEventManagement(ctx, s.eventRepo, id, principal)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.cancellationRepo.GetByEventID(ctx, id)
+	if err == nil {
+		return c, nil
+	}
+	if !errors.Is(err, event.ErrCancellationNotFound) {
+		return nil, err
+	}
+
+	total, err := s.reservationRepo.CountActiveByEventID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// 進捗を先に記録し、イベントの更新前に停止しても ProcessPending で中止から再開できるようにする
+	c = event.NewCancellation(id, total)
+	if err := s.cancellationRepo.Create(ctx, c); err != nil {
+		if errors.Is(err, event.ErrCancellationExists) {
+			return s.cancellationRepo.GetByEventID(ctx, id)
+		}
+		return nil, err
+	}
+	if err := s.ensureCancelled(ctx, e); err != nil {
+		return nil, err
+	}
+
+	logger.Info("イベントを中止",
+		zap.String("event_id", id), zap.Int("total_reservations", total))
+	return c, nil
+}
+
+// GetCancellation はイベントの中止処理の進捗を取得する（イベントの主催者または管理者のみ）
+func (s *EventCancellationService) GetCancellation(ctx context.Context, id string, principal auth.Principal) (*event.Cancellation, error) {
+	if _, err := authorizeEventManagement(ctx, s.eventRepo, id, principal); err != nil {
+		return nil, err
+	}
+	return s.cancellationRepo.GetByEventID(ctx, id)
+}
+
+// ProcessPending は完了していない中止処理を最後まで進め、取り消した予約数を返す
+// 途中で停止した中止処理も未処理の予約から再開する
+func (s *EventCancellationService) ProcessPending(ctx context.Context) (int, error) {
+	cancellations, err := s.cancellationRepo.ListRunning(ctx)
+	if err != nil {
+		return 0, err
+	}
+	processed := 0
+	for _, c := range cancellations {
+		n, err := s.process(ctx, c.EventID)
+		processed += n
+		if err != nil {
+			return processed, fmt.Errorf("イベント %s の中止処理に失敗: %w", c.EventID, err)
+		}
+	}
+	return processed, nil
+}
+
+// process は1つのイベントの中止処理をバッチごとにコミットしながら完了まで進める
+func (s *EventCancellationService) process(ctx context.Context, eventID string) (int, error) {
+	e, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return 0, fmt.Errorf("イベント取得に失敗: %w", err)
+	}
+	if err := s.ensureCancelled(ctx, e); err != nil {
+		return 0, err
+	}
+
+	processed, released := 0, 0
+	defer func() {
+		if released > 0 {
+			s.invalidateSeatCache(ctx, eventID)
+		}
+	}()
+	for {
+		if err := ctx.Err(); err != nil {
+			return processed, err
+		}
+		c, n, r, err := s.processBatch(ctx, eventID)
+		if err != nil {
+			return processed, err
+		}
+		processed += n
+		released += r
+		logger.Info("イベントの中止処理の進捗",
+			zap.String("event_id", eventID),
+			zap.Int("processed_reservations", c.ProcessedReservations),
+			zap.Int("total_reservations", c.TotalReservations),
+			zap.Int("released_seats", c.ReleasedSeats),
+		)
+		if c.IsCompleted() {
+			return processed, nil
+		}
+	}
+}
+
+// processBatch は最大 batchSize 件の予約を取り消して座席を解放し、進捗と合わせて1トランザクションで記録する
+// 取り消した予約数と解放した座席数を返す
+// 予約がなくなった後は予約に紐付かない座席を解放し、解放する座席もなくなったら中止処理を完了にする
+func (s *EventCancellationService) processBatch(ctx context.Context, eventID string) (*event.Cancellation, int, int, error) {
+	tx, err := s.txManager.Begin(ctx)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("トランザクション開始に失敗: %w", err)
+	}
+	defer tx.Rollback()
+
+	// 他のワーカーが同じイベントを処理している場合はコミットまで待つ
+	c, err := s.cancellationRepo.GetForUpdate(ctx, tx, eventID)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if c.IsCompleted() {
+		return c, 0, 0, nil
+	}
+
+	reservations, err := s.reservationRepo.LockActiveByEventID(ctx, tx, eventID, s.batchSize)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	var cancelled, refundPending, released int
+	if len(reservations) > 0 {
+		for _, res := range reservations {
+			eventType := outbox.EventReservationCancelled
+			if res.Status == reservation.StatusConfirmed {
+				if err := res.MarkForRefund(); err != nil {
+					return nil, 0, 0, err
+				}
+				eventType = outbox.EventReservationRefundRequested
+				refundPending++
+			} else {
+				if err := res.Cancel(); err != nil {
+					return nil, 0, 0, err
+				}
+				cancelled++
+			}
+			if err := s.reservationRepo.Update(ctx, tx, res); err != nil {
+				return nil, 0, 0, err
+			}
+			if err := appendReservationEvent(ctx, s.outboxRepo, tx, eventType, newReservationEventPayload(res)); err != nil {
+				return nil, 0, 0, err
+			}
+			if err := s.seatRepo.ReleaseSeats(ctx, tx, res.ID, res.SeatIDs); err != nil {
+				return nil, 0, 0, err
+			}
+			released += len(res.SeatIDs)
+		}
+	} else {
+		if released, err = s.seatRepo.ReleaseByEventID(ctx, tx, eventID, s.batchSize); err != nil {
+			return nil, 0, 0, err
+		}
+		if released == 0 {
+			c.Complete()
+		}
+	}
+	c.RecordBatch(cancelled, refundPending, released)
+	if err := s.cancellationRepo.Update(ctx, tx, c); err != nil {
+		return nil, 0, 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, 0, 0, fmt.Errorf("コミットに失敗: %w", err)
+	}
+
+	// メトリクス記録: 確定済みの予約は返金されるまで有効な予約として数える
+	if m := metrics.Get(); m != nil && cancelled > 0 {
+		m.ActiveReservations.WithLabelValues("pending").Sub(float64(cancelled))
+	}
+	return c, cancelled + refundPending, released, nil
+}
+
+// ensureCancelled はイベントが中止になっていなければ中止にする
+func (s *EventCancellationService) ensureCancelled(ctx context.Context, e *event.Event) error {
+	if e.Status == event.StatusCancelled {
+		return nil
+	}
+	if err := e.Cancel(); err != nil {
+		return err
+	}
+	if err := s.eventRepo.Update(ctx, e); err != nil {
+		return fmt.Errorf("イベントの中止に失敗: %w", err)
+	}
+	return nil
+}
+
+// invalidateSeatCache は座席キャッシュを無効化する
+func (s *EventCancellationService) invalidateSeatCache(ctx context.Context, eventID string) {
+	if s.seatCache != nil {
+		if err := s.seatCache.Invalidate(ctx, eventID); err != nil {
+			logger.Warn("キャッシュ無効化エラー", zap.String("event_id", eventID), zap.Error(err))
+		}
+	}
+}
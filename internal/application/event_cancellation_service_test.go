@@ -0,0 +1,221 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/outbox"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/reservation"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/transaction"
+)
+
+// MockCancellationRepository implements event.CancellationRepository
+type MockCancellationRepository struct {
+	mock.Mock
+}
+
+func (m *MockCancellationRepository) Create(ctx context.Context, c *event.Cancellation) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
+func (m *MockCancellationRepository) GetByEventID(ctx context.Context, eventID string) (*event.Cancellation, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Cancellation), args.Error(1)
+}
+
+func (m *MockCancellationRepository) GetForUpdate(ctx context.Context, tx transaction.Tx, eventID string) (*event.Cancellation, error) {
+	args := m.Called(ctx, tx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*event.Cancellation), args.Error(1)
+}
+
+func (m *MockCancellationRepository) Update(ctx context.Context, tx transaction.Tx, c *event.Cancellation) error {
+	args := m.Called(ctx, tx, c)
+	return args.Error(0)
+}
+
+func (m *MockCancellationRepository) ListRunning(ctx context.Context) ([]*event.Cancellation, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*event.Cancellation), args.Error(1)
+}
+
+type cancellationTestDeps struct {
+	*testDeps
+	cancellationRepo *MockCancellationRepository
+	outboxRepo       *MockOutboxRepository
+	service          *EventCancellationService
+}
+
+func newCancellationTestDeps() *cancellationTestDeps {
+	d := newTestDeps()
+	cr := new(MockCancellationRepository)
+	or := new(MockOutboxRepository)
+	return &cancellationTestDeps{
+		testDeps:         d,
+		cancellationRepo: cr,
+		outboxRepo:       or,
+		service: NewEventCancellationService(d.txManager, d.eventRepo, cr, d.resRepo, d.seatRepo, d.seatCache, 2,
+			WithCancellationOutbox(or)),
+	}
+}
+
+func newCancellableEvent() *event.Event {
+	return &event.Event{
+		ID:          "event-1",
+		OrganizerID: testOrganizer.UserID,
+		Status:      event.StatusOnSale,
+		StartAt:     time.Now().Add(24 * time.Hour),
+	}
+}
+
+func TestEventCancellationService_CancelEvent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("中止処理を記録してイベントを中止にする", func(t *testing.T) {
+		d := newCancellationTestDeps()
+		ev := newCancellableEvent()
+		d.eventRepo.On("GetByID", ctx, "event-1").Return(ev, nil)
+		d.cancellationRepo.On("GetByEventID", ctx, "event-1").Return(nil, event.ErrCancellationNotFound)
+		d.resRepo.On("CountActiveByEventID", ctx, "event-1").Return(3, nil)
+		d.cancellationRepo.On("Create", ctx, mock.AnythingOfType("*event.Cancellation")).Return(nil)
+		d.eventRepo.On("Update", ctx, ev).Return(nil)
+
+		c, err := d.service.CancelEvent(ctx, "event-1", testOrganizer)
+
+		require.NoError(t, err)
+		assert.Equal(t, event.CancellationRunning, c.Status)
+		assert.Equal(t, 3, c.TotalReservations)
+		assert.Equal(t, event.StatusCancelled, ev.Status)
+		// 予約の取り消しはワーカーで行う
+		d.resRepo.AssertNotCalled(t, "LockActiveByEventID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("既に中止処理を開始している場合は進捗を返す", func(t *testing.T) {
+		d := newCancellationTestDeps()
+		existing := event.NewCancellation("event-1", 5)
+		d.eventRepo.On("GetByID", ctx, "event-1").Return(newCancellableEvent(), nil)
+		d.cancellationRepo.On("GetByEventID", ctx, "event-1").Return(existing, nil)
+
+		c, err := d.service.CancelEvent(ctx, "event-1", testOrganizer)
+
+		require.NoError(t, err)
+		assert.Same(t, existing, c)
+		d.cancellationRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("主催者以外は中止できない", func(t *testing.T) {
+		d := newCancellationTestDeps()
+		d.eventRepo.On("GetByID", ctx, "event-1").Return(newCancellableEvent(), nil)
+
+		_, err := d.service.CancelEvent(ctx, "event-1", auth.NewPrincipal("org-2", auth.RoleOrganizer))
+
+		assert.ErrorIs(t, err, auth.ErrPermissionDenied)
+		d.cancellationRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestEventCancellationService_ProcessPending(t *testing.T) {
+	ctx := context.Background()
+	d := newCancellationTestDeps()
+
+	ev := newCancellableEvent()
+	ev.Status = event.StatusCancelled
+	c := event.NewCancellation("event-1", 2)
+	pending := &reservation.Reservation{
+		ID: "res-1", EventID: "event-1", UserID: "user-1", SeatIDs: []string{"seat-1"},
+		Status: reservation.StatusPending, TotalAmount: 3000,
+	}
+	confirmed := &reservation.Reservation{
+		ID: "res-2", EventID: "event-1", UserID: "user-2", SeatIDs: []string{"seat-2", "seat-3"},
+		Status: reservation.StatusConfirmed, TotalAmount: 6000,
+	}
+
+	d.cancellationRepo.On("ListRunning", ctx).Return([]*event.Cancellation{c}, nil)
+	d.eventRepo.On("GetByID", ctx, "event-1").Return(ev, nil)
+	d.txManager.On("Begin", ctx).Return(d.tx, nil)
+	d.tx.On("Commit").Return(nil)
+	d.tx.On("Rollback").Return(nil)
+	d.cancellationRepo.On("GetForUpdate", ctx, d.tx, "event-1").Return(c, nil)
+	d.cancellationRepo.On("Update", ctx, d.tx, c).Return(nil)
+
+	// 1バッチ目: 予約を取り消して座席を解放
+	d.resRepo.On("LockActiveByEventID", ctx, d.tx, "event-1", 2).
+		Return([]*reservation.Reservation{pending, confirmed}, nil).Once()
+	d.resRepo.On("Update", ctx, d.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+	d.outboxRepo.On("Append", ctx, d.tx, mock.MatchedBy(func(msg *outbox.Message) bool {
+		return msg.EventType == outbox.EventReservationCancelled && msg.AggregateID == "res-1"
+	})).Return(nil).Once()
+	d.outboxRepo.On("Append", ctx, d.tx, mock.MatchedBy(func(msg *outbox.Message) bool {
+		return msg.EventType == outbox.EventReservationRefundRequested && msg.AggregateID == "res-2"
+	})).Return(nil).Once()
+	d.seatRepo.On("ReleaseSeats", ctx, d.tx, "res-1", []string{"seat-1"}).Return(nil).Once()
+	d.seatRepo.On("ReleaseSeats", ctx, d.tx, "res-2", []string{"seat-2", "seat-3"}).Return(nil).Once()
+
+	// 2バッチ目: 予約に紐付かない座席を解放
+	d.resRepo.On("LockActiveByEventID", ctx, d.tx, "event-1", 2).Return([]*reservation.Reservation{}, nil).Once()
+	d.seatRepo.On("ReleaseByEventID", ctx, d.tx, "event-1", 2).Return(1, nil).Once()
+
+	// 3バッチ目: 解放する座席がなくなったら完了
+	d.resRepo.On("LockActiveByEventID", ctx, d.tx, "event-1", 2).Return([]*reservation.Reservation{}, nil).Once()
+	d.seatRepo.On("ReleaseByEventID", ctx, d.tx, "event-1", 2).Return(0, nil).Once()
+	d.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+
+	n, err := d.service.ProcessPending(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, reservation.StatusCancelled, pending.Status)
+	assert.Equal(t, reservation.StatusRefundPending, confirmed.Status)
+	assert.True(t, c.IsCompleted())
+	assert.Equal(t, 2, c.ProcessedReservations)
+	assert.Equal(t, 1, c.CancelledReservations)
+	assert.Equal(t, 1, c.RefundPendingReservations)
+	assert.Equal(t, 4, c.ReleasedSeats)
+	d.tx.AssertNumberOfCalls(t, "Commit", 3)
+	d.outboxRepo.AssertExpectations(t)
+	d.seatCache.AssertExpectations(t)
+}
+
+func TestEventCancellationService_ProcessPending_Resumes(t *testing.T) {
+	ctx := context.Background()
+	d := newCancellationTestDeps()
+
+	// イベントを中止にする前に停止した中止処理は、イベントの中止から再開する
+	ev := newCancellableEvent()
+	c := event.NewCancellation("event-1", 0)
+
+	d.cancellationRepo.On("ListRunning", ctx).Return([]*event.Cancellation{c}, nil)
+	d.eventRepo.On("GetByID", ctx, "event-1").Return(ev, nil)
+	d.eventRepo.On("Update", ctx, ev).Return(nil)
+	d.txManager.On("Begin", ctx).Return(d.tx, nil)
+	d.tx.On("Commit").Return(nil)
+	d.tx.On("Rollback").Return(nil)
+	d.cancellationRepo.On("GetForUpdate", ctx, d.tx, "event-1").Return(c, nil)
+	d.cancellationRepo.On("Update", ctx, d.tx, c).Return(nil)
+	d.resRepo.On("LockActiveByEventID", ctx, d.tx, "event-1", 2).Return([]*reservation.Reservation{}, nil)
+	d.seatRepo.On("ReleaseByEventID", ctx, d.tx, "event-1", 2).Return(0, nil)
+
+	n, err := d.service.ProcessPending(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, event.StatusCancelled, ev.Status)
+	assert.True(t, c.IsCompleted())
+	d.seatCache.AssertNotCalled(t, "Invalidate", mock.Anything, mock.Anything)
+}
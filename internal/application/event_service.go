@@ -2,18 +2,28 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"go.uber.org/zap"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pagination"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/reservation"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/logger"
 )
 
 type EventService struct {
-	eventRepo event.Repository
+	eventRepo       event.Repository
+	seatRepo        seat.Repository
+	reservationRepo reservation.Repository
 }
 
-func NewEventService(eventRepo event.Repository) *EventService {
-	return &EventService{eventRepo: eventRepo}
+func NewEventService(eventRepo event.Repository, seatRepo seat.Repository, reservationRepo reservation.Repository) *EventService {
+	return &EventService{eventRepo: eventRepo, seatRepo: seatRepo, reservationRepo: reservationRepo}
 }
 
 type CreateEventInput struct {
@@ -23,34 +33,162 @@ type CreateEventInput struct {
 	StartAt     time.Time
 	EndAt       time.Time
 	TotalSeats  int
+	// 販売期間（nil の場合は制限なし）
+	SalesStartAt *time.Time
+	SalesEndAt   *time.Time
+	// 仮押さえ設定（ゼロ値・nil の場合はデフォルト値を使用）
+	HoldDuration      time.Duration
+	MaxHoldDuration   time.Duration
+	MaxHoldExtensions *int
+	// 待合室を有効にするか（nil の場合は無効）
+	WaitingRoomEnabled *bool
+	// 返金ポリシー（nil の場合はデフォルト値を使用）
+	FullRefundBefore     *time.Duration
+	PartialRefundPercent *int
+	RefundCutoff         *time.Duration
+	// 購入枚数の上限（nil の場合は無制限）
+	MaxSeatsPerReservation *int
+	MaxSeatsPerUser        *int
+	// 座席レイアウト（指定時はイベント作成と同時に座席を生成。TotalSeats 省略時は座席数を使用）
+	Layout *seat.Layout
+	// Principal は呼び出し元（主催者または管理者のみ作成でき、作成者がイベントの主催者になる）
+	Principal auth.Principal
 }
 
 func (s *EventService) CreateEvent(ctx context.Context, input CreateEventInput) (*event.Event, error) {
-	e := event.NewEvent(input.Name, input.Description, input.Venue, input.StartAt, input.EndAt, input.TotalSeats)
+	if err := input.Principal.RequireOrganizer(); err != nil {
+		return nil, err
+	}
+	totalSeats := input.TotalSeats
+	if input.Layout != nil && totalSeats == 0 {
+		totalSeats = input.Layout.SeatCount()
+	}
+	e := event.NewEvent(input.Name, input.Description, input.Venue, input.StartAt, input.EndAt, totalSeats)
+	applySalesPeriod(e, input.SalesStartAt, input.SalesEndAt)
+	applyHoldSettings(e, input.HoldDuration, input.MaxHoldDuration, input.MaxHoldExtensions)
+	applyRefundSettings(e, input.FullRefundBefore, input.PartialRefundPercent, input.RefundCutoff)
+	applyPurchaseLimits(e, input.MaxSeatsPerReservation, input.MaxSeatsPerUser)
+	if input.WaitingRoomEnabled != nil {
+		e.WaitingRoomEnabled = *input.WaitingRoomEnabled
+	}
+	e.OrganizerID = input.Principal.UserID
 	if err := e.Validate(); err != nil {
 		return nil, fmt.Errorf("バリデーションエラー: %w", err)
 	}
+	if input.Layout != nil {
+		if err := input.Layout.Validate(); err != nil {
+			return nil, fmt.Errorf("バリデーションエラー: %w", err)
+		}
+		if input.Layout.SeatCount() > e.TotalSeats {
+			return nil, fmt.Errorf("バリデーションエラー: %w", seat.ErrLayoutExceedsCapacity)
+		}
+	}
 	if err := s.eventRepo.Create(ctx, e); err != nil {
 		return nil, fmt.Errorf("イベント作成に失敗しました: %w", err)
 	}
+	if input.Layout != nil {
+		if err := s.importLayout(ctx, e, input.Layout); err != nil {
+			return nil, err
+		}
+	}
 	return e, nil
 }
 
-func (s *EventService) GetEvent(ctx context.Context, id string) (*event.Event, error) {
-	return s.eventRepo.GetByID(ctx, id)
+// importLayout はレイアウトから座席を一括作成する
+// 失敗した場合は座席のないイベントが残らないよう、作成済みのイベントを削除する
+func (s *EventService) importLayout(ctx context.Context, e *event.Event, layout *seat.Layout) error {
+	seats := layout.BuildSeats(e.ID)
+	if err := s.seatRepo.CreateBulk(ctx, seats); err != nil {
+		if delErr := s.eventRepo.Delete(ctx, e.ID); delErr != nil {
+			logger.Error("レイアウト取り込み失敗後のイベント削除に失敗",
+				zap.String("event_id", e.ID), zap.Error(delErr))
+		}
+		return fmt.Errorf("座席レイアウトの取り込みに失敗しました: %w", err)
+	}
+	logger.Info("座席レイアウトを取り込み", zap.String("event_id", e.ID), zap.Int("seats", len(seats)))
+	return nil
+}
+
+// EventDetail はイベントと、実際の座席から集計した座席数
+type EventDetail struct {
+	Event *event.Event
+	Seats *seat.Inventory
 }
 
-func (s *EventService) ListEvents(ctx context.Context, limit, offset int) ([]*event.Event, error) {
-	if limit <= 0 {
-		limit = 20
+// GetEvent はイベントを取得し、作成済みの座席を状態ごとに集計して返す
+func (s *EventService) GetEvent(ctx context.Context, id string) (*EventDetail, error) {
+	e, err := s.eventRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	inventory, err := s.seatRepo.GetInventoryByEventID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("座席数の集計に失敗: %w", err)
 	}
-	if limit > 100 {
-		limit = 100
+	return &EventDetail{Event: e, Seats: inventory}, nil
+}
+
+// ListEventsInput はイベント一覧の絞り込み条件とページの位置
+type ListEventsInput struct {
+	StartFrom *time.Time
+	StartTo   *time.Time
+	Venue     string
+	Query     string
+
+	Cursor string // 前のページの NextCursor（空の場合は先頭ページ）
+	Limit  int
+}
+
+// ListEvents はイベント一覧を作成日時の新しい順に1ページ分取得する
+func (s *EventService) ListEvents(ctx context.Context, input ListEventsInput) (*pagination.Page[*event.Event], error) {
+	after, err := pagination.DecodeCursor(input.Cursor)
+	if err != nil {
+		return nil, err
 	}
-	if offset < 0 {
-		offset = 0
+	limit := pagination.NormalizeLimit(input.Limit)
+	// 次のページの有無を判定するため1件多く取得する
+	events, err := s.eventRepo.List(ctx, event.ListFilter{
+		PublicOnly: true,
+		StartFrom:  input.StartFrom,
+		StartTo:    input.StartTo,
+		Venue:      input.Venue,
+		Query:      input.Query,
+		After:      after,
+		Limit:      limit + 1,
+	})
+	if err != nil {
+		return nil, err
 	}
-	return s.eventRepo.List(ctx, limit, offset)
+	return pagination.NewPage(events, limit, func(e *event.Event) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: e.CreatedAt, ID: e.ID}
+	}), nil
+}
+
+// SearchEventsInput はイベント検索の条件
+type SearchEventsInput struct {
+	Query           string
+	UpcomingOnly    bool // 開始前のイベントだけを返す
+	HasAvailability bool // 空席があるイベントだけを返す
+	Limit           int
+}
+
+// SearchEvents はキーワードに一致するイベントを関連度の高い順に取得する
+func (s *EventService) SearchEvents(ctx context.Context, input SearchEventsInput) ([]*event.SearchResult, error) {
+	query, err := event.NormalizeSearchQuery(input.Query)
+	if err != nil {
+		return nil, err
+	}
+	filter := event.SearchFilter{
+		PublicOnly:      true,
+		Query:           query,
+		HasAvailability: input.HasAvailability,
+		Limit:           pagination.NormalizeLimit(input.Limit),
+	}
+	if input.UpcomingOnly {
+		now := time.Now()
+		filter.StartAfter = &now
+	}
+	return s.eventRepo.Search(ctx, filter)
 }
 
 type UpdateEventInput struct {
@@ -61,10 +199,30 @@ type UpdateEventInput struct {
 	StartAt     time.Time
 	EndAt       time.Time
 	TotalSeats  int
+	// 販売期間（nil の場合は既存の値を維持）
+	SalesStartAt *time.Time
+	SalesEndAt   *time.Time
+	// 仮押さえ設定（ゼロ値・nil の場合は既存の値を維持）
+	HoldDuration      time.Duration
+	MaxHoldDuration   time.Duration
+	MaxHoldExtensions *int
+	// 待合室を有効にするか（nil の場合は既存の値を維持）
+	WaitingRoomEnabled *bool
+	// 返金ポリシー（nil の場合は既存の値を維持）
+	FullRefundBefore     *time.Duration
+	PartialRefundPercent *int
+	RefundCutoff         *time.Duration
+	// 購入枚数の上限（nil の場合は既存の値を維持）
+	MaxSeatsPerReservation *int
+	MaxSeatsPerUser        *int
+	// ExpectedVersion はクライアントが取得したイベントのバージョン（If-Match。nil の場合は確認しない）
+	ExpectedVersion *int
+	// Principal は呼び出し元（イベントの主催者または管理者のみ更新できる）
+	Principal auth.Principal
 }
 
 func (s *EventService) UpdateEvent(ctx context.Context, input UpdateEventInput) (*event.Event, error) {
-	e, err := s.eventRepo.GetByID(ctx, input.ID)
+	e, err := s.authorizeEventUpdate(ctx, input.ID, input.ExpectedVersion, input.Principal)
 	if err != nil {
 		return nil, err
 	}
@@ -74,15 +232,227 @@ func (s *EventService) UpdateEvent(ctx context.Context, input UpdateEventInput)
 	e.StartAt = input.StartAt
 	e.EndAt = input.EndAt
 	e.TotalSeats = input.TotalSeats
+	applySalesPeriod(e, input.SalesStartAt, input.SalesEndAt)
+	applyHoldSettings(e, input.HoldDuration, input.MaxHoldDuration, input.MaxHoldExtensions)
+	applyRefundSettings(e, input.FullRefundBefore, input.PartialRefundPercent, input.RefundCutoff)
+	applyPurchaseLimits(e, input.MaxSeatsPerReservation, input.MaxSeatsPerUser)
+	if input.WaitingRoomEnabled != nil {
+		e.WaitingRoomEnabled = *input.WaitingRoomEnabled
+	}
+	if err := s.saveUpdatedEvent(ctx, e, input.ExpectedVersion); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// PatchEventInput はイベントの部分更新（JSON Merge Patch）の入力
+// nil の項目は既存の値を維持する
+type PatchEventInput struct {
+	ID          string
+	Name        *string
+	Description *string // 空文字で説明を削除
+	Venue       *string // 空文字で会場を削除
+	StartAt     *time.Time
+	EndAt       *time.Time
+	TotalSeats  *int
+	// 販売期間（ゼロ値で制限をなくす）
+	SalesStartAt *time.Time
+	SalesEndAt   *time.Time
+	// 仮押さえ設定
+	HoldDuration      *time.Duration
+	MaxHoldDuration   *time.Duration
+	MaxHoldExtensions *int
+	// 待合室を有効にするか
+	WaitingRoomEnabled *bool
+	// 返金ポリシー
+	FullRefundBefore     *time.Duration
+	PartialRefundPercent *int
+	RefundCutoff         *time.Duration
+	// 購入枚数の上限
+	MaxSeatsPerReservation *int
+	MaxSeatsPerUser        *int
+	// ExpectedVersion はクライアントが取得したイベントのバージョン（If-Match。nil の場合は確認しない）
+	ExpectedVersion *int
+	// Principal は呼び出し元（イベントの主催者または管理者のみ更新できる）
+	Principal auth.Principal
+}
+
+// PatchEvent は指定された項目だけを変更し、変更後のイベント全体を検証して保存する（イベントの主催者または管理者のみ）
+func (s *EventService) PatchEvent(ctx context.Context, input PatchEventInput) (*event.Event, error) {
+	e, err := s.authorizeEventUpdate(ctx, input.ID, input.ExpectedVersion, input.Principal)
+	if err != nil {
+		return nil, err
+	}
+	if input.Name != nil {
+		e.Name = *input.Name
+	}
+	if input.Description != nil {
+		e.Description = *input.Description
+	}
+	if input.Venue != nil {
+		e.Venue = *input.Venue
+	}
+	if input.StartAt != nil {
+		e.StartAt = *input.StartAt
+	}
+	if input.EndAt != nil {
+		e.EndAt = *input.EndAt
+	}
+	if input.TotalSeats != nil {
+		e.TotalSeats = *input.TotalSeats
+	}
+	applySalesPeriod(e, input.SalesStartAt, input.SalesEndAt)
+	var hold, maxHold time.Duration
+	if input.HoldDuration != nil {
+		hold = *input.HoldDuration
+	}
+	if input.MaxHoldDuration != nil {
+		maxHold = *input.MaxHoldDuration
+	}
+	applyHoldSettings(e, hold, maxHold, input.MaxHoldExtensions)
+	applyRefundSettings(e, input.FullRefundBefore, input.PartialRefundPercent, input.RefundCutoff)
+	applyPurchaseLimits(e, input.MaxSeatsPerReservation, input.MaxSeatsPerUser)
+	if input.WaitingRoomEnabled != nil {
+		e.WaitingRoomEnabled = *input.WaitingRoomEnabled
+	}
+	if err := s.saveUpdatedEvent(ctx, e, input.ExpectedVersion); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// authorizeEventUpdate は更新するイベントを取得し、権限と If-Match のバージョンを確認する
+func (s *EventService) authorizeEventUpdate(ctx context.Context, id string, expectedVersion *int, principal auth.Principal) (*event.Event, error) {
+	e, err := authorizeEventManagement(ctx, s.eventRepo, id, principal)
+	if err != nil {
+		return nil, err
+	}
+	if expectedVersion != nil && *expectedVersion != e.Version {
+		return nil, event.ErrVersionMismatch
+	}
+	return e, nil
+}
+
+// saveUpdatedEvent は変更後のイベントを検証して保存する
+// 座席数を作成済みの座席数より減らす場合は、リポジトリが座席の作成と競合しないよう確認して ErrTotalSeatsBelowCreated を返す
+func (s *EventService) saveUpdatedEvent(ctx context.Context, e *event.Event, expectedVersion *int) error {
 	if err := e.Validate(); err != nil {
-		return nil, fmt.Errorf("バリデーションエラー: %w", err)
+		return fmt.Errorf("バリデーションエラー: %w", err)
 	}
 	if err := s.eventRepo.Update(ctx, e); err != nil {
+		// 取得後に他のリクエストが更新した場合も、クライアントが指定したバージョンとは一致しない
+		if expectedVersion != nil && errors.Is(err, event.ErrOptimisticLockConflict) {
+			return event.ErrVersionMismatch
+		}
+		return err
+	}
+	return nil
+}
+
+// PublishEvent は下書きのイベントを公開する（イベントの主催者または管理者のみ）
+func (s *EventService) PublishEvent(ctx context.Context, id string, principal auth.Principal) (*event.Event, error) {
+	return s.transitionEvent(ctx, id, principal, (*event.Event).Publish)
+}
+
+// OpenEventSales はイベントの販売を開始する（イベントの主催者または管理者のみ）
+func (s *EventService) OpenEventSales(ctx context.Context, id string, principal auth.Principal) (*event.Event, error) {
+	return s.transitionEvent(ctx, id, principal, (*event.Event).OpenSales)
+}
+
+// CloseEventSales はイベントの販売を終了する（イベントの主催者または管理者のみ）
+func (s *EventService) CloseEventSales(ctx context.Context, id string, principal auth.Principal) (*event.Event, error) {
+	return s.transitionEvent(ctx, id, principal, (*event.Event).CloseSales)
+}
+
+// transitionEvent はイベントの状態を変更して保存する
+func (s *EventService) transitionEvent(ctx context.Context, id string, principal auth.Principal, transition func(*event.Event) error) (*event.Event, error) {
+	e, err := authorizeEventManagement(ctx, s.eventRepo, id, principal)
+	if err != nil {
 		return nil, err
 	}
+	from := e.Status
+	if err := transition(e); err != nil {
+		return nil, err
+	}
+	if err := s.eventRepo.Update(ctx, e); err != nil {
+		return nil, fmt.Errorf("イベントの状態変更に失敗: %w", err)
+	}
+	logger.Info("イベントの状態を変更",
+		zap.String("event_id", e.ID), zap.String("from", string(from)), zap.String("to", string(e.Status)))
 	return e, nil
 }
 
-func (s *EventService) DeleteEvent(ctx context.Context, id string) error {
+// DeleteEvent はイベントを削除する（イベントの主催者または管理者のみ）
+// 保留中・確定済み・返金待ちの予約が残っている場合は削除せず、イベントの中止（POST /events/:id/cancel）を促す
+func (s *EventService) DeleteEvent(ctx context.Context, id string, principal auth.Principal) error {
+	if _, err := authorizeEventManagement(ctx, s.eventRepo, id, principal); err != nil {
+		return err
+	}
+	active, err := s.reservationRepo.CountActiveByEventID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if active > 0 {
+		return event.ErrEventHasActiveReservations
+	}
 	return s.eventRepo.Delete(ctx, id)
 }
+
+// authorizeEventManagement は呼び出し元が管理できるイベントを取得する
+// イベントの主催者本人または管理者でなければ auth.ErrPermissionDenied を返す
+func authorizeEventManagement(ctx context.Context, er event.Repository, eventID string, principal auth.Principal) (*event.Event, error) {
+	e, err := er.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("イベント取得に失敗: %w", err)
+	}
+	if err := e.AuthorizeManagement(principal); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// applySalesPeriod は指定された販売期間のみをイベントに反映する
+func applySalesPeriod(e *event.Event, startAt, endAt *time.Time) {
+	if startAt != nil {
+		e.SalesStartAt = *startAt
+	}
+	if endAt != nil {
+		e.SalesEndAt = *endAt
+	}
+}
+
+// applyHoldSettings は指定された仮押さえ設定のみをイベントに反映する
+func applyHoldSettings(e *event.Event, hold, maxHold time.Duration, maxExtensions *int) {
+	if hold > 0 {
+		e.HoldDuration = hold
+	}
+	if maxHold > 0 {
+		e.MaxHoldDuration = maxHold
+	}
+	if maxExtensions != nil {
+		e.MaxHoldExtensions = *maxExtensions
+	}
+}
+
+// applyRefundSettings は指定された返金ポリシーのみをイベントに反映する
+func applyRefundSettings(e *event.Event, fullBefore *time.Duration, partialPercent *int, cutoff *time.Duration) {
+	if fullBefore != nil {
+		e.FullRefundBefore = *fullBefore
+	}
+	if partialPercent != nil {
+		e.PartialRefundPercent = *partialPercent
+	}
+	if cutoff != nil {
+		e.RefundCutoff = *cutoff
+	}
+}
+
+// applyPurchaseLimits は指定された購入枚数の上限のみをイベントに反映する
+func applyPurchaseLimits(e *event.Event, perReservation, perUser *int) {
+	if perReservation != nil {
+		e.MaxSeatsPerReservation = *perReservation
+	}
+	if perUser != nil {
+		e.MaxSeatsPerUser = *perUser
+	}
+}
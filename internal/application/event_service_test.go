@@ -10,9 +10,15 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pagination"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
 )
 
+// testOrganizer はイベントを作成・管理する主催者
+var testOrganizer = auth.NewPrincipal("org-1", auth.RoleOrganizer)
+
 // MockEventRepository はevent.Repositoryのモック
 type MockEventRepository struct {
 	mock.Mock
@@ -31,14 +37,22 @@ func (m *MockEventRepository) GetByID(ctx context.Context, id string) (*event.Ev
 	return args.Get(0).(*event.Event), args.Error(1)
 }
 
-func (m *MockEventRepository) List(ctx context.Context, limit, offset int) ([]*event.Event, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockEventRepository) List(ctx context.Context, filter event.ListFilter) ([]*event.Event, error) {
+	args := m.Called(ctx, filter)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*event.Event), args.Error(1)
 }
 
+func (m *MockEventRepository) Search(ctx context.Context, filter event.SearchFilter) ([]*event.SearchResult, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*event.SearchResult), args.Error(1)
+}
+
 func (m *MockEventRepository) Update(ctx context.Context, e *event.Event) error {
 	args := m.Called(ctx, e)
 	return args.Error(0)
@@ -51,13 +65,13 @@ func (m *MockEventRepository) Delete(ctx context.Context, id string) error {
 
 func TestNewEventService(t *testing.T) {
 	mockRepo := new(MockEventRepository)
-	service := NewEventService(mockRepo)
+	service := NewEventService(mockRepo, nil, nil)
 	assert.NotNil(t, service)
 }
 
 func TestEventService_CreateEvent_Success(t *testing.T) {
 	mockRepo := new(MockEventRepository)
-	service := NewEventService(mockRepo)
+	service := NewEventService(mockRepo, nil, nil)
 
 	input := CreateEventInput{
 		Name:        "テストイベント",
@@ -66,6 +80,7 @@ func TestEventService_CreateEvent_Success(t *testing.T) {
 		StartAt:     time.Now().Add(24 * time.Hour),
 		EndAt:       time.Now().Add(27 * time.Hour),
 		TotalSeats:  100,
+		Principal:   testOrganizer,
 	}
 
 	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*event.Event")).Return(nil)
@@ -78,12 +93,240 @@ func TestEventService_CreateEvent_Success(t *testing.T) {
 	assert.Equal(t, input.Description, result.Description)
 	assert.Equal(t, input.Venue, result.Venue)
 	assert.Equal(t, input.TotalSeats, result.TotalSeats)
+	assert.Equal(t, testOrganizer.UserID, result.OrganizerID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestEventService_CreateEvent_RequiresOrganizer(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal auth.Principal
+		wantErr   error
+	}{
+		{"一般ユーザーは作成できない", auth.NewPrincipal("user-1", auth.RoleCustomer), auth.ErrPermissionDenied},
+		{"未認証では作成できない", auth.Principal{}, auth.ErrUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockEventRepository)
+			service := NewEventService(mockRepo, nil, nil)
+
+			result, err := service.CreateEvent(context.Background(), CreateEventInput{
+				Name:       "テストイベント",
+				StartAt:    time.Now().Add(24 * time.Hour),
+				EndAt:      time.Now().Add(27 * time.Hour),
+				TotalSeats: 100,
+				Principal:  tt.principal,
+			})
+
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, tt.wantErr)
+			mockRepo.AssertNotCalled(t, "Create")
+		})
+	}
+
+	t.Run("管理者は作成でき、作成者が主催者になる", func(t *testing.T) {
+		mockRepo := new(MockEventRepository)
+		service := NewEventService(mockRepo, nil, nil)
+		mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*event.Event")).Return(nil)
+
+		result, err := service.CreateEvent(context.Background(), CreateEventInput{
+			Name:       "テストイベント",
+			StartAt:    time.Now().Add(24 * time.Hour),
+			EndAt:      time.Now().Add(27 * time.Hour),
+			TotalSeats: 100,
+			Principal:  auth.NewPrincipal("admin-1", auth.RoleAdmin),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "admin-1", result.OrganizerID)
+	})
+}
+
+func TestEventService_CreateEvent_HoldSettings(t *testing.T) {
+	mockRepo := new(MockEventRepository)
+	service := NewEventService(mockRepo, nil, nil)
+
+	maxExtensions := 0
+	input := CreateEventInput{
+		Name:              "テストイベント",
+		Venue:             "テスト会場",
+		StartAt:           time.Now().Add(24 * time.Hour),
+		EndAt:             time.Now().Add(27 * time.Hour),
+		TotalSeats:        100,
+		HoldDuration:      5 * time.Minute,
+		MaxHoldDuration:   10 * time.Minute,
+		MaxHoldExtensions: &maxExtensions,
+		Principal:         testOrganizer,
+	}
+
+	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*event.Event")).Return(nil)
+
+	result, err := service.CreateEvent(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, result.HoldDuration)
+	assert.Equal(t, 10*time.Minute, result.MaxHoldDuration)
+	assert.Equal(t, 0, result.MaxHoldExtensions)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestEventService_CreateEvent_InvalidHoldSettings(t *testing.T) {
+	mockRepo := new(MockEventRepository)
+	service := NewEventService(mockRepo, nil, nil)
+
+	// 最大期間が1回あたりの仮押さえ時間より短い
+	input := CreateEventInput{
+		Name:            "テストイベント",
+		Venue:           "テスト会場",
+		StartAt:         time.Now().Add(24 * time.Hour),
+		EndAt:           time.Now().Add(27 * time.Hour),
+		TotalSeats:      100,
+		HoldDuration:    20 * time.Minute,
+		MaxHoldDuration: 10 * time.Minute,
+		Principal:       testOrganizer,
+	}
+
+	result, err := service.CreateEvent(context.Background(), input)
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, event.ErrInvalidHoldPolicy)
+	mockRepo.AssertNotCalled(t, "Create")
+}
+
+func TestEventService_CreateEvent_PurchaseLimits(t *testing.T) {
+	mockRepo := new(MockEventRepository)
+	service := NewEventService(mockRepo, nil, nil)
+
+	perReservation, perUser := 4, 8
+	input := CreateEventInput{
+		Name:                   "テストイベント",
+		Venue:                  "テスト会場",
+		StartAt:                time.Now().Add(24 * time.Hour),
+		EndAt:                  time.Now().Add(27 * time.Hour),
+		TotalSeats:             100,
+		MaxSeatsPerReservation: &perReservation,
+		MaxSeatsPerUser:        &perUser,
+		Principal:              testOrganizer,
+	}
+
+	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*event.Event")).Return(nil)
+
+	result, err := service.CreateEvent(context.Background(), input)
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, result.MaxSeatsPerReservation)
+	assert.Equal(t, 8, result.MaxSeatsPerUser)
 	mockRepo.AssertExpectations(t)
+
+	t.Run("1回の予約の上限が1人あたりの上限より大きい場合はエラー", func(t *testing.T) {
+		perReservation, perUser := 10, 8
+		input.MaxSeatsPerReservation = &perReservation
+		input.MaxSeatsPerUser = &perUser
+
+		result, err := service.CreateEvent(context.Background(), input)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, event.ErrInvalidPurchaseLimit)
+	})
+}
+
+func TestEventService_CreateEvent_WithLayout(t *testing.T) {
+	newLayout := func() *seat.Layout {
+		return &seat.Layout{Sections: []seat.LayoutSection{{
+			Name: "ARENA", Price: 12000,
+			Rows: []seat.LayoutRow{
+				{Label: "A", Seats: []seat.LayoutSeat{{Index: 1}, {Index: 2}}},
+				{Label: "B", Seats: []seat.LayoutSeat{{Index: 1}}},
+			},
+		}}}
+	}
+	baseInput := func() CreateEventInput {
+		return CreateEventInput{
+			Name:      "レイアウトイベント",
+			Venue:     "テスト会場",
+			StartAt:   time.Now().Add(24 * time.Hour),
+			EndAt:     time.Now().Add(27 * time.Hour),
+			Layout:    newLayout(),
+			Principal: testOrganizer,
+		}
+	}
+
+	t.Run("レイアウトから座席を作成し総座席数を補完する", func(t *testing.T) {
+		mockRepo := new(MockEventRepository)
+		mockSeatRepo := new(MockSeatRepository)
+		service := NewEventService(mockRepo, mockSeatRepo, nil)
+
+		mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*event.Event")).
+			Run(func(args mock.Arguments) { args.Get(1).(*event.Event).ID = "event-1" }).
+			Return(nil)
+		mockSeatRepo.On("CreateBulk", mock.Anything, mock.MatchedBy(func(seats []*seat.Seat) bool {
+			return len(seats) == 3 && seats[0].EventID == "event-1" && seats[0].SeatNumber == "ARENA-A-1"
+		})).Return(nil)
+
+		result, err := service.CreateEvent(context.Background(), baseInput())
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.TotalSeats)
+		mockRepo.AssertExpectations(t)
+		mockSeatRepo.AssertExpectations(t)
+	})
+
+	t.Run("レイアウトの座席数が総座席数を超える", func(t *testing.T) {
+		mockRepo := new(MockEventRepository)
+		mockSeatRepo := new(MockSeatRepository)
+		service := NewEventService(mockRepo, mockSeatRepo, nil)
+
+		input := baseInput()
+		input.TotalSeats = 2
+
+		result, err := service.CreateEvent(context.Background(), input)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, seat.ErrLayoutExceedsCapacity)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("不正なレイアウト", func(t *testing.T) {
+		mockRepo := new(MockEventRepository)
+		service := NewEventService(mockRepo, new(MockSeatRepository), nil)
+
+		input := baseInput()
+		input.Layout.Sections[0].Rows[1].Label = "A"
+
+		result, err := service.CreateEvent(context.Background(), input)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, seat.ErrInvalidLayout)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("座席作成に失敗した場合はイベントを削除する", func(t *testing.T) {
+		mockRepo := new(MockEventRepository)
+		mockSeatRepo := new(MockSeatRepository)
+		service := NewEventService(mockRepo, mockSeatRepo, nil)
+
+		mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*event.Event")).
+			Run(func(args mock.Arguments) { args.Get(1).(*event.Event).ID = "event-1" }).
+			Return(nil)
+		mockSeatRepo.On("CreateBulk", mock.Anything, mock.Anything).Return(errors.New("db error"))
+		mockRepo.On("Delete", mock.Anything, "event-1").Return(nil)
+
+		result, err := service.CreateEvent(context.Background(), baseInput())
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "座席レイアウトの取り込みに失敗しました")
+		mockRepo.AssertExpectations(t)
+	})
 }
 
 func TestEventService_CreateEvent_ValidationError(t *testing.T) {
 	mockRepo := new(MockEventRepository)
-	service := NewEventService(mockRepo)
+	service := NewEventService(mockRepo, nil, nil)
 
 	// 無効な入力（名前が空）
 	input := CreateEventInput{
@@ -93,6 +336,7 @@ func TestEventService_CreateEvent_ValidationError(t *testing.T) {
 		StartAt:     time.Now().Add(24 * time.Hour),
 		EndAt:       time.Now().Add(27 * time.Hour),
 		TotalSeats:  100,
+		Principal:   testOrganizer,
 	}
 
 	result, err := service.CreateEvent(context.Background(), input)
@@ -106,7 +350,7 @@ func TestEventService_CreateEvent_ValidationError(t *testing.T) {
 
 func TestEventService_CreateEvent_RepositoryError(t *testing.T) {
 	mockRepo := new(MockEventRepository)
-	service := NewEventService(mockRepo)
+	service := NewEventService(mockRepo, nil, nil)
 
 	input := CreateEventInput{
 		Name:        "テストイベント",
@@ -115,6 +359,7 @@ func TestEventService_CreateEvent_RepositoryError(t *testing.T) {
 		StartAt:     time.Now().Add(24 * time.Hour),
 		EndAt:       time.Now().Add(27 * time.Hour),
 		TotalSeats:  100,
+		Principal:   testOrganizer,
 	}
 
 	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*event.Event")).
@@ -130,25 +375,31 @@ func TestEventService_CreateEvent_RepositoryError(t *testing.T) {
 
 func TestEventService_GetEvent_Success(t *testing.T) {
 	mockRepo := new(MockEventRepository)
-	service := NewEventService(mockRepo)
+	mockSeatRepo := new(MockSeatRepository)
+	service := NewEventService(mockRepo, mockSeatRepo, nil)
 
 	expectedEvent := &event.Event{
-		ID:   "event-1",
-		Name: "テストイベント",
+		ID:         "event-1",
+		Name:       "テストイベント",
+		TotalSeats: 10,
 	}
+	inventory := &seat.Inventory{Total: 10, Available: 6, Reserved: 3, Confirmed: 1}
 
 	mockRepo.On("GetByID", mock.Anything, "event-1").Return(expectedEvent, nil)
+	mockSeatRepo.On("GetInventoryByEventID", mock.Anything, "event-1").Return(inventory, nil)
 
 	result, err := service.GetEvent(context.Background(), "event-1")
 
 	require.NoError(t, err)
-	assert.Equal(t, expectedEvent, result)
+	assert.Equal(t, expectedEvent, result.Event)
+	assert.Equal(t, inventory, result.Seats)
 	mockRepo.AssertExpectations(t)
+	mockSeatRepo.AssertExpectations(t)
 }
 
 func TestEventService_GetEvent_NotFound(t *testing.T) {
 	mockRepo := new(MockEventRepository)
-	service := NewEventService(mockRepo)
+	service := NewEventService(mockRepo, nil, nil)
 
 	mockRepo.On("GetByID", mock.Anything, "non-existent").Return(nil, event.ErrEventNotFound)
 
@@ -162,71 +413,138 @@ func TestEventService_GetEvent_NotFound(t *testing.T) {
 
 func TestEventService_ListEvents_Success(t *testing.T) {
 	mockRepo := new(MockEventRepository)
-	service := NewEventService(mockRepo)
+	service := NewEventService(mockRepo, nil, nil)
 
 	expectedEvents := []*event.Event{
 		{ID: "event-1", Name: "イベント1"},
 		{ID: "event-2", Name: "イベント2"},
 	}
 
-	mockRepo.On("List", mock.Anything, 20, 0).Return(expectedEvents, nil)
+	// 次のページの有無を判定するため limit+1 件取得する
+	mockRepo.On("List", mock.Anything, event.ListFilter{PublicOnly: true, Limit: 21}).Return(expectedEvents, nil)
 
-	result, err := service.ListEvents(context.Background(), 0, 0)
+	result, err := service.ListEvents(context.Background(), ListEventsInput{})
 
 	require.NoError(t, err)
-	assert.Len(t, result, 2)
+	assert.Len(t, result.Items, 2)
+	assert.False(t, result.HasMore())
+	assert.Equal(t, 20, result.Limit)
 	mockRepo.AssertExpectations(t)
 }
 
-func TestEventService_ListEvents_WithLimitAndOffset(t *testing.T) {
+func TestEventService_ListEvents_WithFiltersAndCursor(t *testing.T) {
 	mockRepo := new(MockEventRepository)
-	service := NewEventService(mockRepo)
+	service := NewEventService(mockRepo, nil, nil)
 
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	after := pagination.Cursor{CreatedAt: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC), ID: "11111111-1111-1111-1111-111111111111"}
+	created := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
 	expectedEvents := []*event.Event{
-		{ID: "event-3", Name: "イベント3"},
+		{ID: "22222222-2222-2222-2222-222222222222", Name: "イベント2", CreatedAt: created},
+		{ID: "33333333-3333-3333-3333-333333333333", Name: "イベント3", CreatedAt: created},
 	}
 
-	mockRepo.On("List", mock.Anything, 10, 20).Return(expectedEvents, nil)
-
-	result, err := service.ListEvents(context.Background(), 10, 20)
+	mockRepo.On("List", mock.Anything, event.ListFilter{
+		PublicOnly: true,
+		StartFrom:  &from,
+		StartTo:    &to,
+		Venue:      "東京ドーム",
+		Query:      "ライブ",
+		After:      &after,
+		Limit:      2,
+	}).Return(expectedEvents, nil)
+
+	result, err := service.ListEvents(context.Background(), ListEventsInput{
+		StartFrom: &from,
+		StartTo:   &to,
+		Venue:     "東京ドーム",
+		Query:     "ライブ",
+		Cursor:    after.Encode(),
+		Limit:     1,
+	})
 
 	require.NoError(t, err)
-	assert.Len(t, result, 1)
+	require.Len(t, result.Items, 1)
+	assert.True(t, result.HasMore())
+	next, err := pagination.DecodeCursor(result.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, "22222222-2222-2222-2222-222222222222", next.ID)
+	assert.True(t, created.Equal(next.CreatedAt))
 	mockRepo.AssertExpectations(t)
 }
 
 func TestEventService_ListEvents_LimitCapped(t *testing.T) {
 	mockRepo := new(MockEventRepository)
-	service := NewEventService(mockRepo)
+	service := NewEventService(mockRepo, nil, nil)
 
-	mockRepo.On("List", mock.Anything, 100, 0).Return([]*event.Event{}, nil)
+	mockRepo.On("List", mock.Anything, event.ListFilter{PublicOnly: true, Limit: 101}).Return([]*event.Event{}, nil)
 
 	// limit が 100 を超えると 100 に制限される
-	_, err := service.ListEvents(context.Background(), 200, 0)
+	_, err := service.ListEvents(context.Background(), ListEventsInput{Limit: 200})
 
 	require.NoError(t, err)
 	mockRepo.AssertExpectations(t)
 }
 
-func TestEventService_ListEvents_NegativeOffset(t *testing.T) {
+func TestEventService_ListEvents_InvalidCursor(t *testing.T) {
 	mockRepo := new(MockEventRepository)
-	service := NewEventService(mockRepo)
+	service := NewEventService(mockRepo, nil, nil)
 
-	mockRepo.On("List", mock.Anything, 20, 0).Return([]*event.Event{}, nil)
+	_, err := service.ListEvents(context.Background(), ListEventsInput{Cursor: "invalid"})
 
-	// 負のoffsetは0に補正される
-	_, err := service.ListEvents(context.Background(), 0, -10)
+	assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+	mockRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+}
 
-	require.NoError(t, err)
-	mockRepo.AssertExpectations(t)
+func TestEventService_SearchEvents(t *testing.T) {
+	t.Run("キーワードを正規化して検索する", func(t *testing.T) {
+		mockRepo := new(MockEventRepository)
+		service := NewEventService(mockRepo, nil, nil)
+
+		expected := []*event.SearchResult{{Event: &event.Event{ID: "event-1"}, Rank: 0.5, Snippet: "<mark>ライブ</mark>"}}
+		mockRepo.On("Search", mock.Anything, event.SearchFilter{PublicOnly: true, Query: "東京 ライブ", HasAvailability: true, Limit: 20}).Return(expected, nil)
+
+		result, err := service.SearchEvents(context.Background(), SearchEventsInput{Query: " 東京  ライブ ", HasAvailability: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("開始前のイベントだけを検索する", func(t *testing.T) {
+		mockRepo := new(MockEventRepository)
+		service := NewEventService(mockRepo, nil, nil)
+
+		before := time.Now()
+		mockRepo.On("Search", mock.Anything, mock.MatchedBy(func(f event.SearchFilter) bool {
+			return f.PublicOnly && f.StartAfter != nil && !f.StartAfter.Before(before) && f.Limit == 5
+		})).Return([]*event.SearchResult{}, nil)
+
+		_, err := service.SearchEvents(context.Background(), SearchEventsInput{Query: "ライブ", UpcomingOnly: true, Limit: 5})
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("キーワードが空の場合はエラー", func(t *testing.T) {
+		mockRepo := new(MockEventRepository)
+		service := NewEventService(mockRepo, nil, nil)
+
+		_, err := service.SearchEvents(context.Background(), SearchEventsInput{Query: "  "})
+
+		assert.ErrorIs(t, err, event.ErrInvalidSearchQuery)
+		mockRepo.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
 }
 
 func TestEventService_UpdateEvent_Success(t *testing.T) {
 	mockRepo := new(MockEventRepository)
-	service := NewEventService(mockRepo)
+	service := NewEventService(mockRepo, nil, nil)
 
 	existingEvent := &event.Event{
 		ID:          "event-1",
+		OrganizerID: testOrganizer.UserID,
 		Name:        "旧イベント名",
 		Description: "旧説明",
 		Venue:       "旧会場",
@@ -243,6 +561,7 @@ func TestEventService_UpdateEvent_Success(t *testing.T) {
 		StartAt:     time.Now().Add(48 * time.Hour),
 		EndAt:       time.Now().Add(51 * time.Hour),
 		TotalSeats:  100,
+		Principal:   testOrganizer,
 	}
 
 	mockRepo.On("GetByID", mock.Anything, "event-1").Return(existingEvent, nil)
@@ -260,12 +579,13 @@ func TestEventService_UpdateEvent_Success(t *testing.T) {
 
 func TestEventService_UpdateEvent_NotFound(t *testing.T) {
 	mockRepo := new(MockEventRepository)
-	service := NewEventService(mockRepo)
+	service := NewEventService(mockRepo, nil, nil)
 
 	input := UpdateEventInput{
 		ID:         "non-existent",
 		Name:       "新イベント名",
 		TotalSeats: 100,
+		Principal:  testOrganizer,
 	}
 
 	mockRepo.On("GetByID", mock.Anything, "non-existent").Return(nil, event.ErrEventNotFound)
@@ -278,16 +598,68 @@ func TestEventService_UpdateEvent_NotFound(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestEventService_UpdateEvent_ExpectedVersion(t *testing.T) {
+	newInput := func(version int) UpdateEventInput {
+		return UpdateEventInput{
+			ID:              "event-1",
+			Name:            "新イベント名",
+			StartAt:         time.Now().Add(48 * time.Hour),
+			EndAt:           time.Now().Add(51 * time.Hour),
+			TotalSeats:      100,
+			ExpectedVersion: &version,
+			Principal:       testOrganizer,
+		}
+	}
+	existingEvent := func() *event.Event {
+		return &event.Event{
+			ID: "event-1", OrganizerID: testOrganizer.UserID, Name: "旧イベント名", TotalSeats: 50,
+			StartAt: time.Now().Add(24 * time.Hour), EndAt: time.Now().Add(27 * time.Hour), Version: 3,
+		}
+	}
+
+	t.Run("バージョンが一致すれば更新できる", func(t *testing.T) {
+		mockRepo := new(MockEventRepository)
+		mockRepo.On("GetByID", mock.Anything, "event-1").Return(existingEvent(), nil)
+		mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*event.Event")).Return(nil)
+
+		_, err := NewEventService(mockRepo, nil, nil).UpdateEvent(context.Background(), newInput(3))
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("バージョンが一致しない場合は更新しない", func(t *testing.T) {
+		mockRepo := new(MockEventRepository)
+		mockRepo.On("GetByID", mock.Anything, "event-1").Return(existingEvent(), nil)
+
+		_, err := NewEventService(mockRepo, nil, nil).UpdateEvent(context.Background(), newInput(2))
+
+		assert.ErrorIs(t, err, event.ErrVersionMismatch)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("取得後に他のリクエストが更新した場合もバージョンの不一致", func(t *testing.T) {
+		mockRepo := new(MockEventRepository)
+		mockRepo.On("GetByID", mock.Anything, "event-1").Return(existingEvent(), nil)
+		mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*event.Event")).Return(event.ErrOptimisticLockConflict)
+
+		_, err := NewEventService(mockRepo, nil, nil).UpdateEvent(context.Background(), newInput(3))
+
+		assert.ErrorIs(t, err, event.ErrVersionMismatch)
+	})
+}
+
 func TestEventService_UpdateEvent_ValidationError(t *testing.T) {
 	mockRepo := new(MockEventRepository)
-	service := NewEventService(mockRepo)
+	service := NewEventService(mockRepo, nil, nil)
 
 	existingEvent := &event.Event{
-		ID:         "event-1",
-		Name:       "旧イベント名",
-		TotalSeats: 50,
-		StartAt:    time.Now().Add(24 * time.Hour),
-		EndAt:      time.Now().Add(27 * time.Hour),
+		ID:          "event-1",
+		OrganizerID: testOrganizer.UserID,
+		Name:        "旧イベント名",
+		TotalSeats:  50,
+		StartAt:     time.Now().Add(24 * time.Hour),
+		EndAt:       time.Now().Add(27 * time.Hour),
 	}
 
 	// 無効な入力（名前が空）
@@ -297,6 +669,7 @@ func TestEventService_UpdateEvent_ValidationError(t *testing.T) {
 		TotalSeats: 100,
 		StartAt:    time.Now().Add(24 * time.Hour),
 		EndAt:      time.Now().Add(27 * time.Hour),
+		Principal:  testOrganizer,
 	}
 
 	mockRepo.On("GetByID", mock.Anything, "event-1").Return(existingEvent, nil)
@@ -309,27 +682,157 @@ func TestEventService_UpdateEvent_ValidationError(t *testing.T) {
 	mockRepo.AssertNotCalled(t, "Update")
 }
 
+func TestEventService_UpdateEvent_NotOrganizer(t *testing.T) {
+	mockRepo := new(MockEventRepository)
+	service := NewEventService(mockRepo, nil, nil)
+
+	existingEvent := &event.Event{
+		ID:          "event-1",
+		Name:        "旧イベント名",
+		OrganizerID: testOrganizer.UserID,
+		TotalSeats:  50,
+		StartAt:     time.Now().Add(24 * time.Hour),
+		EndAt:       time.Now().Add(27 * time.Hour),
+	}
+	mockRepo.On("GetByID", mock.Anything, "event-1").Return(existingEvent, nil)
+
+	result, err := service.UpdateEvent(context.Background(), UpdateEventInput{
+		ID:         "event-1",
+		Name:       "乗っ取り",
+		TotalSeats: 100,
+		StartAt:    time.Now().Add(24 * time.Hour),
+		EndAt:      time.Now().Add(27 * time.Hour),
+		Principal:  auth.NewPrincipal("org-2", auth.RoleOrganizer),
+	})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, auth.ErrPermissionDenied)
+	assert.Equal(t, "旧イベント名", existingEvent.Name)
+	mockRepo.AssertNotCalled(t, "Update")
+}
+
 func TestEventService_DeleteEvent_Success(t *testing.T) {
 	mockRepo := new(MockEventRepository)
-	service := NewEventService(mockRepo)
+	mockResRepo := new(MockReservationRepository)
+	service := NewEventService(mockRepo, nil, mockResRepo)
 
+	mockRepo.On("GetByID", mock.Anything, "event-1").Return(&event.Event{ID: "event-1", OrganizerID: testOrganizer.UserID}, nil)
+	mockResRepo.On("CountActiveByEventID", mock.Anything, "event-1").Return(0, nil)
 	mockRepo.On("Delete", mock.Anything, "event-1").Return(nil)
 
-	err := service.DeleteEvent(context.Background(), "event-1")
+	err := service.DeleteEvent(context.Background(), "event-1", testOrganizer)
 
 	require.NoError(t, err)
 	mockRepo.AssertExpectations(t)
 }
 
+func TestEventService_DeleteEvent_HasActiveReservations(t *testing.T) {
+	mockRepo := new(MockEventRepository)
+	mockResRepo := new(MockReservationRepository)
+	service := NewEventService(mockRepo, nil, mockResRepo)
+
+	mockRepo.On("GetByID", mock.Anything, "event-1").Return(&event.Event{ID: "event-1", OrganizerID: testOrganizer.UserID}, nil)
+	mockResRepo.On("CountActiveByEventID", mock.Anything, "event-1").Return(3, nil)
+
+	err := service.DeleteEvent(context.Background(), "event-1", testOrganizer)
+
+	assert.ErrorIs(t, err, event.ErrEventHasActiveReservations)
+	mockRepo.AssertNotCalled(t, "Delete")
+}
+
 func TestEventService_DeleteEvent_NotFound(t *testing.T) {
 	mockRepo := new(MockEventRepository)
-	service := NewEventService(mockRepo)
+	service := NewEventService(mockRepo, nil, nil)
 
-	mockRepo.On("Delete", mock.Anything, "non-existent").Return(event.ErrEventNotFound)
+	mockRepo.On("GetByID", mock.Anything, "non-existent").Return(nil, event.ErrEventNotFound)
 
-	err := service.DeleteEvent(context.Background(), "non-existent")
+	err := service.DeleteEvent(context.Background(), "non-existent", testOrganizer)
 
 	require.Error(t, err)
 	assert.ErrorIs(t, err, event.ErrEventNotFound)
-	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "Delete")
+}
+
+func TestEventService_DeleteEvent_NotOrganizer(t *testing.T) {
+	mockRepo := new(MockEventRepository)
+	service := NewEventService(mockRepo, nil, nil)
+
+	mockRepo.On("GetByID", mock.Anything, "event-1").Return(&event.Event{ID: "event-1", OrganizerID: testOrganizer.UserID}, nil)
+
+	err := service.DeleteEvent(context.Background(), "event-1", auth.NewPrincipal("user-1"))
+
+	assert.ErrorIs(t, err, auth.ErrPermissionDenied)
+	mockRepo.AssertNotCalled(t, "Delete")
+}
+
+func TestEventService_PatchEvent(t *testing.T) {
+	existingEvent := func() *event.Event {
+		return &event.Event{
+			ID: "event-1", OrganizerID: testOrganizer.UserID, Name: "旧イベント名", Description: "旧説明", Venue: "旧会場",
+			StartAt: time.Now().Add(24 * time.Hour), EndAt: time.Now().Add(27 * time.Hour), TotalSeats: 50,
+			HoldDuration: 15 * time.Minute, MaxHoldDuration: 30 * time.Minute,
+		}
+	}
+	ptr := func(s string) *string { return &s }
+	seats := func(n int) *int { return &n }
+
+	t.Run("指定した項目だけを変更する", func(t *testing.T) {
+		mockRepo := new(MockEventRepository)
+		mockRepo.On("GetByID", mock.Anything, "event-1").Return(existingEvent(), nil)
+		mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*event.Event")).Return(nil)
+
+		result, err := NewEventService(mockRepo, nil, nil).PatchEvent(context.Background(), PatchEventInput{
+			ID: "event-1", Name: ptr("新イベント名"), Venue: ptr(""), TotalSeats: seats(80), Principal: testOrganizer,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "新イベント名", result.Name)
+		assert.Equal(t, "旧説明", result.Description)
+		assert.Empty(t, result.Venue)
+		assert.Equal(t, 80, result.TotalSeats)
+		assert.Equal(t, 15*time.Minute, result.HoldDuration)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("変更後のイベント全体を検証する", func(t *testing.T) {
+		mockRepo := new(MockEventRepository)
+		e := existingEvent()
+		mockRepo.On("GetByID", mock.Anything, "event-1").Return(e, nil)
+		endBeforeStart := e.StartAt.Add(-time.Hour)
+
+		_, err := NewEventService(mockRepo, nil, nil).PatchEvent(context.Background(), PatchEventInput{
+			ID: "event-1", EndAt: &endBeforeStart, Principal: testOrganizer,
+		})
+
+		assert.ErrorIs(t, err, event.ErrInvalidEventTime)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("座席数を作成済みの座席数より少なくできない", func(t *testing.T) {
+		mockRepo := new(MockEventRepository)
+		mockSeatRepo := new(MockSeatRepository)
+		mockRepo.On("GetByID", mock.Anything, "event-1").Return(existingEvent(), nil)
+		mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*event.Event")).Return(event.ErrTotalSeatsBelowCreated)
+
+		_, err := NewEventService(mockRepo, mockSeatRepo, nil).PatchEvent(context.Background(), PatchEventInput{
+			ID: "event-1", TotalSeats: seats(30), Principal: testOrganizer,
+		})
+
+		assert.ErrorIs(t, err, event.ErrTotalSeatsBelowCreated)
+		mockSeatRepo.AssertNotCalled(t, "CountByEventID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("作成済みの座席数までは減らせる", func(t *testing.T) {
+		mockRepo := new(MockEventRepository)
+		mockSeatRepo := new(MockSeatRepository)
+		mockRepo.On("GetByID", mock.Anything, "event-1").Return(existingEvent(), nil)
+		mockRepo.On("Update", mock.Anything, mock.AnythingOfType("*event.Event")).Return(nil)
+
+		result, err := NewEventService(mockRepo, mockSeatRepo, nil).PatchEvent(context.Background(), PatchEventInput{
+			ID: "event-1", TotalSeats: seats(40), Principal: testOrganizer,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 40, result.TotalSeats)
+	})
 }
@@ -0,0 +1,88 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/outbox"
+	redisinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/redis"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/logger"
+)
+
+// outboxRelayLockKey は複数インスタンスでリレーが同時に動かないようにするロックのキー
+const outboxRelayLockKey = "outbox:relay"
+
+// outboxRelayLockTTL はリレーのロックの有効期間（1回の配信処理より十分長くする）
+const outboxRelayLockTTL = 30 * time.Second
+
+// OutboxService はアウトボックスに記録されたドメインイベントを外部へ配信する
+type OutboxService struct {
+	outboxRepo  outbox.Repository
+	sink        outbox.Sink
+	lockManager redisinfra.LockManagerInterface
+	batchSize   int
+}
+
+// NewOutboxService はアウトボックスのリレーサービスを作成する
+// RelayPending の呼び出しごとに未配信のメッセージを最大 batchSize 件配信する
+func NewOutboxService(or outbox.Repository, sink outbox.Sink, lm redisinfra.LockManagerInterface, batchSize int) *OutboxService {
+	return &OutboxService{outboxRepo: or, sink: sink, lockManager: lm, batchSize: batchSize}
+}
+
+// RelayPending は未配信のメッセージを発行順に配信し、配信できた件数を返す
+// 配信に失敗した集約の後続メッセージはその回は配信せず、次回に失敗したメッセージから再送する（集約内の順序保証）
+// 配信後の記録に失敗した場合も次回再送するため、配信は at-least-once になる
+func (s *OutboxService) RelayPending(ctx context.Context) (int, error) {
+	if s.lockManager != nil {
+		lock, err := s.lockManager.AcquireLock(ctx, outboxRelayLockKey, outboxRelayLockTTL)
+		if err != nil {
+			if errors.Is(err, redisinfra.ErrLockNotAcquired) {
+				// 他のインスタンスが配信中
+				return 0, nil
+			}
+			return 0, fmt.Errorf("リレーのロック取得に失敗: %w", err)
+		}
+		defer func() { _ = lock.Release(ctx) }()
+	}
+
+	msgs, err := s.outboxRepo.GetUnpublished(ctx, s.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	blocked := make(map[string]bool)
+	for _, msg := range msgs {
+		aggregate := msg.AggregateType + ":" + msg.AggregateID
+		if blocked[aggregate] {
+			continue
+		}
+		log := logger.With(
+			zap.Int64("message_id", msg.ID),
+			zap.String("aggregate", aggregate),
+			zap.String("event_type", msg.EventType),
+			zap.String("sink", s.sink.Name()),
+		)
+
+		if err := s.sink.Publish(ctx, msg); err != nil {
+			blocked[aggregate] = true
+			msg.RecordFailure(err.Error())
+			log.Warn("ドメインイベントの配信に失敗", zap.Int("attempts", msg.Attempts), zap.Error(err))
+			if err := s.outboxRepo.Update(ctx, msg); err != nil {
+				log.Error("配信失敗の記録に失敗", zap.Error(err))
+			}
+			continue
+		}
+		msg.MarkPublished()
+		if err := s.outboxRepo.Update(ctx, msg); err != nil {
+			// 記録できないまま後続を配信すると順序が崩れるため中断する
+			return published, fmt.Errorf("配信済みの記録に失敗: %w", err)
+		}
+		published++
+	}
+	return published, nil
+}
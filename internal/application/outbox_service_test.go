@@ -0,0 +1,166 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/outbox"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/transaction"
+	redisinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/redis"
+)
+
+// MockOutboxRepository implements outbox.Repository
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) Append(ctx context.Context, tx transaction.Tx, msg *outbox.Message) error {
+	args := m.Called(ctx, tx, msg)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) GetUnpublished(ctx context.Context, limit int) ([]*outbox.Message, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*outbox.Message), args.Error(1)
+}
+
+func (m *MockOutboxRepository) Update(ctx context.Context, msg *outbox.Message) error {
+	args := m.Called(ctx, msg)
+	return args.Error(0)
+}
+
+// MockOutboxSink implements outbox.Sink
+type MockOutboxSink struct {
+	mock.Mock
+}
+
+func (m *MockOutboxSink) Name() string { return "mock" }
+
+func (m *MockOutboxSink) Publish(ctx context.Context, msg *outbox.Message) error {
+	args := m.Called(ctx, msg)
+	return args.Error(0)
+}
+
+func newOutboxMessage(id int64, aggregateID, eventType string) *outbox.Message {
+	return &outbox.Message{
+		ID: id, AggregateType: outbox.AggregateReservation, AggregateID: aggregateID,
+		EventType: eventType, Payload: []byte(`{}`), CreatedAt: time.Now(),
+	}
+}
+
+func TestOutboxService_RelayPending(t *testing.T) {
+	t.Run("未配信のメッセージを発行順に配信して記録する", func(t *testing.T) {
+		repo := new(MockOutboxRepository)
+		sink := new(MockOutboxSink)
+		service := NewOutboxService(repo, sink, nil, 100)
+		ctx := context.Background()
+
+		msgs := []*outbox.Message{
+			newOutboxMessage(1, "res-1", outbox.EventReservationCreated),
+			newOutboxMessage(2, "res-1", outbox.EventReservationConfirmed),
+		}
+		repo.On("GetUnpublished", ctx, 100).Return(msgs, nil)
+		var order []int64
+		sink.On("Publish", ctx, mock.AnythingOfType("*outbox.Message")).
+			Run(func(args mock.Arguments) { order = append(order, args.Get(1).(*outbox.Message).ID) }).
+			Return(nil)
+		repo.On("Update", ctx, mock.AnythingOfType("*outbox.Message")).Return(nil)
+
+		count, err := service.RelayPending(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.Equal(t, []int64{1, 2}, order)
+		assert.True(t, msgs[0].IsPublished())
+		assert.True(t, msgs[1].IsPublished())
+	})
+
+	t.Run("配信に失敗した集約の後続メッセージは配信しない", func(t *testing.T) {
+		repo := new(MockOutboxRepository)
+		sink := new(MockOutboxSink)
+		service := NewOutboxService(repo, sink, nil, 100)
+		ctx := context.Background()
+
+		failed := newOutboxMessage(1, "res-1", outbox.EventReservationCreated)
+		following := newOutboxMessage(2, "res-1", outbox.EventReservationCancelled)
+		other := newOutboxMessage(3, "res-2", outbox.EventReservationCreated)
+		repo.On("GetUnpublished", ctx, 100).Return([]*outbox.Message{failed, following, other}, nil)
+		sink.On("Publish", ctx, failed).Return(errors.New("connection refused"))
+		sink.On("Publish", ctx, other).Return(nil)
+		repo.On("Update", ctx, mock.AnythingOfType("*outbox.Message")).Return(nil)
+
+		count, err := service.RelayPending(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		assert.False(t, failed.IsPublished())
+		assert.Equal(t, 1, failed.Attempts)
+		assert.Equal(t, "connection refused", failed.LastError)
+		assert.False(t, following.IsPublished())
+		assert.True(t, other.IsPublished())
+		sink.AssertNotCalled(t, "Publish", ctx, following)
+	})
+
+	t.Run("配信済みを記録できない場合は中断する", func(t *testing.T) {
+		repo := new(MockOutboxRepository)
+		sink := new(MockOutboxSink)
+		service := NewOutboxService(repo, sink, nil, 100)
+		ctx := context.Background()
+
+		first := newOutboxMessage(1, "res-1", outbox.EventReservationCreated)
+		second := newOutboxMessage(2, "res-2", outbox.EventReservationCreated)
+		repo.On("GetUnpublished", ctx, 100).Return([]*outbox.Message{first, second}, nil)
+		sink.On("Publish", ctx, first).Return(nil)
+		repo.On("Update", ctx, first).Return(errors.New("db error"))
+
+		count, err := service.RelayPending(ctx)
+
+		require.Error(t, err)
+		assert.Equal(t, 0, count)
+		sink.AssertNotCalled(t, "Publish", ctx, second)
+	})
+
+	t.Run("他のインスタンスが配信中の場合は何もしない", func(t *testing.T) {
+		repo := new(MockOutboxRepository)
+		sink := new(MockOutboxSink)
+		lockManager := new(MockLockManager)
+		service := NewOutboxService(repo, sink, lockManager, 100)
+		ctx := context.Background()
+
+		lockManager.On("AcquireLock", ctx, "outbox:relay", 30*time.Second).Return(nil, redisinfra.ErrLockNotAcquired)
+
+		count, err := service.RelayPending(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+		repo.AssertNotCalled(t, "GetUnpublished", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ロックを取得して配信する", func(t *testing.T) {
+		repo := new(MockOutboxRepository)
+		sink := new(MockOutboxSink)
+		lockManager := new(MockLockManager)
+		lock := new(MockLock)
+		service := NewOutboxService(repo, sink, lockManager, 10)
+		ctx := context.Background()
+
+		lockManager.On("AcquireLock", ctx, "outbox:relay", 30*time.Second).Return(lock, nil)
+		lock.On("Release", ctx).Return(nil)
+		repo.On("GetUnpublished", ctx, 10).Return([]*outbox.Message{}, nil)
+
+		count, err := service.RelayPending(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+		lock.AssertExpectations(t)
+	})
+}
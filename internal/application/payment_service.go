@@ -0,0 +1,73 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/payment"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/reservation"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/logger"
+)
+
+type PaymentService struct {
+	gateway         payment.Gateway
+	paymentRepo     payment.Repository
+	reservationRepo reservation.Repository
+}
+
+func NewPaymentService(gw payment.Gateway, pr payment.Repository, rr reservation.Repository) *PaymentService {
+	return &PaymentService{gateway: gw, paymentRepo: pr, reservationRepo: rr}
+}
+
+// GetPaymentByReservation は予約の最新の決済を取得する
+// 予約の所有者または管理者のみ参照できる
+func (s *PaymentService) GetPaymentByReservation(ctx context.Context, reservationID string, principal auth.Principal) (*payment.Payment, error) {
+	if _, err := authorizeReservationAccess(ctx, s.reservationRepo, reservationID, principal); err != nil {
+		return nil, err
+	}
+	return s.paymentRepo.GetByReservationID(ctx, reservationID)
+}
+
+// HandleWebhook はプロバイダーからのWebhookを検証し、決済の状態に反映する
+// 同じ通知が複数回届いても結果が変わらないようにする
+func (s *PaymentService) HandleWebhook(ctx context.Context, payload []byte, signature string) (*payment.Payment, error) {
+	ev, err := s.gateway.VerifyWebhook(payload, signature)
+	if err != nil {
+		return nil, err
+	}
+	pay, err := s.paymentRepo.GetByProviderPaymentID(ctx, s.gateway.Name(), ev.ProviderPaymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch ev.Type {
+	case payment.WebhookPaymentCaptured:
+		err = pay.Capture()
+	case payment.WebhookPaymentFailed:
+		if pay.Status == payment.StatusFailed {
+			return pay, nil
+		}
+		err = pay.Fail(ev.Reason)
+	case payment.WebhookPaymentRefunded:
+		// 当システムから返金済みの場合は通知を反映するだけ（残額を返金済みとして扱う）
+		err = pay.Refund(pay.Amount - pay.RefundedAmount)
+	default:
+		return nil, payment.ErrUnknownWebhookEvent
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.paymentRepo.Update(ctx, pay); err != nil {
+		return nil, fmt.Errorf("決済の更新に失敗: %w", err)
+	}
+
+	logger.Info("Webhookを反映",
+		zap.String("type", string(ev.Type)),
+		zap.String("payment_id", pay.ID),
+		zap.String("status", string(pay.Status)),
+	)
+	return pay, nil
+}
@@ -0,0 +1,216 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/payment"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/reservation"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/transaction"
+)
+
+// MockPaymentRepository はpayment.Repositoryのモック
+type MockPaymentRepository struct {
+	mock.Mock
+}
+
+func (m *MockPaymentRepository) Create(ctx context.Context, p *payment.Payment) error {
+	args := m.Called(ctx, p)
+	return args.Error(0)
+}
+
+func (m *MockPaymentRepository) GetByID(ctx context.Context, id string) (*payment.Payment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payment.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepository) GetByReservationID(ctx context.Context, reservationID string) (*payment.Payment, error) {
+	args := m.Called(ctx, reservationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payment.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepository) CountByReservationID(ctx context.Context, reservationID string) (int, error) {
+	args := m.Called(ctx, reservationID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockPaymentRepository) GetByProviderPaymentID(ctx context.Context, provider, providerPaymentID string) (*payment.Payment, error) {
+	args := m.Called(ctx, provider, providerPaymentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payment.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepository) Update(ctx context.Context, p *payment.Payment) error {
+	args := m.Called(ctx, p)
+	return args.Error(0)
+}
+
+func (m *MockPaymentRepository) UpdateTx(ctx context.Context, tx transaction.Tx, p *payment.Payment) error {
+	args := m.Called(ctx, tx, p)
+	return args.Error(0)
+}
+
+// MockPaymentGateway はpayment.Gatewayのモック
+type MockPaymentGateway struct {
+	mock.Mock
+}
+
+func (m *MockPaymentGateway) Name() string {
+	return "mock"
+}
+
+func (m *MockPaymentGateway) Authorize(ctx context.Context, req payment.AuthorizeRequest) (string, error) {
+	args := m.Called(ctx, req)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockPaymentGateway) Capture(ctx context.Context, providerPaymentID string, amount int) error {
+	args := m.Called(ctx, providerPaymentID, amount)
+	return args.Error(0)
+}
+
+func (m *MockPaymentGateway) Refund(ctx context.Context, req payment.RefundRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+func (m *MockPaymentGateway) VerifyWebhook(payload []byte, signature string) (*payment.WebhookEvent, error) {
+	args := m.Called(payload, signature)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payment.WebhookEvent), args.Error(1)
+}
+
+func TestPaymentService_GetPaymentByReservation(t *testing.T) {
+	res := &reservation.Reservation{ID: "res-1", UserID: "user-1"}
+	pay := &payment.Payment{ID: "pay-1", ReservationID: "res-1", Status: payment.StatusCaptured}
+
+	t.Run("予約の所有者は決済を取得できる", func(t *testing.T) {
+		repo := new(MockPaymentRepository)
+		resRepo := new(MockReservationRepository)
+		service := NewPaymentService(new(MockPaymentGateway), repo, resRepo)
+		ctx := context.Background()
+
+		resRepo.On("GetByID", ctx, "res-1").Return(res, nil)
+		repo.On("GetByReservationID", ctx, "res-1").Return(pay, nil)
+
+		result, err := service.GetPaymentByReservation(ctx, "res-1", auth.NewPrincipal("user-1"))
+
+		require.NoError(t, err)
+		assert.Equal(t, "pay-1", result.ID)
+	})
+
+	t.Run("管理者は他のユーザーの予約の決済を取得できる", func(t *testing.T) {
+		repo := new(MockPaymentRepository)
+		resRepo := new(MockReservationRepository)
+		service := NewPaymentService(new(MockPaymentGateway), repo, resRepo)
+		ctx := context.Background()
+
+		resRepo.On("GetByID", ctx, "res-1").Return(res, nil)
+		repo.On("GetByReservationID", ctx, "res-1").Return(pay, nil)
+
+		result, err := service.GetPaymentByReservation(ctx, "res-1", auth.NewPrincipal("admin-1", auth.RoleAdmin))
+
+		require.NoError(t, err)
+		assert.Equal(t, "pay-1", result.ID)
+	})
+
+	t.Run("他のユーザーの予約の決済は取得できない", func(t *testing.T) {
+		repo := new(MockPaymentRepository)
+		resRepo := new(MockReservationRepository)
+		service := NewPaymentService(new(MockPaymentGateway), repo, resRepo)
+		ctx := context.Background()
+
+		resRepo.On("GetByID", ctx, "res-1").Return(res, nil)
+
+		_, err := service.GetPaymentByReservation(ctx, "res-1", auth.NewPrincipal("user-999"))
+
+		assert.ErrorIs(t, err, reservation.ErrReservationNotFound)
+		repo.AssertNotCalled(t, "GetByReservationID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPaymentService_HandleWebhook(t *testing.T) {
+	payload := []byte(`{}`)
+
+	t.Run("返金通知で決済を返金済みにする", func(t *testing.T) {
+		gw := new(MockPaymentGateway)
+		repo := new(MockPaymentRepository)
+		service := NewPaymentService(gw, repo, new(MockReservationRepository))
+
+		pay := &payment.Payment{ID: "pay-1", ProviderPaymentID: "pp-1", Status: payment.StatusCaptured}
+		gw.On("VerifyWebhook", payload, "sig").Return(&payment.WebhookEvent{
+			Type: payment.WebhookPaymentRefunded, ProviderPaymentID: "pp-1",
+		}, nil)
+		repo.On("GetByProviderPaymentID", mock.Anything, "mock", "pp-1").Return(pay, nil)
+		repo.On("Update", mock.Anything, pay).Return(nil)
+
+		result, err := service.HandleWebhook(context.Background(), payload, "sig")
+
+		require.NoError(t, err)
+		assert.Equal(t, payment.StatusRefunded, result.Status)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("重複した売上確定通知は冪等に処理する", func(t *testing.T) {
+		gw := new(MockPaymentGateway)
+		repo := new(MockPaymentRepository)
+		service := NewPaymentService(gw, repo, new(MockReservationRepository))
+
+		pay := &payment.Payment{ID: "pay-1", ProviderPaymentID: "pp-1", Status: payment.StatusCaptured}
+		gw.On("VerifyWebhook", payload, "sig").Return(&payment.WebhookEvent{
+			Type: payment.WebhookPaymentCaptured, ProviderPaymentID: "pp-1",
+		}, nil)
+		repo.On("GetByProviderPaymentID", mock.Anything, "mock", "pp-1").Return(pay, nil)
+		repo.On("Update", mock.Anything, pay).Return(nil)
+
+		result, err := service.HandleWebhook(context.Background(), payload, "sig")
+
+		require.NoError(t, err)
+		assert.Equal(t, payment.StatusCaptured, result.Status)
+	})
+
+	t.Run("売上確定済みの決済に失敗通知が届いた場合エラー", func(t *testing.T) {
+		gw := new(MockPaymentGateway)
+		repo := new(MockPaymentRepository)
+		service := NewPaymentService(gw, repo, new(MockReservationRepository))
+
+		pay := &payment.Payment{ID: "pay-1", ProviderPaymentID: "pp-1", Status: payment.StatusCaptured}
+		gw.On("VerifyWebhook", payload, "sig").Return(&payment.WebhookEvent{
+			Type: payment.WebhookPaymentFailed, ProviderPaymentID: "pp-1",
+		}, nil)
+		repo.On("GetByProviderPaymentID", mock.Anything, "mock", "pp-1").Return(pay, nil)
+
+		_, err := service.HandleWebhook(context.Background(), payload, "sig")
+
+		assert.ErrorIs(t, err, payment.ErrInvalidStatusTransition)
+		repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("署名が不正な場合エラー", func(t *testing.T) {
+		gw := new(MockPaymentGateway)
+		repo := new(MockPaymentRepository)
+		service := NewPaymentService(gw, repo, new(MockReservationRepository))
+
+		gw.On("VerifyWebhook", payload, "bad").Return(nil, payment.ErrInvalidWebhookSignature)
+
+		_, err := service.HandleWebhook(context.Background(), payload, "bad")
+
+		assert.ErrorIs(t, err, payment.ErrInvalidWebhookSignature)
+		repo.AssertNotCalled(t, "GetByProviderPaymentID", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,103 @@
+package application
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pricecategory"
+)
+
+type PriceCategoryService struct {
+	categoryRepo pricecategory.Repository
+	eventRepo    event.Repository
+}
+
+func NewPriceCategoryService(cr pricecategory.Repository, er event.Repository) *PriceCategoryService {
+	return &PriceCategoryService{categoryRepo: cr, eventRepo: er}
+}
+
+type CreatePriceCategoryInput struct {
+	EventID  string
+	Name     string
+	Currency string
+	Amount   int
+	// Principal は呼び出し元（イベントの主催者または管理者のみ作成できる）
+	Principal auth.Principal
+}
+
+func (s *PriceCategoryService) CreatePriceCategory(ctx context.Context, input CreatePriceCategoryInput) (*pricecategory.PriceCategory, error) {
+	if _, err := authorizeEventManagement(ctx, s.eventRepo, input.EventID, input.Principal); err != nil {
+		return nil, err
+	}
+	c := pricecategory.NewPriceCategory(input.EventID, input.Name, input.Currency, input.Amount)
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.categoryRepo.Create(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetPriceCategory はイベントに属する価格カテゴリを取得する
+func (s *PriceCategoryService) GetPriceCategory(ctx context.Context, eventID, id string) (*pricecategory.PriceCategory, error) {
+	c, err := s.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// 別イベントのカテゴリは存在しないものとして扱う
+	if c.EventID != eventID {
+		return nil, pricecategory.ErrPriceCategoryNotFound
+	}
+	return c, nil
+}
+
+func (s *PriceCategoryService) ListPriceCategories(ctx context.Context, eventID string) ([]*pricecategory.PriceCategory, error) {
+	return s.categoryRepo.GetByEventID(ctx, eventID)
+}
+
+type UpdatePriceCategoryInput struct {
+	ID       string
+	EventID  string
+	Name     string
+	Currency string
+	Amount   int
+	// Principal は呼び出し元（イベントの主催者または管理者のみ更新できる）
+	Principal auth.Principal
+}
+
+// UpdatePriceCategory は価格カテゴリを更新する
+// 金額の変更はカテゴリに割り当て済みの座席にも反映される（確定済み予約の金額は変わらない）
+func (s *PriceCategoryService) UpdatePriceCategory(ctx context.Context, input UpdatePriceCategoryInput) (*pricecategory.PriceCategory, error) {
+	if _, err := authorizeEventManagement(ctx, s.eventRepo, input.EventID, input.Principal); err != nil {
+		return nil, err
+	}
+	c, err := s.GetPriceCategory(ctx, input.EventID, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	c.Name = input.Name
+	if input.Currency != "" {
+		c.Currency = strings.ToUpper(input.Currency)
+	}
+	c.Amount = input.Amount
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.categoryRepo.Update(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *PriceCategoryService) DeletePriceCategory(ctx context.Context, eventID, id string, principal auth.Principal) error {
+	if _, err := authorizeEventManagement(ctx, s.eventRepo, eventID, principal); err != nil {
+		return err
+	}
+	if _, err := s.GetPriceCategory(ctx, eventID, id); err != nil {
+		return err
+	}
+	return s.categoryRepo.Delete(ctx, id)
+}
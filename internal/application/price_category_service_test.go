@@ -0,0 +1,190 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pricecategory"
+)
+
+// MockPriceCategoryRepository はpricecategory.Repositoryのモック
+type MockPriceCategoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockPriceCategoryRepository) Create(ctx context.Context, c *pricecategory.PriceCategory) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
+func (m *MockPriceCategoryRepository) GetByID(ctx context.Context, id string) (*pricecategory.PriceCategory, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*pricecategory.PriceCategory), args.Error(1)
+}
+
+func (m *MockPriceCategoryRepository) GetByEventID(ctx context.Context, eventID string) ([]*pricecategory.PriceCategory, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*pricecategory.PriceCategory), args.Error(1)
+}
+
+func (m *MockPriceCategoryRepository) Update(ctx context.Context, c *pricecategory.PriceCategory) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
+func (m *MockPriceCategoryRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func TestPriceCategoryService_CreatePriceCategory(t *testing.T) {
+	t.Run("正常に作成できる", func(t *testing.T) {
+		mockRepo := new(MockPriceCategoryRepository)
+		mockEventRepo := new(MockEventRepository)
+		service := NewPriceCategoryService(mockRepo, mockEventRepo)
+
+		mockEventRepo.On("GetByID", mock.Anything, "event-1").Return(&event.Event{ID: "event-1", OrganizerID: testOrganizer.UserID}, nil)
+		mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*pricecategory.PriceCategory")).Return(nil)
+
+		result, err := service.CreatePriceCategory(context.Background(), CreatePriceCategoryInput{
+			EventID: "event-1", Name: "S席", Currency: "jpy", Amount: 12000, Principal: testOrganizer,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "S席", result.Name)
+		assert.Equal(t, "JPY", result.Currency)
+		assert.Equal(t, 12000, result.Amount)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("イベントが存在しない", func(t *testing.T) {
+		mockRepo := new(MockPriceCategoryRepository)
+		mockEventRepo := new(MockEventRepository)
+		service := NewPriceCategoryService(mockRepo, mockEventRepo)
+
+		mockEventRepo.On("GetByID", mock.Anything, "nonexistent").Return(nil, event.ErrEventNotFound)
+
+		result, err := service.CreatePriceCategory(context.Background(), CreatePriceCategoryInput{
+			EventID: "nonexistent", Name: "S席", Amount: 12000, Principal: testOrganizer,
+		})
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, event.ErrEventNotFound)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("金額が負の場合はバリデーションエラー", func(t *testing.T) {
+		mockRepo := new(MockPriceCategoryRepository)
+		mockEventRepo := new(MockEventRepository)
+		service := NewPriceCategoryService(mockRepo, mockEventRepo)
+
+		mockEventRepo.On("GetByID", mock.Anything, "event-1").Return(&event.Event{ID: "event-1", OrganizerID: testOrganizer.UserID}, nil)
+
+		result, err := service.CreatePriceCategory(context.Background(), CreatePriceCategoryInput{
+			EventID: "event-1", Name: "S席", Amount: -1, Principal: testOrganizer,
+		})
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, pricecategory.ErrInvalidAmount)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestPriceCategoryService_GetPriceCategory_OtherEvent(t *testing.T) {
+	mockRepo := new(MockPriceCategoryRepository)
+	service := NewPriceCategoryService(mockRepo, new(MockEventRepository))
+
+	mockRepo.On("GetByID", mock.Anything, "cat-1").
+		Return(&pricecategory.PriceCategory{ID: "cat-1", EventID: "event-2"}, nil)
+
+	// 別イベントのカテゴリは見つからない扱い
+	result, err := service.GetPriceCategory(context.Background(), "event-1", "cat-1")
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, pricecategory.ErrPriceCategoryNotFound)
+}
+
+func TestPriceCategoryService_UpdatePriceCategory(t *testing.T) {
+	mockRepo := new(MockPriceCategoryRepository)
+	service := NewPriceCategoryService(mockRepo, newOrganizerEventRepo())
+
+	existing := &pricecategory.PriceCategory{
+		ID: "cat-1", EventID: "event-1", Name: "S席", Currency: "JPY", Amount: 12000,
+		CreatedAt: time.Now(), UpdatedAt: time.Now(),
+	}
+	mockRepo.On("GetByID", mock.Anything, "cat-1").Return(existing, nil)
+	mockRepo.On("Update", mock.Anything, existing).Return(nil)
+
+	result, err := service.UpdatePriceCategory(context.Background(), UpdatePriceCategoryInput{
+		ID: "cat-1", EventID: "event-1", Name: "SS席", Amount: 15000, Principal: testOrganizer,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "SS席", result.Name)
+	assert.Equal(t, "JPY", result.Currency, "通貨未指定の場合は既存の値を維持")
+	assert.Equal(t, 15000, result.Amount)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPriceCategoryService_DeletePriceCategory(t *testing.T) {
+	t.Run("正常に削除できる", func(t *testing.T) {
+		mockRepo := new(MockPriceCategoryRepository)
+		service := NewPriceCategoryService(mockRepo, newOrganizerEventRepo())
+
+		mockRepo.On("GetByID", mock.Anything, "cat-1").
+			Return(&pricecategory.PriceCategory{ID: "cat-1", EventID: "event-1"}, nil)
+		mockRepo.On("Delete", mock.Anything, "cat-1").Return(nil)
+
+		err := service.DeletePriceCategory(context.Background(), "event-1", "cat-1", testOrganizer)
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("座席に割り当て済みの場合はエラー", func(t *testing.T) {
+		mockRepo := new(MockPriceCategoryRepository)
+		service := NewPriceCategoryService(mockRepo, newOrganizerEventRepo())
+
+		mockRepo.On("GetByID", mock.Anything, "cat-1").
+			Return(&pricecategory.PriceCategory{ID: "cat-1", EventID: "event-1"}, nil)
+		mockRepo.On("Delete", mock.Anything, "cat-1").Return(pricecategory.ErrPriceCategoryInUse)
+
+		err := service.DeletePriceCategory(context.Background(), "event-1", "cat-1", testOrganizer)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, pricecategory.ErrPriceCategoryInUse)
+	})
+
+	t.Run("他の主催者は削除できない", func(t *testing.T) {
+		mockRepo := new(MockPriceCategoryRepository)
+		service := NewPriceCategoryService(mockRepo, newOrganizerEventRepo())
+
+		err := service.DeletePriceCategory(context.Background(), "event-1", "cat-1", auth.NewPrincipal("org-2", auth.RoleOrganizer))
+
+		assert.ErrorIs(t, err, auth.ErrPermissionDenied)
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+// newOrganizerEventRepo は testOrganizer が主催する event-1 を返すイベントリポジトリのモックを作成する
+func newOrganizerEventRepo() *MockEventRepository {
+	er := new(MockEventRepository)
+	er.On("GetByID", mock.Anything, "event-1").Return(&event.Event{ID: "event-1", OrganizerID: testOrganizer.UserID}, nil)
+	return er
+}
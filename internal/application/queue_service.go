@@ -0,0 +1,101 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/queue"
+	redisinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/redis"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/logger"
+)
+
+// queueAdmitLockKey は複数インスタンスの入場許可を1間隔に1回に制限するロックのキー
+const queueAdmitLockKey = "queue:admit"
+
+type QueueService struct {
+	waitingRoom    redisinfra.WaitingRoomInterface
+	eventRepo      event.Repository
+	admitBatchSize int
+	admissionTTL   time.Duration
+	lockManager    redisinfra.LockManagerInterface
+	admitInterval  time.Duration
+}
+
+// QueueOption はQueueServiceの任意の依存を設定する
+type QueueOption func(*QueueService)
+
+// WithAdmitLock は複数インスタンスで動かしても、入場許可を interval ごとに1回（admitBatchSize 人）に制限する
+func WithAdmitLock(lm redisinfra.LockManagerInterface, interval time.Duration) QueueOption {
+	return func(s *QueueService) {
+		s.lockManager = lm
+		s.admitInterval = interval
+	}
+}
+
+// NewQueueService は待合室サービスを作成する
+// 入場許可は AdmitWaiting の呼び出しごとに admitBatchSize 人ずつ、admissionTTL の間有効なものを与える
+func NewQueueService(wr redisinfra.WaitingRoomInterface, er event.Repository, admitBatchSize int, admissionTTL time.Duration, opts ...QueueOption) *QueueService {
+	s := &QueueService{waitingRoom: wr, eventRepo: er, admitBatchSize: admitBatchSize, admissionTTL: admissionTTL}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// JoinQueue はイベントの待合室に並び、チケットを発行する
+func (s *QueueService) JoinQueue(ctx context.Context, eventID, userID string) (*queue.Ticket, error) {
+	ev, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("イベント取得に失敗: %w", err)
+	}
+	if !ev.IsBookingOpen() {
+		return nil, event.ErrEventNotOpen
+	}
+	if !ev.WaitingRoomEnabled {
+		return nil, queue.ErrWaitingRoomDisabled
+	}
+	return s.waitingRoom.Enqueue(ctx, eventID, userID)
+}
+
+// GetTicket はチケットの状態と順位を取得する
+func (s *QueueService) GetTicket(ctx context.Context, eventID, token string) (*queue.Ticket, error) {
+	return s.waitingRoom.GetTicket(ctx, eventID, token)
+}
+
+// AdmitWaiting は待合室のあるすべてのイベントで、入場待ちの先頭から入場を許可する
+// 他のインスタンスがこの間隔の入場許可を済ませている場合は何もしない
+func (s *QueueService) AdmitWaiting(ctx context.Context) (int, error) {
+	if s.lockManager != nil {
+		// ロックは解放せず期限切れまで保持し、次の間隔まで他のインスタンスに入場許可させない
+		// （自分の次の実行と重ならないよう、期限は間隔より少し短くする）
+		_, err := s.lockManager.AcquireLock(ctx, queueAdmitLockKey, s.admitInterval*9/10)
+		if err != nil {
+			if errors.Is(err, redisinfra.ErrLockNotAcquired) {
+				return 0, nil
+			}
+			return 0, fmt.Errorf("入場許可のロック取得に失敗: %w", err)
+		}
+	}
+	eventIDs, err := s.waitingRoom.ActiveEventIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	total := 0
+	for _, eventID := range eventIDs {
+		admitted, err := s.waitingRoom.Admit(ctx, eventID, s.admitBatchSize, s.admissionTTL)
+		if err != nil {
+			logger.Error("入場許可に失敗", zap.String("event_id", eventID), zap.Error(err))
+			continue
+		}
+		if admitted > 0 {
+			logger.Debug("待合室から入場許可", zap.String("event_id", eventID), zap.Int("count", admitted))
+		}
+		total += admitted
+	}
+	return total, nil
+}
@@ -0,0 +1,184 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/queue"
+	redisinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/redis"
+)
+
+// MockWaitingRoom はWaitingRoomInterfaceのモック
+type MockWaitingRoom struct {
+	mock.Mock
+}
+
+func (m *MockWaitingRoom) Enqueue(ctx context.Context, eventID, userID string) (*queue.Ticket, error) {
+	args := m.Called(ctx, eventID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*queue.Ticket), args.Error(1)
+}
+
+func (m *MockWaitingRoom) GetTicket(ctx context.Context, eventID, token string) (*queue.Ticket, error) {
+	args := m.Called(ctx, eventID, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*queue.Ticket), args.Error(1)
+}
+
+func (m *MockWaitingRoom) Admit(ctx context.Context, eventID string, count int, ttl time.Duration) (int, error) {
+	args := m.Called(ctx, eventID, count, ttl)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockWaitingRoom) IsAdmitted(ctx context.Context, eventID, token, userID string) (bool, error) {
+	args := m.Called(ctx, eventID, token, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockWaitingRoom) ActiveEventIDs(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func TestQueueService_JoinQueue(t *testing.T) {
+	newEvent := func(waitingRoom bool) *event.Event {
+		return &event.Event{
+			Status:             event.StatusOnSale,
+			ID:                 "event-1",
+			StartAt:            time.Now().Add(1 * time.Hour),
+			EndAt:              time.Now().Add(2 * time.Hour),
+			WaitingRoomEnabled: waitingRoom,
+		}
+	}
+
+	t.Run("待合室が有効なイベントに並べる", func(t *testing.T) {
+		mockRoom := new(MockWaitingRoom)
+		mockEventRepo := new(MockEventRepository)
+		service := NewQueueService(mockRoom, mockEventRepo, 100, 10*time.Minute)
+
+		mockEventRepo.On("GetByID", mock.Anything, "event-1").Return(newEvent(true), nil)
+		mockRoom.On("Enqueue", mock.Anything, "event-1", "user-1").Return(&queue.Ticket{
+			Token: "token-1", EventID: "event-1", UserID: "user-1", Status: queue.StatusWaiting, Position: 1,
+		}, nil)
+
+		ticket, err := service.JoinQueue(context.Background(), "event-1", "user-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "token-1", ticket.Token)
+		assert.Equal(t, 1, ticket.Position)
+		mockRoom.AssertExpectations(t)
+	})
+
+	t.Run("待合室が無効なイベントには並べない", func(t *testing.T) {
+		mockRoom := new(MockWaitingRoom)
+		mockEventRepo := new(MockEventRepository)
+		service := NewQueueService(mockRoom, mockEventRepo, 100, 10*time.Minute)
+
+		mockEventRepo.On("GetByID", mock.Anything, "event-1").Return(newEvent(false), nil)
+
+		_, err := service.JoinQueue(context.Background(), "event-1", "user-1")
+
+		assert.ErrorIs(t, err, queue.ErrWaitingRoomDisabled)
+		mockRoom.AssertNotCalled(t, "Enqueue", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("予約受付が終了したイベントには並べない", func(t *testing.T) {
+		mockRoom := new(MockWaitingRoom)
+		mockEventRepo := new(MockEventRepository)
+		service := NewQueueService(mockRoom, mockEventRepo, 100, 10*time.Minute)
+
+		started := newEvent(true)
+		started.StartAt = time.Now().Add(-1 * time.Hour)
+		mockEventRepo.On("GetByID", mock.Anything, "event-1").Return(started, nil)
+
+		_, err := service.JoinQueue(context.Background(), "event-1", "user-1")
+
+		assert.ErrorIs(t, err, event.ErrEventNotOpen)
+	})
+}
+
+func TestQueueService_AdmitWaiting(t *testing.T) {
+	t.Run("すべてのイベントで入場を許可する", func(t *testing.T) {
+		mockRoom := new(MockWaitingRoom)
+		service := NewQueueService(mockRoom, new(MockEventRepository), 50, 10*time.Minute)
+
+		mockRoom.On("ActiveEventIDs", mock.Anything).Return([]string{"event-1", "event-2"}, nil)
+		mockRoom.On("Admit", mock.Anything, "event-1", 50, 10*time.Minute).Return(50, nil)
+		mockRoom.On("Admit", mock.Anything, "event-2", 50, 10*time.Minute).Return(3, nil)
+
+		count, err := service.AdmitWaiting(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 53, count)
+		mockRoom.AssertExpectations(t)
+	})
+
+	t.Run("一部のイベントで失敗しても他のイベントは処理する", func(t *testing.T) {
+		mockRoom := new(MockWaitingRoom)
+		service := NewQueueService(mockRoom, new(MockEventRepository), 50, 10*time.Minute)
+
+		mockRoom.On("ActiveEventIDs", mock.Anything).Return([]string{"event-1", "event-2"}, nil)
+		mockRoom.On("Admit", mock.Anything, "event-1", 50, 10*time.Minute).Return(0, assert.AnError)
+		mockRoom.On("Admit", mock.Anything, "event-2", 50, 10*time.Minute).Return(3, nil)
+
+		count, err := service.AdmitWaiting(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("イベント一覧の取得に失敗した場合エラー", func(t *testing.T) {
+		mockRoom := new(MockWaitingRoom)
+		service := NewQueueService(mockRoom, new(MockEventRepository), 50, 10*time.Minute)
+
+		mockRoom.On("ActiveEventIDs", mock.Anything).Return(nil, assert.AnError)
+
+		_, err := service.AdmitWaiting(context.Background())
+
+		assert.Error(t, err)
+	})
+
+	t.Run("この間隔の入場許可を他のインスタンスが済ませていれば何もしない", func(t *testing.T) {
+		mockRoom := new(MockWaitingRoom)
+		mockLock := new(MockLockManager)
+		service := NewQueueService(mockRoom, new(MockEventRepository), 50, 10*time.Minute, WithAdmitLock(mockLock, 10*time.Second))
+
+		mockLock.On("AcquireLock", mock.Anything, "queue:admit", 9*time.Second).Return(nil, redisinfra.ErrLockNotAcquired)
+
+		count, err := service.AdmitWaiting(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+		mockRoom.AssertNotCalled(t, "ActiveEventIDs", mock.Anything)
+	})
+
+	t.Run("ロックを取得したインスタンスはロックを解放せずに入場を許可する", func(t *testing.T) {
+		mockRoom := new(MockWaitingRoom)
+		mockLock := new(MockLockManager)
+		lock := new(MockLock)
+		service := NewQueueService(mockRoom, new(MockEventRepository), 50, 10*time.Minute, WithAdmitLock(mockLock, 10*time.Second))
+
+		mockLock.On("AcquireLock", mock.Anything, "queue:admit", 9*time.Second).Return(lock, nil)
+		mockRoom.On("ActiveEventIDs", mock.Anything).Return([]string{"event-1"}, nil)
+		mockRoom.On("Admit", mock.Anything, "event-1", 50, 10*time.Minute).Return(50, nil)
+
+		count, err := service.AdmitWaiting(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 50, count)
+		lock.AssertNotCalled(t, "Release", mock.Anything)
+	})
+}
@@ -10,10 +10,17 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/outbox"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pagination"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/payment"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pricecategory"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/queue"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/reservation"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/transaction"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/waitlist"
 	redisinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/redis"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/logger"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/metrics"
@@ -26,10 +33,51 @@ type ReservationService struct {
 	eventRepo       event.Repository
 	lockManager     redisinfra.LockManagerInterface
 	seatCache       redisinfra.SeatCacheInterface
+	categoryRepo    pricecategory.Repository
+	waitlistRepo    waitlist.Repository
+	waitingRoom     redisinfra.WaitingRoomInterface
+	paymentGateway  payment.Gateway
+	paymentRepo     payment.Repository
+	outboxRepo      outbox.Repository
 }
 
-func NewReservationService(txm transaction.Manager, rr reservation.Repository, sr seat.Repository, er event.Repository, lm redisinfra.LockManagerInterface, cache redisinfra.SeatCacheInterface) *ReservationService {
-	return &ReservationService{txManager: txm, reservationRepo: rr, seatRepo: sr, eventRepo: er, lockManager: lm, seatCache: cache}
+// ReservationOption はReservationServiceの任意の依存を設定する
+type ReservationOption func(*ReservationService)
+
+// WithPriceCategoryRepository は価格カテゴリによる金額計算を有効にする
+func WithPriceCategoryRepository(cr pricecategory.Repository) ReservationOption {
+	return func(s *ReservationService) { s.categoryRepo = cr }
+}
+
+// WithWaitlistRepository は座席解放時の順番待ちユーザーへのオファーを有効にする
+func WithWaitlistRepository(wr waitlist.Repository) ReservationOption {
+	return func(s *ReservationService) { s.waitlistRepo = wr }
+}
+
+// WithWaitingRoom は待合室が有効なイベントで、入場許可のないユーザーの予約作成を拒否する
+func WithWaitingRoom(wr redisinfra.WaitingRoomInterface) ReservationOption {
+	return func(s *ReservationService) { s.waitingRoom = wr }
+}
+
+// WithPayment は予約確定時の決済（オーソリ・売上確定）を有効にする
+func WithPayment(gw payment.Gateway, pr payment.Repository) ReservationOption {
+	return func(s *ReservationService) {
+		s.paymentGateway = gw
+		s.paymentRepo = pr
+	}
+}
+
+// WithOutbox は予約の状態変更をドメインイベントとしてアウトボックスに記録する
+func WithOutbox(or outbox.Repository) ReservationOption {
+	return func(s *ReservationService) { s.outboxRepo = or }
+}
+
+func NewReservationService(txm transaction.Manager, rr reservation.Repository, sr seat.Repository, er event.Repository, lm redisinfra.LockManagerInterface, cache redisinfra.SeatCacheInterface, opts ...ReservationOption) *ReservationService {
+	s := &ReservationService{txManager: txm, reservationRepo: rr, seatRepo: sr, eventRepo: er, lockManager: lm, seatCache: cache}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 type CreateReservationInput struct {
@@ -37,6 +85,9 @@ type CreateReservationInput struct {
 	UserID         string
 	SeatIDs        []string
 	IdempotencyKey string
+	QueueToken     string // 待合室のチケット（待合室が有効なイベントで必須）
+
+	admitted bool // 入場確認済み（サービス内部からの呼び出し用）
 }
 
 func (s *ReservationService) CreateReservation(ctx context.Context, input CreateReservationInput) (*reservation.Reservation, error) {
@@ -58,6 +109,14 @@ func (s *ReservationService) CreateReservation(ctx context.Context, input Create
 		return nil, fmt.Errorf("冪等性チェックに失敗: %w", err)
 	}
 
+	// 待合室の入場確認（ロック競合の前に流量を絞る）
+	if !input.admitted {
+		if err := s.checkAdmission(ctx, input.EventID, input.UserID, input.QueueToken); err != nil {
+			log.Warn("待合室の入場確認に失敗", zap.Error(err))
+			return nil, err
+		}
+	}
+
 	// 分散ロックを取得（座席IDをソートしてデッドロックを防止）
 	lockKey := s.buildSeatLockKey(input.SeatIDs)
 	var lock redisinfra.Lock
@@ -73,10 +132,10 @@ func (s *ReservationService) CreateReservation(ctx context.Context, input Create
 			}
 			if errors.Is(err, redisinfra.ErrLockNotAcquired) {
 				log.Warn("分散ロック取得失敗: 他のユーザーが処理中")
-				return nil, fmt.Errorf("座席が他のユーザーによって処理中です")
+				return nil, seat.ErrSeatLockContention
 			}
 			log.Error("ロック取得に失敗", zap.Error(err))
-			return nil, fmt.Errorf("ロック取得に失敗: %w", err)
+			return nil, fmt.Errorf("ロック取得に失敗: %w: %w", seat.ErrSeatLockUnavailable, err)
 		}
 		if m := metrics.Get(); m != nil {
 			m.DistributedLockDuration.WithLabelValues("acquire", "success").Observe(lockDuration)
@@ -99,6 +158,11 @@ func (s *ReservationService) CreateReservation(ctx context.Context, input Create
 	if !ev.IsBookingOpen() {
 		return nil, event.ErrEventNotOpen
 	}
+	if limitErr := ev.CheckPurchaseLimit(len(input.SeatIDs), 0); limitErr != nil {
+		log.Warn("購入枚数の上限を超過", zap.Error(limitErr))
+		s.recordLimitExceeded()
+		return nil, limitErr
+	}
 
 	// 座席確認
 	seats, err := s.seatRepo.GetByEventID(ctx, input.EventID)
@@ -110,7 +174,7 @@ func (s *ReservationService) CreateReservation(ctx context.Context, input Create
 	for _, se := range seats {
 		seatMap[se.ID] = se
 	}
-	var totalAmount int
+	selected := make([]*seat.Seat, 0, len(input.SeatIDs))
 	for _, id := range input.SeatIDs {
 		se, ok := seatMap[id]
 		if !ok {
@@ -124,11 +188,18 @@ func (s *ReservationService) CreateReservation(ctx context.Context, input Create
 			}
 			return nil, seat.ErrSeatAlreadyReserved
 		}
-		totalAmount += se.Price
+		selected = append(selected, se)
+	}
+	seatPrices, totalAmount, err := s.calculateSeatPrices(ctx, input.EventID, selected)
+	if err != nil {
+		log.Error("合計金額の計算に失敗", zap.Error(err))
+		return nil, err
 	}
 
 	// 予約作成
 	res := reservation.NewReservation(input.EventID, input.UserID, input.IdempotencyKey, input.SeatIDs, totalAmount)
+	res.SeatPrices = seatPrices
+	res.ApplyHoldPolicy(holdPolicyOf(ev))
 	if validateErr := res.Validate(); validateErr != nil {
 		log.Error("予約バリデーション失敗", zap.Error(validateErr))
 		return nil, validateErr
@@ -142,6 +213,20 @@ func (s *ReservationService) CreateReservation(ctx context.Context, input Create
 	}
 	defer tx.Rollback()
 
+	// 1人あたりの上限は同じユーザーの予約作成をトランザクション内で直列化してから判定する
+	if ev.MaxSeatsPerUser > 0 {
+		held, countErr := s.reservationRepo.CountSeatsHeldByUser(ctx, tx, input.EventID, input.UserID)
+		if countErr != nil {
+			log.Error("保持座席数の取得に失敗", zap.Error(countErr))
+			return nil, countErr
+		}
+		if limitErr := ev.CheckPurchaseLimit(len(input.SeatIDs), held); limitErr != nil {
+			log.Warn("購入枚数の上限を超過", zap.Int("held", held), zap.Error(limitErr))
+			s.recordLimitExceeded()
+			return nil, limitErr
+		}
+	}
+
 	if err := s.reservationRepo.Create(ctx, tx, res); err != nil {
 		log.Error("予約作成に失敗", zap.Error(err))
 		return nil, err
@@ -150,6 +235,10 @@ func (s *ReservationService) CreateReservation(ctx context.Context, input Create
 		log.Error("座席予約に失敗", zap.Error(err))
 		return nil, err
 	}
+	if err := s.recordEvent(ctx, tx, outbox.EventReservationCreated, newReservationEventPayload(res)); err != nil {
+		log.Error("ドメインイベントの記録に失敗", zap.Error(err))
+		return nil, err
+	}
 	if err := tx.Commit(); err != nil {
 		log.Error("コミットに失敗", zap.Error(err))
 		return nil, fmt.Errorf("コミットに失敗: %w", err)
@@ -168,6 +257,176 @@ func (s *ReservationService) CreateReservation(ctx context.Context, input Create
 	return res, nil
 }
 
+// recordLimitExceeded は購入枚数の上限超過をメトリクスに記録する
+func (s *ReservationService) recordLimitExceeded() {
+	if m := metrics.Get(); m != nil {
+		m.ReservationsTotal.WithLabelValues("limit_exceeded").Inc()
+	}
+}
+
+// bestAvailableMaxAttempts は最適座席の予約を競合時に選び直す最大回数
+const bestAvailableMaxAttempts = 3
+
+type BestAvailableInput struct {
+	EventID         string
+	UserID          string
+	Quantity        int
+	PriceCategoryID string
+	Section         string
+	Adjacent        bool
+	IdempotencyKey  string
+	QueueToken      string
+
+	admitted bool
+}
+
+// ReserveBestAvailable は条件に合う最適な座席ブロックを選んで仮押さえする
+// 座席の確保は CreateReservation と同じ分散ロック・ReserveSeats の経路で行い、
+// 選んだ座席が他のユーザーに先に確保された場合は空席を取り直して再選択する
+func (s *ReservationService) ReserveBestAvailable(ctx context.Context, input BestAvailableInput) (*reservation.Reservation, error) {
+	log := logger.With(
+		zap.String("event_id", input.EventID),
+		zap.String("user_id", input.UserID),
+		zap.Int("quantity", input.Quantity),
+	)
+
+	// 冪等性チェック（再試行で別の座席が選ばれないよう、座席選択より前に行う）
+	existing, err := s.reservationRepo.GetByIdempotencyKey(ctx, input.IdempotencyKey)
+	if err == nil {
+		log.Info("冪等性チェック: 既存予約を返却")
+		return existing, nil
+	}
+	if !errors.Is(err, reservation.ErrReservationNotFound) {
+		return nil, fmt.Errorf("冪等性チェックに失敗: %w", err)
+	}
+	if !input.admitted {
+		if err := s.checkAdmission(ctx, input.EventID, input.UserID, input.QueueToken); err != nil {
+			log.Warn("待合室の入場確認に失敗", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	criteria := seat.BlockCriteria{
+		Quantity:        input.Quantity,
+		Section:         input.Section,
+		PriceCategoryID: input.PriceCategoryID,
+		Adjacent:        input.Adjacent,
+	}
+	for attempt := 1; ; attempt++ {
+		seats, err := s.seatRepo.GetByEventID(ctx, input.EventID)
+		if err != nil {
+			return nil, fmt.Errorf("座席取得に失敗: %w", err)
+		}
+		block, err := seat.FindBestBlock(seats, criteria)
+		if err != nil {
+			log.Info("最適座席が見つからない", zap.Error(err))
+			return nil, err
+		}
+		seatIDs := make([]string, len(block))
+		for i, se := range block {
+			seatIDs[i] = se.ID
+		}
+
+		res, err := s.CreateReservation(ctx, CreateReservationInput{
+			EventID:        input.EventID,
+			UserID:         input.UserID,
+			SeatIDs:        seatIDs,
+			IdempotencyKey: input.IdempotencyKey,
+			admitted:       true,
+		})
+		if err == nil {
+			return res, nil
+		}
+		if !errors.Is(err, seat.ErrSeatAlreadyReserved) || attempt >= bestAvailableMaxAttempts {
+			return nil, err
+		}
+		log.Info("選択した座席が競合したため再選択", zap.Int("attempt", attempt))
+	}
+}
+
+// checkAdmission は待合室が有効なイベントで、ユーザーのチケットに入場許可があるかを確認する
+func (s *ReservationService) checkAdmission(ctx context.Context, eventID, userID, token string) error {
+	if s.waitingRoom == nil {
+		return nil
+	}
+	ev, err := s.eventRepo.GetByID(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("イベント取得に失敗: %w", err)
+	}
+	if !ev.WaitingRoomEnabled {
+		return nil
+	}
+	if token == "" {
+		return queue.ErrNotAdmitted
+	}
+	ok, err := s.waitingRoom.IsAdmitted(ctx, eventID, token, userID)
+	if err != nil {
+		return fmt.Errorf("待合室の確認に失敗: %w", err)
+	}
+	if !ok {
+		return queue.ErrNotAdmitted
+	}
+	return nil
+}
+
+// calculateSeatPrices は座席ごとの価格と合計金額を計算する
+// 価格カテゴリが割り当てられた座席はカテゴリの金額を、それ以外は座席の価格を使用する
+func (s *ReservationService) calculateSeatPrices(ctx context.Context, eventID string, seats []*seat.Seat) (map[string]int, int, error) {
+	var categories map[string]*pricecategory.PriceCategory
+	for _, se := range seats {
+		if se.PriceCategoryID != nil && s.categoryRepo != nil {
+			list, err := s.categoryRepo.GetByEventID(ctx, eventID)
+			if err != nil {
+				return nil, 0, fmt.Errorf("価格カテゴリ取得に失敗: %w", err)
+			}
+			categories = make(map[string]*pricecategory.PriceCategory, len(list))
+			for _, c := range list {
+				categories[c.ID] = c
+			}
+			break
+		}
+	}
+
+	prices := make(map[string]int, len(seats))
+	var total int
+	var currency string
+	for _, se := range seats {
+		if categories == nil || se.PriceCategoryID == nil {
+			prices[se.ID] = se.Price
+			total += se.Price
+			continue
+		}
+		c, ok := categories[*se.PriceCategoryID]
+		if !ok {
+			return nil, 0, pricecategory.ErrPriceCategoryNotFound
+		}
+		if currency != "" && currency != c.Currency {
+			return nil, 0, pricecategory.ErrCurrencyMismatch
+		}
+		currency = c.Currency
+		prices[se.ID] = c.Amount
+		total += c.Amount
+	}
+	return prices, total, nil
+}
+
+// holdPolicyOf はイベントの設定から仮押さえポリシーを組み立てる
+// 未設定（ゼロ値）の項目はデフォルト値で補う
+func holdPolicyOf(ev *event.Event) reservation.HoldPolicy {
+	policy := reservation.HoldPolicy{
+		Duration:      ev.HoldDuration,
+		MaxDuration:   ev.MaxHoldDuration,
+		MaxExtensions: ev.MaxHoldExtensions,
+	}
+	if policy.Duration <= 0 {
+		policy.Duration = reservation.ReservationExpiration
+	}
+	if policy.MaxDuration < policy.Duration {
+		policy.MaxDuration = policy.Duration
+	}
+	return policy
+}
+
 // buildSeatLockKey は座席IDからロックキーを生成（ソートしてデッドロック防止）
 func (s *ReservationService) buildSeatLockKey(seatIDs []string) string {
 	sorted := make([]string, len(seatIDs))
@@ -176,38 +435,133 @@ func (s *ReservationService) buildSeatLockKey(seatIDs []string) string {
 	return "seats:" + strings.Join(sorted, ",")
 }
 
-func (s *ReservationService) GetReservation(ctx context.Context, id string) (*reservation.Reservation, error) {
-	return s.reservationRepo.GetByID(ctx, id)
+// GetReservation は呼び出し元が所有する予約を取得する
+func (s *ReservationService) GetReservation(ctx context.Context, id string, principal auth.Principal) (*reservation.Reservation, error) {
+	return s.getOwnedReservation(ctx, id, principal)
 }
 
-func (s *ReservationService) GetUserReservations(ctx context.Context, userID string, limit, offset int) ([]*reservation.Reservation, error) {
-	if limit <= 0 {
-		limit = 20
+// getOwnedReservation は予約を取得し、呼び出し元が所有者（または管理者）であることを確認する
+// 他のユーザーの予約は存在を知られないよう ErrReservationNotFound を返す
+func (s *ReservationService) getOwnedReservation(ctx context.Context, id string, principal auth.Principal) (*reservation.Reservation, error) {
+	return authorizeReservationAccess(ctx, s.reservationRepo, id, principal)
+}
+
+// authorizeReservationAccess は呼び出し元が所有する（または管理者として参照できる）予約を取得する
+// 他のユーザーの予約は存在を知られないよう ErrReservationNotFound を返す
+func authorizeReservationAccess(ctx context.Context, rr reservation.Repository, id string, principal auth.Principal) (*reservation.Reservation, error) {
+	res, err := rr.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !principal.CanAccess(res.UserID) {
+		return nil, reservation.ErrReservationNotFound
 	}
-	return s.reservationRepo.GetByUserID(ctx, userID, limit, offset)
+	return res, nil
 }
 
-func (s *ReservationService) ConfirmReservation(ctx context.Context, id string) (*reservation.Reservation, error) {
-	res, err := s.reservationRepo.GetByID(ctx, id)
+// getOwnedReservationForUpdate は getOwnedReservation と同様に所有者を確認し、予約の行ロックを取得する
+func (s *ReservationService) getOwnedReservationForUpdate(ctx context.Context, tx transaction.Tx, id string, principal auth.Principal) (*reservation.Reservation, error) {
+	res, err := s.reservationRepo.GetForUpdate(ctx, tx, id)
 	if err != nil {
 		return nil, err
 	}
-	if confirmErr := res.Confirm(); confirmErr != nil {
-		return nil, confirmErr
+	if !principal.CanAccess(res.UserID) {
+		return nil, reservation.ErrReservationNotFound
+	}
+	return res, nil
+}
+
+// ListUserReservationsInput はユーザーの予約一覧の絞り込み条件とページの位置
+type ListUserReservationsInput struct {
+	UserID  string
+	Status  reservation.Status // 空の場合は全ての状態
+	EventID string             // 空の場合は全てのイベント
+
+	Cursor string // 前のページの NextCursor（空の場合は先頭ページ）
+	Limit  int
+}
+
+// GetUserReservations はユーザーの予約一覧を作成日時の新しい順に1ページ分取得する
+func (s *ReservationService) GetUserReservations(ctx context.Context, input ListUserReservationsInput) (*pagination.Page[*reservation.Reservation], error) {
+	if input.Status != "" && !input.Status.IsValid() {
+		return nil, reservation.ErrInvalidStatus
+	}
+	after, err := pagination.DecodeCursor(input.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	limit := pagination.NormalizeLimit(input.Limit)
+	// 次のページの有無を判定するため1件多く取得する
+	reservations, err := s.reservationRepo.GetByUserID(ctx, input.UserID, reservation.ListFilter{
+		Status:  input.Status,
+		EventID: input.EventID,
+		After:   after,
+		Limit:   limit + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pagination.NewPage(reservations, limit, func(r *reservation.Reservation) pagination.Cursor {
+		return pagination.Cursor{CreatedAt: r.CreatedAt, ID: r.ID}
+	}), nil
+}
+
+// GetEventReservations はイベントの予約一覧を取得する（イベントの主催者または管理者のみ）
+func (s *ReservationService) GetEventReservations(ctx context.Context, eventID string, principal auth.Principal, limit, offset int) ([]*reservation.Reservation, error) {
+	if _, err := authorizeEventManagement(ctx, s.eventRepo, eventID, principal); err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = 20
 	}
+	return s.reservationRepo.GetByEventID(ctx, eventID, limit, offset)
+}
+
+type ConfirmReservationInput struct {
+	ReservationID string
+	PaymentToken  string         // 決済トークン（決済が有効な場合は必須）
+	Principal     auth.Principal // 呼び出し元（予約の所有者または管理者のみ確定できる）
+}
+
+func (s *ReservationService) ConfirmReservation(ctx context.Context, input ConfirmReservationInput) (*reservation.Reservation, error) {
 	tx, err := s.txManager.Begin(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("トランザクション開始に失敗: %w", err)
 	}
 	defer tx.Rollback()
-	if err := s.seatRepo.ConfirmSeats(ctx, tx, res.SeatIDs); err != nil {
+	// 同じ予約の確定が並行して二重に売上確定しないよう、決済の前に予約の行をロックする
+	// ロックは決済プロバイダーの呼び出しをまたいでコミットまで保持する
+	res, err := s.getOwnedReservationForUpdate(ctx, tx, input.ReservationID, input.Principal)
+	if err != nil {
 		return nil, err
 	}
-	if err := s.reservationRepo.Update(ctx, tx, res); err != nil {
-		return nil, err
+	if confirmErr := res.Confirm(); confirmErr != nil {
+		return nil, confirmErr
 	}
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("コミットに失敗: %w", err)
+	// 削除・中止されたイベントの仮押さえは決済しない
+	ev, err := s.eventRepo.GetByID(ctx, res.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("イベント取得に失敗: %w", err)
+	}
+	if ev.Status == event.StatusCancelled {
+		return nil, event.ErrEventCancelled
+	}
+
+	// 売上確定まで完了してから座席を確定する
+	var pay *payment.Payment
+	if s.paymentGateway != nil {
+		pay, err = s.capturePayment(ctx, res, input.PaymentToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.commitConfirmation(ctx, tx, res, pay); err != nil {
+		if pay != nil {
+			// 座席を確定できなかったため、売上確定済みの決済を返金する
+			s.refundPayment(ctx, pay)
+		}
+		return nil, err
 	}
 
 	// メトリクス記録: 予約確定
@@ -216,11 +570,169 @@ func (s *ReservationService) ConfirmReservation(ctx context.Context, id string)
 		m.ActiveReservations.WithLabelValues("confirmed").Inc()
 	}
 
+	s.updateWaitlistOffer(ctx, res, (*waitlist.Entry).Accept)
+
 	return res, nil
 }
 
-func (s *ReservationService) CancelReservation(ctx context.Context, id string) (*reservation.Reservation, error) {
-	res, err := s.reservationRepo.GetByID(ctx, id)
+// commitConfirmation は座席・予約・決済の確定を予約の行ロックを取得したトランザクションで記録する
+func (s *ReservationService) commitConfirmation(ctx context.Context, tx transaction.Tx, res *reservation.Reservation, pay *payment.Payment) error {
+	if err := s.seatRepo.ConfirmSeats(ctx, tx, res.SeatIDs); err != nil {
+		return err
+	}
+	if err := s.reservationRepo.Update(ctx, tx, res); err != nil {
+		return err
+	}
+	if err := s.recordEvent(ctx, tx, outbox.EventReservationConfirmed, newReservationEventPayload(res)); err != nil {
+		return err
+	}
+	if pay != nil {
+		if err := s.paymentRepo.UpdateTx(ctx, tx, pay); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("コミットに失敗: %w", err)
+	}
+	return nil
+}
+
+// capturePayment は予約金額のオーソリと売上確定を行う
+// 決済の試行ごとに決済レコードを作成し、失敗時は理由を記録する
+// 売上確定の状態は予約の確定と同じトランザクションで保存するため、ここでは永続化しない
+func (s *ReservationService) capturePayment(ctx context.Context, res *reservation.Reservation, token string) (*payment.Payment, error) {
+	if token == "" {
+		return nil, payment.ErrPaymentMethodRequired
+	}
+	log := logger.With(zap.String("reservation_id", res.ID), zap.Int("amount", res.TotalAmount))
+
+	pay := payment.NewPayment(res.ID, s.paymentGateway.Name(), res.TotalAmount)
+	if err := pay.Validate(); err != nil {
+		return nil, err
+	}
+	// 予約の行ロック下で数えるため、同じ試行を二重に送っても同じ冪等性キーになる
+	attempts, err := s.paymentRepo.CountByReservationID(ctx, res.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.paymentRepo.Create(ctx, pay); err != nil {
+		return nil, err
+	}
+
+	providerID, err := s.paymentGateway.Authorize(ctx, payment.AuthorizeRequest{
+		ReservationID:  res.ID,
+		Amount:         pay.Amount,
+		Currency:       pay.Currency,
+		PaymentToken:   token,
+		IdempotencyKey: authorizeIdempotencyKey(res.ID, attempts+1),
+	})
+	if err != nil {
+		return nil, s.failPayment(ctx, pay, err)
+	}
+	if err := pay.Authorize(providerID); err != nil {
+		return nil, err
+	}
+	if err := s.paymentRepo.Update(ctx, pay); err != nil {
+		log.Warn("オーソリ結果の保存に失敗", zap.String("provider_payment_id", providerID), zap.Error(err))
+	}
+
+	if err := s.paymentGateway.Capture(ctx, providerID, pay.Amount); err != nil {
+		return nil, s.failPayment(ctx, pay, err)
+	}
+	if err := pay.Capture(); err != nil {
+		return nil, err
+	}
+	log.Info("決済の売上確定", zap.String("payment_id", pay.ID), zap.String("provider_payment_id", providerID))
+	return pay, nil
+}
+
+// authorizeIdempotencyKey は予約の attempt 回目のオーソリに使う冪等性キーを返す
+// 拒否された後に別の決済手段で再試行できるよう、試行ごとに異なるキーにする
+func authorizeIdempotencyKey(reservationID string, attempt int) string {
+	return fmt.Sprintf("reservation:%s:authorize:%d", reservationID, attempt)
+}
+
+// failPayment は決済の失敗を記録し、呼び出し元に返すエラーを返す
+func (s *ReservationService) failPayment(ctx context.Context, pay *payment.Payment, cause error) error {
+	if errors.Is(cause, context.DeadlineExceeded) {
+		cause = payment.ErrPaymentTimeout
+	}
+	logger.Warn("決済に失敗",
+		zap.String("reservation_id", pay.ReservationID),
+		zap.String("payment_id", pay.ID),
+		zap.Error(cause),
+	)
+	if err := pay.Fail(cause.Error()); err == nil {
+		if err := s.paymentRepo.Update(ctx, pay); err != nil {
+			logger.Error("決済失敗の記録に失敗", zap.String("payment_id", pay.ID), zap.Error(err))
+		}
+	}
+	return cause
+}
+
+// refundPayment は売上確定済みの決済を全額返金する（補償処理のためエラーはログのみ）
+func (s *ReservationService) refundPayment(ctx context.Context, pay *payment.Payment) {
+	log := logger.With(zap.String("reservation_id", pay.ReservationID), zap.String("payment_id", pay.ID))
+	if err := s.paymentGateway.Refund(ctx, payment.RefundRequest{
+		ProviderPaymentID: pay.ProviderPaymentID,
+		Amount:            pay.Amount,
+		IdempotencyKey:    "payment:" + pay.ID + ":compensation",
+	}); err != nil {
+		log.Error("補償返金に失敗", zap.Error(err))
+		return
+	}
+	if err := pay.Refund(pay.Amount); err != nil {
+		log.Error("補償返金の記録に失敗", zap.Error(err))
+		return
+	}
+	if err := s.paymentRepo.Update(ctx, pay); err != nil {
+		log.Error("補償返金の記録に失敗", zap.Error(err))
+		return
+	}
+	log.Warn("予約を確定できなかったため決済を返金")
+}
+
+// ExtendReservation は保留中予約の仮押さえ期間を延長する
+// 予約の所有者または管理者のみ延長できる
+func (s *ReservationService) ExtendReservation(ctx context.Context, id string, principal auth.Principal) (*reservation.Reservation, error) {
+	res, err := s.getOwnedReservation(ctx, id, principal)
+	if err != nil {
+		return nil, err
+	}
+	ev, err := s.eventRepo.GetByID(ctx, res.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("イベント取得に失敗: %w", err)
+	}
+	if !ev.IsBookingOpen() {
+		return nil, event.ErrEventNotOpen
+	}
+	if extendErr := res.Extend(holdPolicyOf(ev)); extendErr != nil {
+		return nil, extendErr
+	}
+	tx, err := s.txManager.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("トランザクション開始に失敗: %w", err)
+	}
+	defer tx.Rollback()
+	// 読み取り後に期限切れ・キャンセルされた予約を保留中に戻さないよう、保留中のままの場合だけ更新する
+	if err := s.reservationRepo.UpdateFromStatus(ctx, tx, res, reservation.StatusPending); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("コミットに失敗: %w", err)
+	}
+
+	logger.Info("仮押さえを延長",
+		zap.String("reservation_id", res.ID),
+		zap.Time("expires_at", res.ExpiresAt),
+		zap.Int("extension_count", res.ExtensionCount),
+	)
+	return res, nil
+}
+
+// CancelReservation は呼び出し元が所有する保留中の予約をキャンセルし、座席を解放する
+func (s *ReservationService) CancelReservation(ctx context.Context, id string, principal auth.Principal) (*reservation.Reservation, error) {
+	res, err := s.getOwnedReservation(ctx, id, principal)
 	if err != nil {
 		return nil, err
 	}
@@ -232,10 +744,15 @@ func (s *ReservationService) CancelReservation(ctx context.Context, id string) (
 		return nil, fmt.Errorf("トランザクション開始に失敗: %w", err)
 	}
 	defer tx.Rollback()
-	if err := s.seatRepo.ReleaseSeats(ctx, tx, res.SeatIDs); err != nil {
+	// 確定処理が行ロックを保持している間に読み取った予約を上書きしないよう、保留中のままの場合だけ更新する
+	// 予約の行を座席より先に更新し、確定処理とロックの取得順を揃える
+	if err := s.reservationRepo.UpdateFromStatus(ctx, tx, res, reservation.StatusPending); err != nil {
 		return nil, err
 	}
-	if err := s.reservationRepo.Update(ctx, tx, res); err != nil {
+	if err := s.seatRepo.ReleaseSeats(ctx, tx, res.ID, res.SeatIDs); err != nil {
+		return nil, err
+	}
+	if err := s.recordEvent(ctx, tx, outbox.EventReservationCancelled, newReservationEventPayload(res)); err != nil {
 		return nil, err
 	}
 	if err := tx.Commit(); err != nil {
@@ -250,9 +767,290 @@ func (s *ReservationService) CancelReservation(ctx context.Context, id string) (
 	// キャッシュ無効化
 	s.invalidateSeatCache(ctx, res.EventID)
 
+	// 解放された座席を順番待ちユーザーにオファー
+	s.updateWaitlistOffer(ctx, res, (*waitlist.Entry).Cancel)
+	s.offerWaitlist(ctx, res.EventID)
+
+	return res, nil
+}
+
+// RefundReservation は確定済み予約をイベントの返金ポリシーに従って返金し、座席を解放する
+// 予約の所有者または管理者のみ返金できる
+func (s *ReservationService) RefundReservation(ctx context.Context, id string, principal auth.Principal) (*reservation.Reservation, error) {
+	tx, err := s.txManager.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("トランザクション開始に失敗: %w", err)
+	}
+	defer tx.Rollback()
+	// 同じ予約の返金が並行して二重に払い戻さないよう、返金の前に予約の行をロックする
+	res, err := s.getOwnedReservationForUpdate(ctx, tx, id, principal)
+	if err != nil {
+		return nil, err
+	}
+	ev, err := s.eventRepo.GetByID(ctx, res.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("イベント取得に失敗: %w", err)
+	}
+	// 座席の取り外しで返金済みの額を除き、今回の返金額だけをプロバイダーで返金する
+	alreadyRefunded := res.RefundedAmount
+	if refundErr := res.Refund(refundPolicyOf(ev), ev.StartAt); refundErr != nil {
+		return nil, refundErr
+	}
+	amount := res.RefundedAmount - alreadyRefunded
+
+	// 決済プロバイダーでの返金が完了してから座席を解放する
+	var pay *payment.Payment
+	if s.paymentGateway != nil {
+		pay, err = s.refundCapturedPayment(ctx, res.ID, amount, refundIdempotencyKey(res.ID, "refund"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.commitRefund(ctx, tx, res, pay); err != nil {
+		if pay != nil {
+			// プロバイダー側は返金済みのため、手動での照合が必要
+			logger.Error("返金済み決済の記録に失敗",
+				zap.String("reservation_id", res.ID),
+				zap.String("payment_id", pay.ID),
+				zap.Int("refunded_amount", amount),
+				zap.Error(err),
+			)
+		}
+		return nil, err
+	}
+
+	logger.Info("予約を返金",
+		zap.String("reservation_id", res.ID),
+		zap.Int("total_amount", res.TotalAmount),
+		zap.Int("refunded_amount", res.RefundedAmount),
+	)
+
+	// メトリクス記録: 確定済み予約の返金
+	if m := metrics.Get(); m != nil {
+		m.ActiveReservations.WithLabelValues("confirmed").Dec()
+	}
+
+	s.invalidateSeatCache(ctx, res.EventID)
+
+	// 解放された座席を順番待ちユーザーにオファー
+	s.offerWaitlist(ctx, res.EventID)
+
+	return res, nil
+}
+
+// commitRefund は座席の解放と予約・決済の返金を予約の行ロックを取得したトランザクションで記録する
+func (s *ReservationService) commitRefund(ctx context.Context, tx transaction.Tx, res *reservation.Reservation, pay *payment.Payment) error {
+	if err := s.seatRepo.ReleaseSeats(ctx, tx, res.ID, res.SeatIDs); err != nil {
+		return err
+	}
+	if err := s.reservationRepo.Update(ctx, tx, res); err != nil {
+		return err
+	}
+	if err := s.recordEvent(ctx, tx, outbox.EventReservationRefunded, newReservationEventPayload(res)); err != nil {
+		return err
+	}
+	if pay != nil {
+		if err := s.paymentRepo.UpdateTx(ctx, tx, pay); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("コミットに失敗: %w", err)
+	}
+	return nil
+}
+
+// refundCapturedPayment は予約の売上確定済み決済から amount を払い戻す
+// 返金額が0の場合や決済レコードがない場合は何もしない
+// idempotencyKey は返金操作ごとに固定し、プロバイダーへの再送で二重に払い戻さないようにする
+func (s *ReservationService) refundCapturedPayment(ctx context.Context, reservationID string, amount int, idempotencyKey string) (*payment.Payment, error) {
+	if amount == 0 {
+		return nil, nil
+	}
+	pay, err := s.paymentRepo.GetByReservationID(ctx, reservationID)
+	if err != nil {
+		if errors.Is(err, payment.ErrPaymentNotFound) {
+			logger.Warn("返金対象の決済が見つかりません", zap.String("reservation_id", reservationID))
+			return nil, nil
+		}
+		return nil, err
+	}
+	// 座席の取り外しで一部返金済みの決済も残額の範囲で返金できる
+	if pay.Status != payment.StatusCaptured && pay.Status != payment.StatusRefunded {
+		return nil, payment.ErrInvalidStatusTransition
+	}
+	if amount > pay.Amount-pay.RefundedAmount {
+		return nil, payment.ErrInvalidAmount
+	}
+	if err := s.paymentGateway.Refund(ctx, payment.RefundRequest{
+		ProviderPaymentID: pay.ProviderPaymentID,
+		Amount:            amount,
+		IdempotencyKey:    idempotencyKey,
+	}); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, payment.ErrPaymentTimeout
+		}
+		return nil, err
+	}
+	if err := pay.Refund(amount); err != nil {
+		return nil, err
+	}
+	return pay, nil
+}
+
+// refundIdempotencyKey は予約の返金操作 op に使う冪等性キーを返す
+func refundIdempotencyKey(reservationID, op string) string {
+	return "reservation:" + reservationID + ":" + op
+}
+
+// refundPolicyOf はイベントの設定から返金ポリシーを組み立てる
+func refundPolicyOf(ev *event.Event) reservation.RefundPolicy {
+	return reservation.RefundPolicy{
+		FullRefundBefore: ev.FullRefundBefore,
+		RefundCutoff:     ev.RefundCutoff,
+		PartialPercent:   ev.PartialRefundPercent,
+	}
+}
+
+type RemoveSeatsInput struct {
+	ReservationID string
+	SeatIDs       []string
+	Principal     auth.Principal // 呼び出し元（予約の所有者または管理者のみ座席を外せる）
+}
+
+// RemoveSeats は予約から指定した座席だけを外して解放し、外した座席の予約時点の価格を合計金額から差し引く
+// 確定済みの予約で決済が有効な場合は、減額分をプロバイダーで返金する
+func (s *ReservationService) RemoveSeats(ctx context.Context, input RemoveSeatsInput) (*reservation.Reservation, error) {
+	tx, err := s.txManager.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("トランザクション開始に失敗: %w", err)
+	}
+	defer tx.Rollback()
+	// 同じ座席の取り外しが並行して二重に返金しないよう、返金の前に予約の行をロックする
+	res, err := s.getOwnedReservationForUpdate(ctx, tx, input.ReservationID, input.Principal)
+	if err != nil {
+		return nil, err
+	}
+	ev, err := s.eventRepo.GetByID(ctx, res.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("イベント取得に失敗: %w", err)
+	}
+	// 確定済みの予約から外した座席は、予約全体の返金と同じ返金ポリシーで返金する
+	removed, refund, err := res.RemoveSeats(input.SeatIDs, refundPolicyOf(ev), ev.StartAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var pay *payment.Payment
+	if s.paymentGateway != nil && refund > 0 {
+		// 外す座席が同じ要求の再送は同じ冪等性キーになる
+		key := refundIdempotencyKey(res.ID, "remove-"+s.buildSeatLockKey(removed))
+		pay, err = s.refundCapturedPayment(ctx, res.ID, refund, key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.commitSeatRemoval(ctx, tx, res, removed, pay); err != nil {
+		if pay != nil {
+			// プロバイダー側は返金済みのため、手動での照合が必要
+			logger.Error("返金済み決済の記録に失敗",
+				zap.String("reservation_id", res.ID),
+				zap.String("payment_id", pay.ID),
+				zap.Int("refunded_amount", refund),
+				zap.Error(err),
+			)
+		}
+		return nil, err
+	}
+
+	logger.Info("予約から座席を解放",
+		zap.String("reservation_id", res.ID),
+		zap.Strings("removed_seat_ids", removed),
+		zap.Int("total_amount", res.TotalAmount),
+		zap.Int("refunded_amount", refund),
+	)
+
+	s.invalidateSeatCache(ctx, res.EventID)
+
+	// 解放された座席を順番待ちユーザーにオファー
+	s.offerWaitlist(ctx, res.EventID)
+
 	return res, nil
 }
 
+// commitSeatRemoval は座席の解放・関連付けの削除・予約と決済の更新を予約の行ロックを取得したトランザクションで記録する
+func (s *ReservationService) commitSeatRemoval(ctx context.Context, tx transaction.Tx, res *reservation.Reservation, removed []string, pay *payment.Payment) error {
+	if err := s.seatRepo.ReleaseSeats(ctx, tx, res.ID, removed); err != nil {
+		return err
+	}
+	if err := s.reservationRepo.RemoveSeats(ctx, tx, res.ID, removed); err != nil {
+		return err
+	}
+	if err := s.reservationRepo.Update(ctx, tx, res); err != nil {
+		return err
+	}
+	payload := newReservationEventPayload(res)
+	payload.RemovedSeatIDs = removed
+	if err := s.recordEvent(ctx, tx, outbox.EventReservationSeatsRemoved, payload); err != nil {
+		return err
+	}
+	if pay != nil {
+		if err := s.paymentRepo.UpdateTx(ctx, tx, pay); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("コミットに失敗: %w", err)
+	}
+	return nil
+}
+
+// reservationEventPayload はアウトボックスに記録する予約イベントの内容
+type reservationEventPayload struct {
+	ReservationID  string    `json:"reservation_id"`
+	EventID        string    `json:"event_id"`
+	UserID         string    `json:"user_id"`
+	SeatIDs        []string  `json:"seat_ids"`
+	Status         string    `json:"status"`
+	TotalAmount    int       `json:"total_amount"`
+	RefundedAmount int       `json:"refunded_amount,omitempty"`
+	RemovedSeatIDs []string  `json:"removed_seat_ids,omitempty"`
+	OccurredAt     time.Time `json:"occurred_at"`
+}
+
+func newReservationEventPayload(res *reservation.Reservation) reservationEventPayload {
+	return reservationEventPayload{
+		ReservationID:  res.ID,
+		EventID:        res.EventID,
+		UserID:         res.UserID,
+		SeatIDs:        res.SeatIDs,
+		Status:         string(res.Status),
+		TotalAmount:    res.TotalAmount,
+		RefundedAmount: res.RefundedAmount,
+		OccurredAt:     res.UpdatedAt,
+	}
+}
+
+// recordEvent は予約のドメインイベントをトランザクション内でアウトボックスに記録する
+// 予約の行を更新した後に呼び出し、同じ予約のイベントがコミット順に採番されるようにする
+func (s *ReservationService) recordEvent(ctx context.Context, tx transaction.Tx, eventType string, payload reservationEventPayload) error {
+	return appendReservationEvent(ctx, s.outboxRepo, tx, eventType, payload)
+}
+
+// appendReservationEvent は予約のドメインイベントをアウトボックスに記録する（or が nil の場合は何もしない）
+func appendReservationEvent(ctx context.Context, or outbox.Repository, tx transaction.Tx, eventType string, payload reservationEventPayload) error {
+	if or == nil {
+		return nil
+	}
+	msg, err := outbox.NewMessage(outbox.AggregateReservation, payload.ReservationID, eventType, payload)
+	if err != nil {
+		return err
+	}
+	return or.Append(ctx, tx, msg)
+}
+
 // invalidateSeatCache は座席キャッシュを無効化する
 func (s *ReservationService) invalidateSeatCache(ctx context.Context, eventID string) {
 	if s.seatCache != nil {
@@ -270,6 +1068,8 @@ func (s *ReservationService) CancelExpiredReservations(ctx context.Context, expi
 	}
 
 	canceledCount := 0
+	var releasedEvents []string
+	released := make(map[string]bool)
 	for _, res := range expired {
 		log := logger.With(
 			zap.String("reservation_id", res.ID),
@@ -288,14 +1088,25 @@ func (s *ReservationService) CancelExpiredReservations(ctx context.Context, expi
 			continue
 		}
 
-		if err := s.seatRepo.ReleaseSeats(ctx, tx, res.SeatIDs); err != nil {
+		// 取得後に確定・キャンセルされた予約は対象外にする
+		if err := s.reservationRepo.UpdateFromStatus(ctx, tx, res, reservation.StatusPending); err != nil {
+			if errors.Is(err, reservation.ErrReservationNotPending) {
+				log.Info("期限切れ予約は既に保留中ではありません")
+			} else {
+				log.Error("予約更新に失敗", zap.Error(err))
+			}
+			_ = tx.Rollback()
+			continue
+		}
+
+		if err := s.seatRepo.ReleaseSeats(ctx, tx, res.ID, res.SeatIDs); err != nil {
 			log.Error("座席解放に失敗", zap.Error(err))
 			_ = tx.Rollback()
 			continue
 		}
 
-		if err := s.reservationRepo.Update(ctx, tx, res); err != nil {
-			log.Error("予約更新に失敗", zap.Error(err))
+		if err := s.recordEvent(ctx, tx, outbox.EventReservationExpired, newReservationEventPayload(res)); err != nil {
+			log.Error("ドメインイベントの記録に失敗", zap.Error(err))
 			_ = tx.Rollback()
 			continue
 		}
@@ -307,7 +1118,129 @@ func (s *ReservationService) CancelExpiredReservations(ctx context.Context, expi
 
 		log.Info("期限切れ予約をキャンセル")
 		canceledCount++
+
+		// オファーの期限切れは次の順番待ちユーザーに回す
+		s.updateWaitlistOffer(ctx, res, (*waitlist.Entry).Lapse)
+		if !released[res.EventID] {
+			released[res.EventID] = true
+			releasedEvents = append(releasedEvents, res.EventID)
+		}
+	}
+
+	for _, eventID := range releasedEvents {
+		s.offerWaitlist(ctx, eventID)
 	}
 
 	return canceledCount, nil
 }
+
+// waitlistOfferKey はオファー予約の冪等性キーを返す
+// エントリごとに固定することで、同じエントリへの二重オファーを防ぐ
+func waitlistOfferKey(entryID string) string {
+	return "waitlist:" + entryID
+}
+
+// updateWaitlistOffer は予約が順番待ちのオファーだった場合にエントリの状態を遷移させる
+func (s *ReservationService) updateWaitlistOffer(ctx context.Context, res *reservation.Reservation, transition func(*waitlist.Entry) error) {
+	if s.waitlistRepo == nil {
+		return
+	}
+	entry, err := s.waitlistRepo.GetByReservationID(ctx, res.ID)
+	if err != nil {
+		if !errors.Is(err, waitlist.ErrEntryNotFound) {
+			logger.Warn("順番待ちエントリ取得に失敗", zap.String("reservation_id", res.ID), zap.Error(err))
+		}
+		return
+	}
+	if err := transition(entry); err != nil {
+		return
+	}
+	if err := s.waitlistRepo.Update(ctx, entry); err != nil {
+		logger.Warn("順番待ちエントリ更新に失敗", zap.String("entry_id", entry.ID), zap.Error(err))
+	}
+}
+
+// offerWaitlist は解放された座席を順番待ちの先頭ユーザーから順に仮押さえ予約としてオファーする
+// 先頭ユーザーの希望枚数に空席が足りない場合は、順番を守るためそこで打ち切る
+// 購入枚数の上限超過など、そのユーザーにはオファーできない場合は順番を飛ばして次のユーザーに進む
+func (s *ReservationService) offerWaitlist(ctx context.Context, eventID string) {
+	if s.waitlistRepo == nil {
+		return
+	}
+	if s.lockManager != nil {
+		lock, err := s.lockManager.AcquireLockWithRetry(ctx, "waitlist:"+eventID, 30*time.Second, 3, 100*time.Millisecond)
+		if err != nil {
+			logger.Warn("順番待ちロック取得に失敗", zap.String("event_id", eventID), zap.Error(err))
+			return
+		}
+		defer func() { _ = lock.Release(ctx) }()
+	}
+
+	for {
+		entry, err := s.waitlistRepo.GetNextWaiting(ctx, eventID)
+		if err != nil {
+			if !errors.Is(err, waitlist.ErrEntryNotFound) {
+				logger.Warn("順番待ちエントリ取得に失敗", zap.String("event_id", eventID), zap.Error(err))
+			}
+			return
+		}
+		log := logger.With(
+			zap.String("entry_id", entry.ID),
+			zap.String("event_id", eventID),
+			zap.String("user_id", entry.UserID),
+		)
+
+		res, err := s.ReserveBestAvailable(ctx, BestAvailableInput{
+			EventID:        eventID,
+			UserID:         entry.UserID,
+			Quantity:       entry.Quantity,
+			IdempotencyKey: waitlistOfferKey(entry.ID),
+			// オファーはユーザーに代わって作成するため待合室を通さない
+			admitted: true,
+		})
+		if err != nil {
+			if !isPermanentOfferError(err) {
+				if errors.Is(err, seat.ErrInsufficientSeats) {
+					log.Info("空席が不足しているためオファーを保留", zap.Int("quantity", entry.Quantity))
+				} else {
+					log.Warn("オファー予約の作成に失敗", zap.Error(err))
+				}
+				return
+			}
+			// 待っても解消しないため、先頭に残すと後続のオファーが止まり続ける
+			if skipErr := entry.Skip(err.Error()); skipErr != nil {
+				return
+			}
+			if updateErr := s.waitlistRepo.Update(ctx, entry); updateErr != nil {
+				log.Error("順番待ちエントリ更新に失敗", zap.Error(updateErr))
+				return
+			}
+			log.Warn("オファーを作成できないため順番を飛ばす", zap.Error(err))
+			continue
+		}
+		if err := entry.Offer(res.ID); err != nil {
+			return
+		}
+		// 以前のオファーが記録前に終了していた場合（冪等性キーで既存予約が返る）は期限切れとして扱う
+		if !res.IsPending() {
+			_ = entry.Lapse()
+		}
+		if err := s.waitlistRepo.Update(ctx, entry); err != nil {
+			log.Error("順番待ちエントリ更新に失敗", zap.Error(err))
+			return
+		}
+		log.Info("順番待ちユーザーに仮押さえをオファー",
+			zap.String("reservation_id", res.ID),
+			zap.Time("expires_at", res.ExpiresAt),
+		)
+	}
+}
+
+// isPermanentOfferError はオファー予約の作成失敗が、座席の解放を待っても解消しないものかを返す
+// 空席不足やロックの競合、DB障害などは次の座席解放で再試行する
+func isPermanentOfferError(err error) bool {
+	return errors.Is(err, event.ErrPurchaseLimitExceeded) ||
+		errors.Is(err, event.ErrEventNotOpen) ||
+		errors.Is(err, event.ErrEventNotFound) ||
+		errors.Is(err, seat.ErrSeatAlreadyReserved)
+}
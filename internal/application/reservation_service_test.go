@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/config"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/postgres"
 	redisinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/redis"
@@ -40,8 +41,8 @@ func setupTestEnv(t *testing.T) (*ReservationService, *SeatService, *EventServic
 	reservationRepo := postgres.NewReservationRepository(db)
 	txManager := postgres.NewTxManager(db)
 
-	eventService := NewEventService(eventRepo)
-	seatService := NewSeatService(seatRepo, eventRepo, nil)
+	eventService := NewEventService(eventRepo, seatRepo, reservationRepo)
+	seatService := NewSeatService(seatRepo, eventRepo, nil, nil)
 	reservationService := NewReservationService(txManager, reservationRepo, seatRepo, eventRepo, lockManager, nil)
 
 	cleanup := func() {
@@ -56,6 +57,15 @@ func setupTestEnv(t *testing.T) (*ReservationService, *SeatService, *EventServic
 	return reservationService, seatService, eventService, cleanup
 }
 
+// openEventSales は作成直後（下書き）のイベントを公開して販売を開始する
+func openEventSales(t *testing.T, ctx context.Context, eventService *EventService, eventID string) {
+	t.Helper()
+	_, err := eventService.PublishEvent(ctx, eventID, testOrganizer)
+	require.NoError(t, err)
+	_, err = eventService.OpenEventSales(ctx, eventID, testOrganizer)
+	require.NoError(t, err)
+}
+
 func TestConcurrentReservation(t *testing.T) {
 	reservationService, seatService, eventService, cleanup := setupTestEnv(t)
 	defer cleanup()
@@ -67,12 +77,14 @@ func TestConcurrentReservation(t *testing.T) {
 		Name: "並行テストイベント", Venue: "テスト会場",
 		StartAt: time.Now().Add(24 * time.Hour), EndAt: time.Now().Add(26 * time.Hour),
 		TotalSeats: 10,
+		Principal:  testOrganizer,
 	})
 	require.NoError(t, err)
+	openEventSales(t, ctx, eventService, ev.ID)
 
 	// 座席を1つだけ作成
 	seats, err := seatService.CreateBulkSeats(ctx, CreateBulkSeatsInput{
-		EventID: ev.ID, Prefix: "TEST", Count: 1, Price: 5000,
+		EventID: ev.ID, Prefix: "TEST", Count: 1, Price: 5000, Principal: testOrganizer,
 	})
 	require.NoError(t, err)
 	require.Len(t, seats, 1)
@@ -119,11 +131,13 @@ func TestIdempotency(t *testing.T) {
 		Name: "冪等性テストイベント", Venue: "テスト会場",
 		StartAt: time.Now().Add(24 * time.Hour), EndAt: time.Now().Add(26 * time.Hour),
 		TotalSeats: 10,
+		Principal:  testOrganizer,
 	})
 	require.NoError(t, err)
+	openEventSales(t, ctx, eventService, ev.ID)
 
 	seats, err := seatService.CreateBulkSeats(ctx, CreateBulkSeatsInput{
-		EventID: ev.ID, Prefix: "IDEM", Count: 2, Price: 5000,
+		EventID: ev.ID, Prefix: "IDEM", Count: 2, Price: 5000, Principal: testOrganizer,
 	})
 	require.NoError(t, err)
 
@@ -154,11 +168,13 @@ func TestSeatAlreadyReserved(t *testing.T) {
 		Name: "座席予約済みテスト", Venue: "テスト会場",
 		StartAt: time.Now().Add(24 * time.Hour), EndAt: time.Now().Add(26 * time.Hour),
 		TotalSeats: 10,
+		Principal:  testOrganizer,
 	})
 	require.NoError(t, err)
+	openEventSales(t, ctx, eventService, ev.ID)
 
 	seats, err := seatService.CreateBulkSeats(ctx, CreateBulkSeatsInput{
-		EventID: ev.ID, Prefix: "RES", Count: 1, Price: 5000,
+		EventID: ev.ID, Prefix: "RES", Count: 1, Price: 5000, Principal: testOrganizer,
 	})
 	require.NoError(t, err)
 
@@ -191,11 +207,13 @@ func TestReservationConfirmAndCancel(t *testing.T) {
 		Name: "確定キャンセルテスト", Venue: "テスト会場",
 		StartAt: time.Now().Add(24 * time.Hour), EndAt: time.Now().Add(26 * time.Hour),
 		TotalSeats: 10,
+		Principal:  testOrganizer,
 	})
 	require.NoError(t, err)
+	openEventSales(t, ctx, eventService, ev.ID)
 
 	seats, err := seatService.CreateBulkSeats(ctx, CreateBulkSeatsInput{
-		EventID: ev.ID, Prefix: "CC", Count: 2, Price: 5000,
+		EventID: ev.ID, Prefix: "CC", Count: 2, Price: 5000, Principal: testOrganizer,
 	})
 	require.NoError(t, err)
 
@@ -207,7 +225,7 @@ func TestReservationConfirmAndCancel(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		confirmed, err := reservationService.ConfirmReservation(ctx, res.ID)
+		confirmed, err := reservationService.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: res.ID, Principal: auth.NewPrincipal(res.UserID)})
 		require.NoError(t, err)
 		assert.Equal(t, "confirmed", string(confirmed.Status))
 	})
@@ -220,7 +238,7 @@ func TestReservationConfirmAndCancel(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		cancelled, err := reservationService.CancelReservation(ctx, res.ID)
+		cancelled, err := reservationService.CancelReservation(ctx, res.ID, auth.NewPrincipal(res.UserID))
 		require.NoError(t, err)
 		assert.Equal(t, "cancelled", string(cancelled.Status))
 
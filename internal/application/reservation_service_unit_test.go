@@ -2,7 +2,9 @@ package application
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,13 +12,24 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/outbox"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pagination"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/payment"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pricecategory"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/queue"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/reservation"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/transaction"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/waitlist"
+	paymentinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/payment"
 	redisinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/redis"
 )
 
+// testOwner はテストで使う予約（UserID: user-1）の所有者
+var testOwner = auth.NewPrincipal("user-1")
+
 // === Mock implementations ===
 
 // MockTxManager implements transaction.Manager
@@ -65,8 +78,24 @@ func (m *MockReservationRepository) GetByID(ctx context.Context, id string) (*re
 	return args.Get(0).(*reservation.Reservation), args.Error(1)
 }
 
-func (m *MockReservationRepository) GetByUserID(ctx context.Context, userID string, limit, offset int) ([]*reservation.Reservation, error) {
-	args := m.Called(ctx, userID, limit, offset)
+func (m *MockReservationRepository) GetForUpdate(ctx context.Context, tx transaction.Tx, id string) (*reservation.Reservation, error) {
+	args := m.Called(ctx, tx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*reservation.Reservation), args.Error(1)
+}
+
+func (m *MockReservationRepository) GetByUserID(ctx context.Context, userID string, filter reservation.ListFilter) ([]*reservation.Reservation, error) {
+	args := m.Called(ctx, userID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*reservation.Reservation), args.Error(1)
+}
+
+func (m *MockReservationRepository) GetByEventID(ctx context.Context, eventID string, limit, offset int) ([]*reservation.Reservation, error) {
+	args := m.Called(ctx, eventID, limit, offset)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -81,11 +110,26 @@ func (m *MockReservationRepository) GetByIdempotencyKey(ctx context.Context, key
 	return args.Get(0).(*reservation.Reservation), args.Error(1)
 }
 
+func (m *MockReservationRepository) CountSeatsHeldByUser(ctx context.Context, tx transaction.Tx, eventID, userID string) (int, error) {
+	args := m.Called(ctx, tx, eventID, userID)
+	return args.Int(0), args.Error(1)
+}
+
 func (m *MockReservationRepository) Update(ctx context.Context, tx transaction.Tx, r *reservation.Reservation) error {
 	args := m.Called(ctx, tx, r)
 	return args.Error(0)
 }
 
+func (m *MockReservationRepository) UpdateFromStatus(ctx context.Context, tx transaction.Tx, r *reservation.Reservation, from reservation.Status) error {
+	args := m.Called(ctx, tx, r, from)
+	return args.Error(0)
+}
+
+func (m *MockReservationRepository) RemoveSeats(ctx context.Context, tx transaction.Tx, reservationID string, seatIDs []string) error {
+	args := m.Called(ctx, tx, reservationID, seatIDs)
+	return args.Error(0)
+}
+
 func (m *MockReservationRepository) Delete(ctx context.Context, id string) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -99,6 +143,19 @@ func (m *MockReservationRepository) GetExpiredPending(ctx context.Context, older
 	return args.Get(0).([]*reservation.Reservation), args.Error(1)
 }
 
+func (m *MockReservationRepository) CountActiveByEventID(ctx context.Context, eventID string) (int, error) {
+	args := m.Called(ctx, eventID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockReservationRepository) LockActiveByEventID(ctx context.Context, tx transaction.Tx, eventID string, limit int) ([]*reservation.Reservation, error) {
+	args := m.Called(ctx, tx, eventID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*reservation.Reservation), args.Error(1)
+}
+
 // MockSeatRepositoryUnit implements seat.Repository for unit tests
 type MockSeatRepositoryUnit struct {
 	mock.Mock
@@ -143,6 +200,27 @@ func (m *MockSeatRepositoryUnit) CountAvailableByEventID(ctx context.Context, ev
 	return args.Int(0), args.Error(1)
 }
 
+func (m *MockSeatRepositoryUnit) CountByEventID(ctx context.Context, eventID string) (int, error) {
+	args := m.Called(ctx, eventID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockSeatRepositoryUnit) GetInventoryByEventID(ctx context.Context, eventID string) (*seat.Inventory, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*seat.Inventory), args.Error(1)
+}
+
+func (m *MockSeatRepositoryUnit) ListDrift(ctx context.Context) ([]*seat.Drift, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*seat.Drift), args.Error(1)
+}
+
 func (m *MockSeatRepositoryUnit) ReserveSeats(ctx context.Context, tx transaction.Tx, ids []string, reservationID string) error {
 	args := m.Called(ctx, tx, ids, reservationID)
 	return args.Error(0)
@@ -153,11 +231,16 @@ func (m *MockSeatRepositoryUnit) ConfirmSeats(ctx context.Context, tx transactio
 	return args.Error(0)
 }
 
-func (m *MockSeatRepositoryUnit) ReleaseSeats(ctx context.Context, tx transaction.Tx, ids []string) error {
-	args := m.Called(ctx, tx, ids)
+func (m *MockSeatRepositoryUnit) ReleaseSeats(ctx context.Context, tx transaction.Tx, reservationID string, ids []string) error {
+	args := m.Called(ctx, tx, reservationID, ids)
 	return args.Error(0)
 }
 
+func (m *MockSeatRepositoryUnit) ReleaseByEventID(ctx context.Context, tx transaction.Tx, eventID string, limit int) (int, error) {
+	args := m.Called(ctx, tx, eventID, limit)
+	return args.Int(0), args.Error(1)
+}
+
 // MockEventRepositoryUnit implements event.Repository for unit tests
 type MockEventRepositoryUnit struct {
 	mock.Mock
@@ -176,14 +259,22 @@ func (m *MockEventRepositoryUnit) GetByID(ctx context.Context, id string) (*even
 	return args.Get(0).(*event.Event), args.Error(1)
 }
 
-func (m *MockEventRepositoryUnit) List(ctx context.Context, limit, offset int) ([]*event.Event, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockEventRepositoryUnit) List(ctx context.Context, filter event.ListFilter) ([]*event.Event, error) {
+	args := m.Called(ctx, filter)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*event.Event), args.Error(1)
 }
 
+func (m *MockEventRepositoryUnit) Search(ctx context.Context, filter event.SearchFilter) ([]*event.SearchResult, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*event.SearchResult), args.Error(1)
+}
+
 func (m *MockEventRepositoryUnit) Update(ctx context.Context, e *event.Event) error {
 	args := m.Called(ctx, e)
 	return args.Error(0)
@@ -252,15 +343,17 @@ func (m *MockSeatCacheUnit) Invalidate(ctx context.Context, eventID string) erro
 
 // === Test helper ===
 type testDeps struct {
-	txManager   *MockTxManager
-	tx          *MockTx
-	resRepo     *MockReservationRepository
-	seatRepo    *MockSeatRepositoryUnit
-	eventRepo   *MockEventRepositoryUnit
-	lockManager *MockLockManager
-	lock        *MockLock
-	seatCache   *MockSeatCacheUnit
-	service     *ReservationService
+	txManager    *MockTxManager
+	tx           *MockTx
+	resRepo      *MockReservationRepository
+	seatRepo     *MockSeatRepositoryUnit
+	eventRepo    *MockEventRepositoryUnit
+	lockManager  *MockLockManager
+	lock         *MockLock
+	seatCache    *MockSeatCacheUnit
+	categoryRepo *MockPriceCategoryRepository
+	waitlistRepo *MockWaitlistRepository
+	service      *ReservationService
 }
 
 func newTestDeps() *testDeps {
@@ -272,22 +365,74 @@ func newTestDeps() *testDeps {
 	lockManager := new(MockLockManager)
 	lock := new(MockLock)
 	seatCache := new(MockSeatCacheUnit)
+	categoryRepo := new(MockPriceCategoryRepository)
 
-	service := NewReservationService(txm, resRepo, seatRepo, eventRepo, lockManager, seatCache)
+	service := NewReservationService(txm, resRepo, seatRepo, eventRepo, lockManager, seatCache,
+		WithPriceCategoryRepository(categoryRepo),
+	)
 
 	return &testDeps{
-		txManager:   txm,
-		tx:          tx,
-		resRepo:     resRepo,
-		seatRepo:    seatRepo,
-		eventRepo:   eventRepo,
-		lockManager: lockManager,
-		lock:        lock,
-		seatCache:   seatCache,
-		service:     service,
+		txManager:    txm,
+		tx:           tx,
+		resRepo:      resRepo,
+		seatRepo:     seatRepo,
+		eventRepo:    eventRepo,
+		lockManager:  lockManager,
+		lock:         lock,
+		seatCache:    seatCache,
+		categoryRepo: categoryRepo,
+		service:      service,
 	}
 }
 
+// expectConfirmableEvent は予約を確定できる（削除・中止されていない）イベントの取得を設定する
+func (d *testDeps) expectConfirmableEvent(ctx context.Context) {
+	d.eventRepo.On("GetByID", ctx, "event-1").Return(&event.Event{
+		ID: "event-1", Status: event.StatusOnSale,
+		StartAt: time.Now().Add(1 * time.Hour), EndAt: time.Now().Add(2 * time.Hour),
+	}, nil)
+}
+
+// enableWaitlist は順番待ちオファーを有効にしたサービスに差し替える
+func (d *testDeps) enableWaitlist() {
+	d.waitlistRepo = new(MockWaitlistRepository)
+	d.service = NewReservationService(d.txManager, d.resRepo, d.seatRepo, d.eventRepo, d.lockManager, d.seatCache,
+		WithPriceCategoryRepository(d.categoryRepo),
+		WithWaitlistRepository(d.waitlistRepo),
+	)
+}
+
+// enableWaitingRoom は待合室による入場制限を有効にしたサービスに差し替える
+func (d *testDeps) enableWaitingRoom() *MockWaitingRoom {
+	room := new(MockWaitingRoom)
+	d.service = NewReservationService(d.txManager, d.resRepo, d.seatRepo, d.eventRepo, d.lockManager, d.seatCache,
+		WithPriceCategoryRepository(d.categoryRepo),
+		WithWaitingRoom(room),
+	)
+	return room
+}
+
+// enableOutbox はドメインイベントの記録を有効にしたサービスに差し替える
+func (d *testDeps) enableOutbox() *MockOutboxRepository {
+	repo := new(MockOutboxRepository)
+	d.service = NewReservationService(d.txManager, d.resRepo, d.seatRepo, d.eventRepo, d.lockManager, d.seatCache,
+		WithPriceCategoryRepository(d.categoryRepo),
+		WithOutbox(repo),
+	)
+	return repo
+}
+
+// enablePayment はフェイクプロバイダーによる決済を有効にしたサービスに差し替える
+func (d *testDeps) enablePayment() (*paymentinfra.FakeProvider, *MockPaymentRepository) {
+	provider := paymentinfra.NewFakeProvider("secret", 10*time.Millisecond)
+	repo := new(MockPaymentRepository)
+	d.service = NewReservationService(d.txManager, d.resRepo, d.seatRepo, d.eventRepo, d.lockManager, d.seatCache,
+		WithPriceCategoryRepository(d.categoryRepo),
+		WithPayment(provider, repo),
+	)
+	return provider, repo
+}
+
 // === Tests ===
 
 func TestReservationService_CreateReservation_Success(t *testing.T) {
@@ -311,6 +456,7 @@ func TestReservationService_CreateReservation_Success(t *testing.T) {
 
 	// IsBookingOpen() returns true when now.Before(StartAt)
 	openEvent := &event.Event{
+		Status:  event.StatusOnSale,
 		ID:      "event-1",
 		Name:    "Test Event",
 		StartAt: time.Now().Add(1 * time.Hour), // Future start = booking open
@@ -342,6 +488,7 @@ func TestReservationService_CreateReservation_Success(t *testing.T) {
 	assert.Equal(t, "event-1", result.EventID)
 	assert.Equal(t, "user-1", result.UserID)
 	assert.Equal(t, 3000, result.TotalAmount)
+	assert.Equal(t, map[string]int{"seat-1": 1000, "seat-2": 2000}, result.SeatPrices)
 	assert.Equal(t, reservation.StatusPending, result.Status)
 
 	deps.txManager.AssertExpectations(t)
@@ -351,6 +498,68 @@ func TestReservationService_CreateReservation_Success(t *testing.T) {
 	deps.lockManager.AssertExpectations(t)
 }
 
+func TestReservationService_CreateReservation_PriceCategory(t *testing.T) {
+	catS, catA := "cat-s", "cat-a"
+	setup := func(deps *testDeps, ctx context.Context, categories []*pricecategory.PriceCategory) {
+		deps.resRepo.On("GetByIdempotencyKey", ctx, "key-1").Return(nil, reservation.ErrReservationNotFound)
+		deps.lockManager.On("AcquireLockWithRetry", ctx, mock.AnythingOfType("string"), 10*time.Second, 3, 100*time.Millisecond).
+			Return(deps.lock, nil)
+		deps.lock.On("Release", ctx).Return(nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(&event.Event{
+			ID: "event-1", StartAt: time.Now().Add(1 * time.Hour), EndAt: time.Now().Add(2 * time.Hour), Status: event.StatusOnSale,
+		}, nil)
+		// 座席の Price は古い金額のまま（カテゴリの金額が優先される）
+		deps.seatRepo.On("GetByEventID", ctx, "event-1").Return([]*seat.Seat{
+			{ID: "seat-1", EventID: "event-1", Status: seat.StatusAvailable, Price: 1, PriceCategoryID: &catS},
+			{ID: "seat-2", EventID: "event-1", Status: seat.StatusAvailable, Price: 1, PriceCategoryID: &catA},
+			{ID: "seat-3", EventID: "event-1", Status: seat.StatusAvailable, Price: 3000},
+		}, nil)
+		deps.categoryRepo.On("GetByEventID", ctx, "event-1").Return(categories, nil)
+	}
+	input := CreateReservationInput{
+		EventID: "event-1", UserID: "user-1", IdempotencyKey: "key-1",
+		SeatIDs: []string{"seat-1", "seat-2", "seat-3"},
+	}
+
+	t.Run("カテゴリの金額から合計金額を計算する", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+		setup(deps, ctx, []*pricecategory.PriceCategory{
+			{ID: catS, EventID: "event-1", Currency: "JPY", Amount: 12000},
+			{ID: catA, EventID: "event-1", Currency: "JPY", Amount: 8000},
+		})
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.resRepo.On("Create", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+		deps.seatRepo.On("ReserveSeats", ctx, deps.tx, input.SeatIDs, mock.AnythingOfType("string")).Return(nil)
+		deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+
+		result, err := deps.service.CreateReservation(ctx, input)
+
+		require.NoError(t, err)
+		assert.Equal(t, 12000+8000+3000, result.TotalAmount)
+		assert.Equal(t, map[string]int{"seat-1": 12000, "seat-2": 8000, "seat-3": 3000}, result.SeatPrices)
+		deps.categoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("通貨が異なるカテゴリの混在はエラー", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+		setup(deps, ctx, []*pricecategory.PriceCategory{
+			{ID: catS, EventID: "event-1", Currency: "JPY", Amount: 12000},
+			{ID: catA, EventID: "event-1", Currency: "USD", Amount: 80},
+		})
+
+		result, err := deps.service.CreateReservation(ctx, input)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, pricecategory.ErrCurrencyMismatch)
+		deps.txManager.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+}
+
 func TestReservationService_CreateReservation_IdempotencyHit(t *testing.T) {
 	deps := newTestDeps()
 	ctx := context.Background()
@@ -382,6 +591,82 @@ func TestReservationService_CreateReservation_IdempotencyHit(t *testing.T) {
 	deps.lockManager.AssertNotCalled(t, "AcquireLockWithRetry")
 }
 
+func TestReservationService_CreateReservation_WaitingRoom(t *testing.T) {
+	waitingRoomEvent := &event.Event{
+		Status:             event.StatusOnSale,
+		ID:                 "event-1",
+		StartAt:            time.Now().Add(1 * time.Hour),
+		EndAt:              time.Now().Add(2 * time.Hour),
+		WaitingRoomEnabled: true,
+	}
+
+	t.Run("トークンがない場合は入場許可なしエラー", func(t *testing.T) {
+		deps := newTestDeps()
+		deps.enableWaitingRoom()
+		ctx := context.Background()
+
+		deps.resRepo.On("GetByIdempotencyKey", ctx, "key-1").Return(nil, reservation.ErrReservationNotFound)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(waitingRoomEvent, nil)
+
+		_, err := deps.service.CreateReservation(ctx, CreateReservationInput{
+			EventID: "event-1", UserID: "user-1", SeatIDs: []string{"seat-1"}, IdempotencyKey: "key-1",
+		})
+
+		assert.ErrorIs(t, err, queue.ErrNotAdmitted)
+		deps.lockManager.AssertNotCalled(t, "AcquireLockWithRetry")
+	})
+
+	t.Run("入場許可のないトークンは拒否される", func(t *testing.T) {
+		deps := newTestDeps()
+		room := deps.enableWaitingRoom()
+		ctx := context.Background()
+
+		deps.resRepo.On("GetByIdempotencyKey", ctx, "key-1").Return(nil, reservation.ErrReservationNotFound)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(waitingRoomEvent, nil)
+		room.On("IsAdmitted", ctx, "event-1", "token-1", "user-1").Return(false, nil)
+
+		_, err := deps.service.CreateReservation(ctx, CreateReservationInput{
+			EventID: "event-1", UserID: "user-1", SeatIDs: []string{"seat-1"},
+			IdempotencyKey: "key-1", QueueToken: "token-1",
+		})
+
+		assert.ErrorIs(t, err, queue.ErrNotAdmitted)
+		deps.lockManager.AssertNotCalled(t, "AcquireLockWithRetry")
+		room.AssertExpectations(t)
+	})
+
+	t.Run("入場許可済みなら予約できる", func(t *testing.T) {
+		deps := newTestDeps()
+		room := deps.enableWaitingRoom()
+		ctx := context.Background()
+
+		deps.resRepo.On("GetByIdempotencyKey", ctx, "key-1").Return(nil, reservation.ErrReservationNotFound)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(waitingRoomEvent, nil)
+		room.On("IsAdmitted", ctx, "event-1", "token-1", "user-1").Return(true, nil)
+		deps.lockManager.On("AcquireLockWithRetry", ctx, mock.AnythingOfType("string"), 10*time.Second, 3, 100*time.Millisecond).
+			Return(deps.lock, nil)
+		deps.lock.On("Release", ctx).Return(nil)
+		deps.seatRepo.On("GetByEventID", ctx, "event-1").Return([]*seat.Seat{
+			{ID: "seat-1", EventID: "event-1", Status: seat.StatusAvailable, Price: 1000},
+		}, nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.resRepo.On("Create", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+		deps.seatRepo.On("ReserveSeats", ctx, deps.tx, []string{"seat-1"}, mock.AnythingOfType("string")).Return(nil)
+		deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+
+		result, err := deps.service.CreateReservation(ctx, CreateReservationInput{
+			EventID: "event-1", UserID: "user-1", SeatIDs: []string{"seat-1"},
+			IdempotencyKey: "key-1", QueueToken: "token-1",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, reservation.StatusPending, result.Status)
+		room.AssertExpectations(t)
+	})
+}
+
 func TestReservationService_CreateReservation_LockFailed(t *testing.T) {
 	deps := newTestDeps()
 	ctx := context.Background()
@@ -405,7 +690,7 @@ func TestReservationService_CreateReservation_LockFailed(t *testing.T) {
 	// Assert
 	require.Error(t, err)
 	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "他のユーザーによって処理中")
+	assert.ErrorIs(t, err, seat.ErrSeatLockContention)
 }
 
 func TestReservationService_CreateReservation_EventNotOpen(t *testing.T) {
@@ -428,6 +713,7 @@ func TestReservationService_CreateReservation_EventNotOpen(t *testing.T) {
 
 	// Event with past start time (IsBookingOpen returns false)
 	closedEvent := &event.Event{
+		Status:  event.StatusOnSale,
 		ID:      "event-1",
 		Name:    "Past Event",
 		StartAt: time.Now().Add(-1 * time.Hour), // Past start = booking closed
@@ -463,6 +749,7 @@ func TestReservationService_CreateReservation_SeatNotFound(t *testing.T) {
 	deps.lock.On("Release", ctx).Return(nil)
 
 	openEvent := &event.Event{
+		Status:  event.StatusOnSale,
 		ID:      "event-1",
 		Name:    "Test Event",
 		StartAt: time.Now().Add(1 * time.Hour),
@@ -504,6 +791,7 @@ func TestReservationService_CreateReservation_SeatAlreadyReserved(t *testing.T)
 	deps.lock.On("Release", ctx).Return(nil)
 
 	openEvent := &event.Event{
+		Status:  event.StatusOnSale,
 		ID:      "event-1",
 		Name:    "Test Event",
 		StartAt: time.Now().Add(1 * time.Hour),
@@ -526,141 +814,1538 @@ func TestReservationService_CreateReservation_SeatAlreadyReserved(t *testing.T)
 	assert.True(t, errors.Is(err, seat.ErrSeatAlreadyReserved))
 }
 
-func TestReservationService_GetReservation(t *testing.T) {
-	deps := newTestDeps()
+func TestReservationService_CreateReservation_PurchaseLimit(t *testing.T) {
 	ctx := context.Background()
-
-	expected := &reservation.Reservation{
-		ID:      "res-1",
-		EventID: "event-1",
-		UserID:  "user-1",
+	input := CreateReservationInput{
+		EventID:        "event-1",
+		UserID:         "user-1",
+		SeatIDs:        []string{"seat-1", "seat-2", "seat-3"},
+		IdempotencyKey: "key-1",
+	}
+	setup := func(ev *event.Event) *testDeps {
+		deps := newTestDeps()
+		deps.resRepo.On("GetByIdempotencyKey", ctx, input.IdempotencyKey).
+			Return(nil, reservation.ErrReservationNotFound)
+		deps.lockManager.On("AcquireLockWithRetry", ctx, mock.AnythingOfType("string"), 10*time.Second, 3, 100*time.Millisecond).
+			Return(deps.lock, nil)
+		deps.lock.On("Release", ctx).Return(nil)
+		ev.ID = "event-1"
+		ev.Status = event.StatusOnSale
+		ev.StartAt = time.Now().Add(1 * time.Hour)
+		ev.EndAt = time.Now().Add(2 * time.Hour)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(ev, nil)
+		return deps
 	}
-	deps.resRepo.On("GetByID", ctx, "res-1").Return(expected, nil)
 
-	result, err := deps.service.GetReservation(ctx, "res-1")
+	t.Run("1回の予約の上限を超える場合はトランザクションを開始しない", func(t *testing.T) {
+		deps := setup(&event.Event{MaxSeatsPerReservation: 2})
 
-	require.NoError(t, err)
-	assert.Equal(t, expected, result)
-}
+		result, err := deps.service.CreateReservation(ctx, input)
 
-func TestReservationService_GetUserReservations(t *testing.T) {
-	deps := newTestDeps()
-	ctx := context.Background()
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, event.ErrPurchaseLimitExceeded)
+		deps.txManager.AssertNotCalled(t, "Begin", mock.Anything)
+	})
 
-	expected := []*reservation.Reservation{
-		{ID: "res-1", UserID: "user-1"},
-		{ID: "res-2", UserID: "user-1"},
-	}
-	deps.resRepo.On("GetByUserID", ctx, "user-1", 20, 0).Return(expected, nil)
+	t.Run("1人あたりの上限はトランザクション内の保持座席数で判定する", func(t *testing.T) {
+		deps := setup(&event.Event{MaxSeatsPerUser: 4})
+		deps.seatRepo.On("GetByEventID", ctx, "event-1").Return([]*seat.Seat{
+			{ID: "seat-1", EventID: "event-1", Status: seat.StatusAvailable, Price: 1000},
+			{ID: "seat-2", EventID: "event-1", Status: seat.StatusAvailable, Price: 1000},
+			{ID: "seat-3", EventID: "event-1", Status: seat.StatusAvailable, Price: 1000},
+		}, nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.resRepo.On("CountSeatsHeldByUser", ctx, deps.tx, "event-1", "user-1").Return(2, nil)
 
-	result, err := deps.service.GetUserReservations(ctx, "user-1", 0, 0)
+		result, err := deps.service.CreateReservation(ctx, input)
 
-	require.NoError(t, err)
-	assert.Len(t, result, 2)
-}
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, event.ErrPurchaseLimitExceeded)
+		deps.resRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+		deps.tx.AssertNotCalled(t, "Commit")
+	})
 
-func TestReservationService_ConfirmReservation_Success(t *testing.T) {
-	deps := newTestDeps()
-	ctx := context.Background()
+	t.Run("上限内であれば予約できる", func(t *testing.T) {
+		deps := setup(&event.Event{MaxSeatsPerReservation: 3, MaxSeatsPerUser: 4})
+		deps.seatRepo.On("GetByEventID", ctx, "event-1").Return([]*seat.Seat{
+			{ID: "seat-1", EventID: "event-1", Status: seat.StatusAvailable, Price: 1000},
+			{ID: "seat-2", EventID: "event-1", Status: seat.StatusAvailable, Price: 1000},
+			{ID: "seat-3", EventID: "event-1", Status: seat.StatusAvailable, Price: 1000},
+		}, nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.resRepo.On("CountSeatsHeldByUser", ctx, deps.tx, "event-1", "user-1").Return(1, nil)
+		deps.resRepo.On("Create", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+		deps.seatRepo.On("ReserveSeats", ctx, deps.tx, input.SeatIDs, mock.AnythingOfType("string")).Return(nil)
+		deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
 
-	res := &reservation.Reservation{
-		ID:        "res-1",
-		EventID:   "event-1",
-		UserID:    "user-1",
-		SeatIDs:   []string{"seat-1"},
-		Status:    reservation.StatusPending,
-		ExpiresAt: time.Now().Add(10 * time.Minute), // Not expired
-	}
-	deps.resRepo.On("GetByID", ctx, "res-1").Return(res, nil)
-	deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
-	deps.tx.On("Rollback").Return(nil)
-	deps.tx.On("Commit").Return(nil)
-	deps.seatRepo.On("ConfirmSeats", ctx, deps.tx, res.SeatIDs).Return(nil)
-	deps.resRepo.On("Update", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+		result, err := deps.service.CreateReservation(ctx, input)
 
-	result, err := deps.service.ConfirmReservation(ctx, "res-1")
+		require.NoError(t, err)
+		assert.Equal(t, 3000, result.TotalAmount)
+		deps.resRepo.AssertExpectations(t)
+	})
+}
 
-	require.NoError(t, err)
-	assert.Equal(t, reservation.StatusConfirmed, result.Status)
+func layoutSeats(statuses ...seat.Status) []*seat.Seat {
+	seats := make([]*seat.Seat, len(statuses))
+	for i, st := range statuses {
+		seats[i] = &seat.Seat{
+			ID: fmt.Sprintf("seat-A%d", i+1), EventID: "event-1", Status: st, Price: 1000,
+			Section: "ARENA", Row: "A", SeatIndex: i + 1,
+		}
+	}
+	return seats
 }
 
-func TestReservationService_ConfirmReservation_NotFound(t *testing.T) {
-	deps := newTestDeps()
+func TestReservationService_ReserveBestAvailable(t *testing.T) {
 	ctx := context.Background()
+	openEvent := &event.Event{
+		Status:  event.StatusOnSale,
+		ID:      "event-1",
+		Name:    "Test Event",
+		StartAt: time.Now().Add(1 * time.Hour),
+		EndAt:   time.Now().Add(2 * time.Hour),
+	}
+	input := BestAvailableInput{
+		EventID:        "event-1",
+		UserID:         "user-1",
+		Quantity:       2,
+		Adjacent:       true,
+		IdempotencyKey: "key-1",
+	}
 
-	deps.resRepo.On("GetByID", ctx, "nonexistent").Return(nil, reservation.ErrReservationNotFound)
+	t.Run("中央の連続した座席を予約する", func(t *testing.T) {
+		deps := newTestDeps()
+		seats := layoutSeats(seat.StatusAvailable, seat.StatusAvailable, seat.StatusAvailable, seat.StatusAvailable)
+
+		deps.resRepo.On("GetByIdempotencyKey", ctx, "key-1").Return(nil, reservation.ErrReservationNotFound)
+		deps.seatRepo.On("GetByEventID", ctx, "event-1").Return(seats, nil)
+		deps.lockManager.On("AcquireLockWithRetry", ctx, "seats:seat-A2,seat-A3", 10*time.Second, 3, 100*time.Millisecond).
+			Return(deps.lock, nil)
+		deps.lock.On("Release", ctx).Return(nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(openEvent, nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.resRepo.On("Create", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+		deps.seatRepo.On("ReserveSeats", ctx, deps.tx, []string{"seat-A2", "seat-A3"}, mock.AnythingOfType("string")).Return(nil)
+		deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
 
-	result, err := deps.service.ConfirmReservation(ctx, "nonexistent")
+		result, err := deps.service.ReserveBestAvailable(ctx, input)
 
-	require.Error(t, err)
-	assert.Nil(t, result)
-	assert.True(t, errors.Is(err, reservation.ErrReservationNotFound))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"seat-A2", "seat-A3"}, result.SeatIDs)
+		assert.Equal(t, 2000, result.TotalAmount)
+		deps.seatRepo.AssertExpectations(t)
+	})
+
+	t.Run("競合した場合は座席を選び直す", func(t *testing.T) {
+		deps := newTestDeps()
+		stale := layoutSeats(seat.StatusAvailable, seat.StatusAvailable, seat.StatusAvailable, seat.StatusAvailable)
+		fresh := layoutSeats(seat.StatusAvailable, seat.StatusReserved, seat.StatusAvailable, seat.StatusAvailable)
+
+		deps.resRepo.On("GetByIdempotencyKey", ctx, "key-1").Return(nil, reservation.ErrReservationNotFound)
+		deps.seatRepo.On("GetByEventID", ctx, "event-1").Return(stale, nil).Once()
+		deps.seatRepo.On("GetByEventID", ctx, "event-1").Return(fresh, nil)
+		deps.lockManager.On("AcquireLockWithRetry", ctx, mock.AnythingOfType("string"), 10*time.Second, 3, 100*time.Millisecond).
+			Return(deps.lock, nil)
+		deps.lock.On("Release", ctx).Return(nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(openEvent, nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.resRepo.On("Create", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+		deps.seatRepo.On("ReserveSeats", ctx, deps.tx, []string{"seat-A3", "seat-A4"}, mock.AnythingOfType("string")).Return(nil)
+		deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+
+		result, err := deps.service.ReserveBestAvailable(ctx, input)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"seat-A3", "seat-A4"}, result.SeatIDs)
+	})
+
+	t.Run("連続した空席がない場合はエラー", func(t *testing.T) {
+		deps := newTestDeps()
+		seats := layoutSeats(seat.StatusAvailable, seat.StatusReserved, seat.StatusAvailable, seat.StatusReserved)
+
+		deps.resRepo.On("GetByIdempotencyKey", ctx, "key-1").Return(nil, reservation.ErrReservationNotFound)
+		deps.seatRepo.On("GetByEventID", ctx, "event-1").Return(seats, nil)
+
+		result, err := deps.service.ReserveBestAvailable(ctx, input)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, seat.ErrNoAdjacentSeats))
+		deps.lockManager.AssertNotCalled(t, "AcquireLockWithRetry")
+	})
+
+	t.Run("冪等性キーが一致する場合は既存予約を返す", func(t *testing.T) {
+		deps := newTestDeps()
+		existing := &reservation.Reservation{ID: "res-1", IdempotencyKey: "key-1"}
+		deps.resRepo.On("GetByIdempotencyKey", ctx, "key-1").Return(existing, nil)
+
+		result, err := deps.service.ReserveBestAvailable(ctx, input)
+
+		require.NoError(t, err)
+		assert.Equal(t, "res-1", result.ID)
+		deps.seatRepo.AssertNotCalled(t, "GetByEventID")
+	})
 }
 
-func TestReservationService_CancelReservation_Success(t *testing.T) {
+func TestReservationService_GetReservation(t *testing.T) {
 	deps := newTestDeps()
 	ctx := context.Background()
 
-	res := &reservation.Reservation{
+	expected := &reservation.Reservation{
 		ID:      "res-1",
 		EventID: "event-1",
 		UserID:  "user-1",
-		SeatIDs: []string{"seat-1"},
-		Status:  reservation.StatusPending,
 	}
-	deps.resRepo.On("GetByID", ctx, "res-1").Return(res, nil)
-	deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
-	deps.tx.On("Rollback").Return(nil)
-	deps.tx.On("Commit").Return(nil)
-	deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, res.SeatIDs).Return(nil)
-	deps.resRepo.On("Update", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
-	deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+	deps.resRepo.On("GetByID", ctx, "res-1").Return(expected, nil)
 
-	result, err := deps.service.CancelReservation(ctx, "res-1")
+	result, err := deps.service.GetReservation(ctx, "res-1", testOwner)
 
 	require.NoError(t, err)
-	assert.Equal(t, reservation.StatusCancelled, result.Status)
+	assert.Equal(t, expected, result)
 }
 
-func TestReservationService_CancelExpiredReservations(t *testing.T) {
-	deps := newTestDeps()
-	ctx := context.Background()
-
-	expired := []*reservation.Reservation{
-		{
-			ID:      "res-1",
-			EventID: "event-1",
-			UserID:  "user-1",
-			SeatIDs: []string{"seat-1"},
-			Status:  reservation.StatusPending,
-		},
-		{
-			ID:      "res-2",
-			EventID: "event-2",
-			UserID:  "user-2",
-			SeatIDs: []string{"seat-2"},
-			Status:  reservation.StatusPending,
-		},
+func TestReservationService_Ownership(t *testing.T) {
+	newPending := func() *reservation.Reservation {
+		return &reservation.Reservation{
+			ID: "res-1", EventID: "event-1", UserID: "user-1", SeatIDs: []string{"seat-1"},
+			Status: reservation.StatusPending, ExpiresAt: time.Now().Add(10 * time.Minute),
+		}
 	}
-	expireAfter := 15 * time.Minute
-
-	deps.resRepo.On("GetExpiredPending", ctx, expireAfter).Return(expired, nil)
+	other := auth.NewPrincipal("user-2")
+
+	t.Run("他のユーザーの予約は見つからない扱いにする", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+		deps.resRepo.On("GetByID", ctx, "res-1").Return(newPending(), nil)
+
+		_, err := deps.service.GetReservation(ctx, "res-1", other)
+		assert.ErrorIs(t, err, reservation.ErrReservationNotFound)
+
+		_, err = deps.service.CancelReservation(ctx, "res-1", other)
+		assert.ErrorIs(t, err, reservation.ErrReservationNotFound)
+
+		deps.txManager.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("他のユーザーの予約は確定しない", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newPending(), nil)
+
+		_, err := deps.service.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: "res-1", Principal: other})
+
+		assert.ErrorIs(t, err, reservation.ErrReservationNotFound)
+		deps.seatRepo.AssertNotCalled(t, "ConfirmSeats", mock.Anything, mock.Anything, mock.Anything)
+		deps.tx.AssertNotCalled(t, "Commit")
+	})
+
+	t.Run("未認証の呼び出し元は見つからない扱いにする", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+		deps.resRepo.On("GetByID", ctx, "res-1").Return(newPending(), nil)
+
+		_, err := deps.service.GetReservation(ctx, "res-1", auth.Principal{})
+		assert.ErrorIs(t, err, reservation.ErrReservationNotFound)
+	})
+
+	t.Run("管理者は他のユーザーの予約をキャンセルできる", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+		deps.resRepo.On("GetByID", ctx, "res-1").Return(newPending(), nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", []string{"seat-1"}).Return(nil)
+		deps.resRepo.On("UpdateFromStatus", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation"), reservation.StatusPending).Return(nil)
+		deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+
+		result, err := deps.service.CancelReservation(ctx, "res-1", auth.NewPrincipal("admin-1", auth.RoleAdmin))
+
+		require.NoError(t, err)
+		assert.Equal(t, reservation.StatusCancelled, result.Status)
+	})
+}
+
+func TestReservationService_GetEventReservations(t *testing.T) {
+	ev := &event.Event{ID: "event-1", OrganizerID: testOrganizer.UserID}
+	reservations := []*reservation.Reservation{{ID: "res-1", EventID: "event-1", UserID: "user-1"}}
+
+	t.Run("主催者はイベントの予約一覧を取得できる", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(ev, nil)
+		deps.resRepo.On("GetByEventID", ctx, "event-1", 20, 0).Return(reservations, nil)
+
+		result, err := deps.service.GetEventReservations(ctx, "event-1", testOrganizer, 0, 0)
+
+		require.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("管理者は任意のイベントの予約一覧を取得できる", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(ev, nil)
+		deps.resRepo.On("GetByEventID", ctx, "event-1", 50, 10).Return(reservations, nil)
+
+		_, err := deps.service.GetEventReservations(ctx, "event-1", auth.NewPrincipal("admin-1", auth.RoleAdmin), 50, 10)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("他の主催者や購入者は取得できない", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(ev, nil)
+
+		_, err := deps.service.GetEventReservations(ctx, "event-1", auth.NewPrincipal("org-2", auth.RoleOrganizer), 0, 0)
+		assert.ErrorIs(t, err, auth.ErrPermissionDenied)
+
+		_, err = deps.service.GetEventReservations(ctx, "event-1", testOwner, 0, 0)
+		assert.ErrorIs(t, err, auth.ErrPermissionDenied)
+
+		deps.resRepo.AssertNotCalled(t, "GetByEventID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestReservationService_GetUserReservations(t *testing.T) {
+	t.Run("先頭ページ", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		expected := []*reservation.Reservation{
+			{ID: "res-1", UserID: "user-1"},
+			{ID: "res-2", UserID: "user-1"},
+		}
+		deps.resRepo.On("GetByUserID", ctx, "user-1", reservation.ListFilter{Limit: 21}).Return(expected, nil)
+
+		result, err := deps.service.GetUserReservations(ctx, ListUserReservationsInput{UserID: "user-1"})
+
+		require.NoError(t, err)
+		assert.Len(t, result.Items, 2)
+		assert.Empty(t, result.NextCursor)
+	})
+
+	t.Run("状態とイベントで絞り込み、続きがある", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		expected := []*reservation.Reservation{
+			{ID: "11111111-1111-1111-1111-111111111111", UserID: "user-1", CreatedAt: created},
+			{ID: "22222222-2222-2222-2222-222222222222", UserID: "user-1", CreatedAt: created},
+		}
+		deps.resRepo.On("GetByUserID", ctx, "user-1", reservation.ListFilter{
+			Status:  reservation.StatusConfirmed,
+			EventID: "event-1",
+			Limit:   2,
+		}).Return(expected, nil)
+
+		result, err := deps.service.GetUserReservations(ctx, ListUserReservationsInput{
+			UserID:  "user-1",
+			Status:  reservation.StatusConfirmed,
+			EventID: "event-1",
+			Limit:   1,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		assert.True(t, result.HasMore())
+		next, err := pagination.DecodeCursor(result.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, "11111111-1111-1111-1111-111111111111", next.ID)
+	})
+
+	t.Run("不正な状態", func(t *testing.T) {
+		deps := newTestDeps()
+
+		_, err := deps.service.GetUserReservations(context.Background(), ListUserReservationsInput{UserID: "user-1", Status: "unknown"})
+
+		assert.ErrorIs(t, err, reservation.ErrInvalidStatus)
+		deps.resRepo.AssertNotCalled(t, "GetByUserID", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("不正なカーソル", func(t *testing.T) {
+		deps := newTestDeps()
+
+		_, err := deps.service.GetUserReservations(context.Background(), ListUserReservationsInput{UserID: "user-1", Cursor: "invalid"})
+
+		assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+	})
+}
+
+func TestReservationService_ConfirmReservation_Success(t *testing.T) {
+	deps := newTestDeps()
+	ctx := context.Background()
+
+	res := &reservation.Reservation{
+		ID:        "res-1",
+		EventID:   "event-1",
+		UserID:    "user-1",
+		SeatIDs:   []string{"seat-1"},
+		Status:    reservation.StatusPending,
+		ExpiresAt: time.Now().Add(10 * time.Minute), // Not expired
+	}
+	deps.expectConfirmableEvent(ctx)
+	deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(res, nil)
+	deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+	deps.tx.On("Rollback").Return(nil)
+	deps.tx.On("Commit").Return(nil)
+	deps.seatRepo.On("ConfirmSeats", ctx, deps.tx, res.SeatIDs).Return(nil)
+	deps.resRepo.On("Update", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+
+	result, err := deps.service.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: "res-1", Principal: testOwner})
+
+	require.NoError(t, err)
+	assert.Equal(t, reservation.StatusConfirmed, result.Status)
+}
+
+func TestReservationService_ConfirmReservation_Payment(t *testing.T) {
+	newPending := func() *reservation.Reservation {
+		return &reservation.Reservation{
+			ID: "res-1", EventID: "event-1", UserID: "user-1",
+			SeatIDs: []string{"seat-1"}, Status: reservation.StatusPending,
+			TotalAmount: 5000, ExpiresAt: time.Now().Add(10 * time.Minute),
+		}
+	}
+
+	t.Run("売上確定後に座席を確定する", func(t *testing.T) {
+		deps := newTestDeps()
+		_, payRepo := deps.enablePayment()
+		ctx := context.Background()
+
+		deps.expectConfirmableEvent(ctx)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newPending(), nil)
+		payRepo.On("CountByReservationID", ctx, "res-1").Return(0, nil)
+		payRepo.On("Create", ctx, mock.AnythingOfType("*payment.Payment")).
+			Run(func(args mock.Arguments) { args.Get(1).(*payment.Payment).ID = "pay-1" }).Return(nil)
+		payRepo.On("Update", ctx, mock.AnythingOfType("*payment.Payment")).Return(nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.seatRepo.On("ConfirmSeats", ctx, deps.tx, []string{"seat-1"}).Return(nil)
+		deps.resRepo.On("Update", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+		payRepo.On("UpdateTx", ctx, deps.tx, mock.MatchedBy(func(p *payment.Payment) bool {
+			return p.Status == payment.StatusCaptured && p.Amount == 5000
+		})).Return(nil)
+
+		result, err := deps.service.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: "res-1", Principal: testOwner, PaymentToken: "tok_visa"})
+
+		require.NoError(t, err)
+		assert.Equal(t, reservation.StatusConfirmed, result.Status)
+		payRepo.AssertExpectations(t)
+		deps.seatRepo.AssertExpectations(t)
+	})
+
+	t.Run("オーソリの冪等性キーは予約IDと試行回数から作る", func(t *testing.T) {
+		deps := newTestDeps()
+		gw := new(MockPaymentGateway)
+		payRepo := new(MockPaymentRepository)
+		deps.service = NewReservationService(deps.txManager, deps.resRepo, deps.seatRepo, deps.eventRepo, deps.lockManager, deps.seatCache,
+			WithPayment(gw, payRepo),
+		)
+		ctx := context.Background()
+
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.expectConfirmableEvent(ctx)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newPending(), nil)
+		// 1回目の試行はカードが拒否された
+		payRepo.On("CountByReservationID", ctx, "res-1").Return(1, nil)
+		payRepo.On("Create", ctx, mock.AnythingOfType("*payment.Payment")).Return(nil)
+		payRepo.On("Update", ctx, mock.AnythingOfType("*payment.Payment")).Return(nil)
+		gw.On("Authorize", ctx, mock.MatchedBy(func(req payment.AuthorizeRequest) bool {
+			return req.IdempotencyKey == "reservation:res-1:authorize:2"
+		})).Return("pp-1", nil)
+		gw.On("Capture", ctx, "pp-1", 5000).Return(payment.ErrPaymentDeclined)
+
+		_, err := deps.service.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: "res-1", Principal: testOwner, PaymentToken: "tok_visa"})
+
+		assert.ErrorIs(t, err, payment.ErrPaymentDeclined)
+		gw.AssertExpectations(t)
+	})
+
+	t.Run("決済トークンがない場合は確定しない", func(t *testing.T) {
+		deps := newTestDeps()
+		_, payRepo := deps.enablePayment()
+		ctx := context.Background()
+
+		deps.expectConfirmableEvent(ctx)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newPending(), nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+
+		_, err := deps.service.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: "res-1", Principal: testOwner})
+
+		assert.ErrorIs(t, err, payment.ErrPaymentMethodRequired)
+		payRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		deps.tx.AssertNotCalled(t, "Commit")
+	})
+
+	for _, tc := range []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{"カードが拒否された場合は座席を確定しない", paymentinfra.FakeTokenDecline, payment.ErrPaymentDeclined},
+		{"プロバイダーがタイムアウトした場合は座席を確定しない", paymentinfra.FakeTokenTimeout, payment.ErrPaymentTimeout},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := newTestDeps()
+			_, payRepo := deps.enablePayment()
+			ctx := context.Background()
+
+			deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+			deps.tx.On("Rollback").Return(nil)
+			deps.expectConfirmableEvent(ctx)
+			deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newPending(), nil)
+			payRepo.On("CountByReservationID", ctx, "res-1").Return(0, nil)
+			payRepo.On("Create", ctx, mock.AnythingOfType("*payment.Payment")).Return(nil)
+			payRepo.On("Update", ctx, mock.MatchedBy(func(p *payment.Payment) bool {
+				return p.Status == payment.StatusFailed && p.FailureReason == tc.err.Error()
+			})).Return(nil)
+
+			_, err := deps.service.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: "res-1", Principal: testOwner, PaymentToken: tc.token})
+
+			assert.ErrorIs(t, err, tc.err)
+			payRepo.AssertExpectations(t)
+			deps.tx.AssertNotCalled(t, "Commit")
+			deps.seatRepo.AssertNotCalled(t, "ConfirmSeats", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("座席の確定に失敗した場合は返金する", func(t *testing.T) {
+		deps := newTestDeps()
+		_, payRepo := deps.enablePayment()
+		ctx := context.Background()
+
+		deps.expectConfirmableEvent(ctx)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newPending(), nil)
+		payRepo.On("CountByReservationID", ctx, "res-1").Return(0, nil)
+		payRepo.On("Create", ctx, mock.AnythingOfType("*payment.Payment")).Return(nil)
+		payRepo.On("Update", ctx, mock.AnythingOfType("*payment.Payment")).Return(nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.seatRepo.On("ConfirmSeats", ctx, deps.tx, []string{"seat-1"}).Return(errors.New("db error"))
+
+		_, err := deps.service.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: "res-1", Principal: testOwner, PaymentToken: "tok_visa"})
+
+		require.Error(t, err)
+		payRepo.AssertCalled(t, "Update", ctx, mock.MatchedBy(func(p *payment.Payment) bool {
+			return p.Status == payment.StatusRefunded
+		}))
+	})
+}
+
+func TestReservationService_ConfirmReservation_AcceptsWaitlistOffer(t *testing.T) {
+	deps := newTestDeps()
+	deps.enableWaitlist()
+	ctx := context.Background()
+
+	res := &reservation.Reservation{
+		ID:        "res-1",
+		EventID:   "event-1",
+		UserID:    "user-1",
+		SeatIDs:   []string{"seat-1"},
+		Status:    reservation.StatusPending,
+		ExpiresAt: time.Now().Add(10 * time.Minute),
+	}
+	entry := &waitlist.Entry{ID: "entry-1", EventID: "event-1", UserID: "user-1", Quantity: 1, Status: waitlist.StatusOffered}
+	deps.expectConfirmableEvent(ctx)
+	deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(res, nil)
+	deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+	deps.tx.On("Rollback").Return(nil)
+	deps.tx.On("Commit").Return(nil)
+	deps.seatRepo.On("ConfirmSeats", ctx, deps.tx, res.SeatIDs).Return(nil)
+	deps.resRepo.On("Update", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+	deps.waitlistRepo.On("GetByReservationID", ctx, "res-1").Return(entry, nil)
+	deps.waitlistRepo.On("Update", ctx, entry).Return(nil)
+
+	_, err := deps.service.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: "res-1", Principal: testOwner})
+
+	require.NoError(t, err)
+	assert.Equal(t, waitlist.StatusAccepted, entry.Status)
+}
+
+func TestReservationService_ConfirmReservation_EventUnavailable(t *testing.T) {
+	newPending := func() *reservation.Reservation {
+		return &reservation.Reservation{
+			ID: "res-1", EventID: "event-1", UserID: "user-1",
+			SeatIDs: []string{"seat-1"}, Status: reservation.StatusPending,
+			TotalAmount: 5000, ExpiresAt: time.Now().Add(10 * time.Minute),
+		}
+	}
+
+	t.Run("中止されたイベントの予約は決済・確定しない", func(t *testing.T) {
+		deps := newTestDeps()
+		_, payRepo := deps.enablePayment()
+		ctx := context.Background()
+
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newPending(), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(&event.Event{ID: "event-1", Status: event.StatusCancelled}, nil)
+
+		_, err := deps.service.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: "res-1", Principal: testOwner, PaymentToken: "tok_visa"})
+
+		assert.ErrorIs(t, err, event.ErrEventCancelled)
+		payRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		deps.seatRepo.AssertNotCalled(t, "ConfirmSeats", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("削除されたイベントの予約は決済・確定しない", func(t *testing.T) {
+		deps := newTestDeps()
+		_, payRepo := deps.enablePayment()
+		ctx := context.Background()
+
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newPending(), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(nil, event.ErrEventNotFound)
+
+		_, err := deps.service.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: "res-1", Principal: testOwner, PaymentToken: "tok_visa"})
+
+		assert.ErrorIs(t, err, event.ErrEventNotFound)
+		payRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		deps.seatRepo.AssertNotCalled(t, "ConfirmSeats", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestReservationService_ConfirmReservation_NotFound(t *testing.T) {
+	deps := newTestDeps()
+	ctx := context.Background()
+
+	deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+	deps.tx.On("Rollback").Return(nil)
+	deps.resRepo.On("GetForUpdate", ctx, deps.tx, "nonexistent").Return(nil, reservation.ErrReservationNotFound)
+
+	result, err := deps.service.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: "nonexistent", Principal: testOwner})
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, reservation.ErrReservationNotFound))
+}
+
+func TestReservationService_ExtendReservation_Success(t *testing.T) {
+	deps := newTestDeps()
+	ctx := context.Background()
+
+	createdAt := time.Now().Add(-10 * time.Minute)
+	res := &reservation.Reservation{
+		ID:        "res-1",
+		EventID:   "event-1",
+		UserID:    "user-1",
+		SeatIDs:   []string{"seat-1"},
+		Status:    reservation.StatusPending,
+		ExpiresAt: createdAt.Add(15 * time.Minute),
+		CreatedAt: createdAt,
+	}
+	openEvent := &event.Event{
+		Status:            event.StatusOnSale,
+		ID:                "event-1",
+		StartAt:           time.Now().Add(1 * time.Hour),
+		EndAt:             time.Now().Add(2 * time.Hour),
+		HoldDuration:      15 * time.Minute,
+		MaxHoldDuration:   45 * time.Minute,
+		MaxHoldExtensions: 2,
+	}
+	deps.resRepo.On("GetByID", ctx, "res-1").Return(res, nil)
+	deps.eventRepo.On("GetByID", ctx, "event-1").Return(openEvent, nil)
+	deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+	deps.tx.On("Rollback").Return(nil)
+	deps.tx.On("Commit").Return(nil)
+	deps.resRepo.On("UpdateFromStatus", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation"), reservation.StatusPending).Return(nil)
+
+	result, err := deps.service.ExtendReservation(ctx, "res-1", testOwner)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.ExtensionCount)
+	assert.Equal(t, createdAt.Add(30*time.Minute), result.ExpiresAt)
+	deps.resRepo.AssertExpectations(t)
+}
+
+func TestReservationService_ExtendReservation_Errors(t *testing.T) {
+	openEvent := func() *event.Event {
+		return &event.Event{
+			Status:            event.StatusOnSale,
+			ID:                "event-1",
+			StartAt:           time.Now().Add(1 * time.Hour),
+			EndAt:             time.Now().Add(2 * time.Hour),
+			HoldDuration:      15 * time.Minute,
+			MaxHoldDuration:   30 * time.Minute,
+			MaxHoldExtensions: 1,
+		}
+	}
+	pending := func(extensions int) *reservation.Reservation {
+		return &reservation.Reservation{
+			ID:             "res-1",
+			EventID:        "event-1",
+			UserID:         "user-1",
+			Status:         reservation.StatusPending,
+			ExpiresAt:      time.Now().Add(5 * time.Minute),
+			ExtensionCount: extensions,
+			CreatedAt:      time.Now().Add(-10 * time.Minute),
+		}
+	}
+
+	t.Run("予約が見つからない", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		deps.resRepo.On("GetByID", ctx, "nonexistent").Return(nil, reservation.ErrReservationNotFound)
+
+		result, err := deps.service.ExtendReservation(ctx, "nonexistent", testOwner)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, reservation.ErrReservationNotFound)
+	})
+
+	t.Run("他のユーザーの予約は延長しない", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		deps.resRepo.On("GetByID", ctx, "res-1").Return(pending(0), nil)
+
+		result, err := deps.service.ExtendReservation(ctx, "res-1", auth.NewPrincipal("user-999"))
+
+		assert.ErrorIs(t, err, reservation.ErrReservationNotFound)
+		assert.Nil(t, result)
+		deps.eventRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+		deps.txManager.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("イベントが予約受付中でない", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		closedEvent := openEvent()
+		closedEvent.StartAt = time.Now().Add(-1 * time.Hour)
+		deps.resRepo.On("GetByID", ctx, "res-1").Return(pending(0), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(closedEvent, nil)
+
+		result, err := deps.service.ExtendReservation(ctx, "res-1", testOwner)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, event.ErrEventNotOpen)
+		deps.txManager.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("延長回数の上限に達している", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		deps.resRepo.On("GetByID", ctx, "res-1").Return(pending(1), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(openEvent(), nil)
+
+		result, err := deps.service.ExtendReservation(ctx, "res-1", testOwner)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, reservation.ErrHoldExtensionLimitReached)
+		deps.txManager.AssertNotCalled(t, "Begin", mock.Anything)
+	})
+
+	t.Run("予約更新失敗", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		deps.resRepo.On("GetByID", ctx, "res-1").Return(pending(0), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(openEvent(), nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.resRepo.On("UpdateFromStatus", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation"), reservation.StatusPending).
+			Return(errors.New("update error"))
+
+		result, err := deps.service.ExtendReservation(ctx, "res-1", testOwner)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		deps.tx.AssertNotCalled(t, "Commit")
+	})
+
+	t.Run("読み取り後に期限切れ・キャンセルされた予約は延長しない", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		deps.resRepo.On("GetByID", ctx, "res-1").Return(pending(0), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(openEvent(), nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.resRepo.On("UpdateFromStatus", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation"), reservation.StatusPending).
+			Return(reservation.ErrReservationNotPending)
+
+		result, err := deps.service.ExtendReservation(ctx, "res-1", testOwner)
+
+		assert.ErrorIs(t, err, reservation.ErrReservationNotPending)
+		assert.Nil(t, result)
+		deps.tx.AssertNotCalled(t, "Commit")
+	})
+}
+
+func TestReservationService_CancelReservation_Success(t *testing.T) {
+	deps := newTestDeps()
+	ctx := context.Background()
+
+	res := &reservation.Reservation{
+		ID:      "res-1",
+		EventID: "event-1",
+		UserID:  "user-1",
+		SeatIDs: []string{"seat-1"},
+		Status:  reservation.StatusPending,
+	}
+	deps.resRepo.On("GetByID", ctx, "res-1").Return(res, nil)
+	deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+	deps.tx.On("Rollback").Return(nil)
+	deps.tx.On("Commit").Return(nil)
+	deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", res.SeatIDs).Return(nil)
+	deps.resRepo.On("UpdateFromStatus", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation"), reservation.StatusPending).Return(nil)
+	deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+
+	result, err := deps.service.CancelReservation(ctx, "res-1", testOwner)
+
+	require.NoError(t, err)
+	assert.Equal(t, reservation.StatusCancelled, result.Status)
+}
+
+func TestReservationService_CancelReservation_OffersToWaitlist(t *testing.T) {
+	deps := newTestDeps()
+	deps.enableWaitlist()
+	ctx := context.Background()
+
+	res := &reservation.Reservation{
+		ID:      "res-1",
+		EventID: "event-1",
+		UserID:  "user-1",
+		SeatIDs: []string{"seat-A1"},
+		Status:  reservation.StatusPending,
+	}
+	deps.resRepo.On("GetByID", ctx, "res-1").Return(res, nil)
+	deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+	deps.tx.On("Rollback").Return(nil)
+	deps.tx.On("Commit").Return(nil)
+	deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", res.SeatIDs).Return(nil)
+	deps.resRepo.On("UpdateFromStatus", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation"), reservation.StatusPending).Return(nil)
+	deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+
+	// キャンセルされた予約自体はオファーではない
+	deps.waitlistRepo.On("GetByReservationID", ctx, "res-1").Return(nil, waitlist.ErrEntryNotFound)
+
+	// 順番待ちの先頭ユーザーにオファー
+	deps.lockManager.On("AcquireLockWithRetry", ctx, "waitlist:event-1", 30*time.Second, 3, 100*time.Millisecond).
+		Return(deps.lock, nil)
+	deps.lockManager.On("AcquireLockWithRetry", ctx, "seats:seat-A1", 10*time.Second, 3, 100*time.Millisecond).
+		Return(deps.lock, nil)
+	deps.lock.On("Release", ctx).Return(nil)
+	entry := &waitlist.Entry{ID: "entry-1", EventID: "event-1", UserID: "user-2", Quantity: 1, Status: waitlist.StatusWaiting}
+	deps.waitlistRepo.On("GetNextWaiting", ctx, "event-1").Return(entry, nil).Once()
+	deps.waitlistRepo.On("GetNextWaiting", ctx, "event-1").Return(nil, waitlist.ErrEntryNotFound)
+	deps.resRepo.On("GetByIdempotencyKey", ctx, "waitlist:entry-1").Return(nil, reservation.ErrReservationNotFound)
+	deps.seatRepo.On("GetByEventID", ctx, "event-1").Return(layoutSeats(seat.StatusAvailable, seat.StatusReserved), nil)
+	deps.eventRepo.On("GetByID", ctx, "event-1").Return(&event.Event{
+		Status:  event.StatusOnSale,
+		ID:      "event-1",
+		StartAt: time.Now().Add(1 * time.Hour),
+		EndAt:   time.Now().Add(2 * time.Hour),
+	}, nil)
+	var offered *reservation.Reservation
+	deps.resRepo.On("Create", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).
+		Run(func(args mock.Arguments) {
+			offered = args.Get(2).(*reservation.Reservation)
+			offered.ID = "res-2"
+		}).Return(nil)
+	deps.seatRepo.On("ReserveSeats", ctx, deps.tx, []string{"seat-A1"}, "res-2").Return(nil)
+	deps.waitlistRepo.On("Update", ctx, entry).Return(nil)
+
+	result, err := deps.service.CancelReservation(ctx, "res-1", testOwner)
+
+	require.NoError(t, err)
+	assert.Equal(t, reservation.StatusCancelled, result.Status)
+	require.NotNil(t, offered)
+	assert.Equal(t, "user-2", offered.UserID)
+	assert.Equal(t, waitlist.StatusOffered, entry.Status)
+	require.NotNil(t, entry.ReservationID)
+	assert.Equal(t, "res-2", *entry.ReservationID)
+	deps.waitlistRepo.AssertExpectations(t)
+}
+
+func TestReservationService_OfferWaitlist_SkipsEntryOverPurchaseLimit(t *testing.T) {
+	deps := newTestDeps()
+	deps.enableWaitlist()
+	ctx := context.Background()
+
+	deps.lockManager.On("AcquireLockWithRetry", ctx, "waitlist:event-1", 30*time.Second, 3, 100*time.Millisecond).
+		Return(deps.lock, nil)
+	deps.lockManager.On("AcquireLockWithRetry", ctx, mock.AnythingOfType("string"), 10*time.Second, 3, 100*time.Millisecond).
+		Return(deps.lock, nil)
+	deps.lock.On("Release", ctx).Return(nil)
+	deps.seatRepo.On("GetByEventID", ctx, "event-1").Return(layoutSeats(seat.StatusAvailable, seat.StatusAvailable), nil)
+	deps.eventRepo.On("GetByID", ctx, "event-1").Return(&event.Event{
+		Status:                 event.StatusOnSale,
+		ID:                     "event-1",
+		StartAt:                time.Now().Add(1 * time.Hour),
+		EndAt:                  time.Now().Add(2 * time.Hour),
+		MaxSeatsPerReservation: 1,
+	}, nil)
+
+	// 先頭ユーザーは1回の予約の上限（1席）を超える2席を希望している
+	head := &waitlist.Entry{ID: "entry-1", EventID: "event-1", UserID: "user-2", Quantity: 2, Status: waitlist.StatusWaiting}
+	next := &waitlist.Entry{ID: "entry-2", EventID: "event-1", UserID: "user-3", Quantity: 1, Status: waitlist.StatusWaiting}
+	deps.waitlistRepo.On("GetNextWaiting", ctx, "event-1").Return(head, nil).Once()
+	deps.waitlistRepo.On("GetNextWaiting", ctx, "event-1").Return(next, nil).Once()
+	deps.waitlistRepo.On("GetNextWaiting", ctx, "event-1").Return(nil, waitlist.ErrEntryNotFound)
+	deps.resRepo.On("GetByIdempotencyKey", ctx, "waitlist:entry-1").Return(nil, reservation.ErrReservationNotFound)
+	deps.resRepo.On("GetByIdempotencyKey", ctx, "waitlist:entry-2").Return(nil, reservation.ErrReservationNotFound)
+	deps.waitlistRepo.On("Update", ctx, head).Return(nil)
+	deps.waitlistRepo.On("Update", ctx, next).Return(nil)
+
+	// 2番目のユーザーにオファー
+	deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+	deps.tx.On("Rollback").Return(nil)
+	deps.tx.On("Commit").Return(nil)
+	var offered *reservation.Reservation
+	deps.resRepo.On("Create", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).
+		Run(func(args mock.Arguments) {
+			offered = args.Get(2).(*reservation.Reservation)
+			offered.ID = "res-2"
+		}).Return(nil)
+	deps.seatRepo.On("ReserveSeats", ctx, deps.tx, []string{"seat-A1"}, "res-2").Return(nil)
+	deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+
+	deps.service.offerWaitlist(ctx, "event-1")
+
+	assert.Equal(t, waitlist.StatusSkipped, head.Status)
+	require.NotNil(t, head.SkipReason)
+	assert.Contains(t, *head.SkipReason, event.ErrPurchaseLimitExceeded.Error())
+	require.NotNil(t, offered)
+	assert.Equal(t, "user-3", offered.UserID)
+	assert.Equal(t, waitlist.StatusOffered, next.Status)
+	deps.waitlistRepo.AssertExpectations(t)
+}
+
+func TestReservationService_RefundReservation(t *testing.T) {
+	newConfirmed := func() *reservation.Reservation {
+		return &reservation.Reservation{
+			ID: "res-1", EventID: "event-1", UserID: "user-1",
+			SeatIDs: []string{"seat-1", "seat-2"}, Status: reservation.StatusConfirmed,
+			TotalAmount: 10000,
+		}
+	}
+	eventStartingIn := func(d time.Duration) *event.Event {
+		return &event.Event{
+			ID: "event-1", StartAt: time.Now().Add(d), EndAt: time.Now().Add(d + 2*time.Hour), Status: event.StatusOnSale,
+			FullRefundBefore: 7 * 24 * time.Hour, PartialRefundPercent: 50, RefundCutoff: 24 * time.Hour,
+		}
+	}
+
+	for _, tc := range []struct {
+		name   string
+		until  time.Duration
+		amount int
+	}{
+		{"全額返金期間は全額を返金して座席を解放する", 10 * 24 * time.Hour, 10000},
+		{"部分返金期間は一部を返金して座席を解放する", 3 * 24 * time.Hour, 5000},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := newTestDeps()
+			ctx := context.Background()
+
+			deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newConfirmed(), nil)
+			deps.eventRepo.On("GetByID", ctx, "event-1").Return(eventStartingIn(tc.until), nil)
+			deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+			deps.tx.On("Rollback").Return(nil)
+			deps.tx.On("Commit").Return(nil)
+			deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", []string{"seat-1", "seat-2"}).Return(nil)
+			deps.resRepo.On("Update", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+			deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+
+			result, err := deps.service.RefundReservation(ctx, "res-1", testOwner)
+
+			require.NoError(t, err)
+			assert.Equal(t, reservation.StatusRefunded, result.Status)
+			assert.Equal(t, tc.amount, result.RefundedAmount)
+			deps.seatRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("座席の取り外しで返金済みの予約は残りの金額だけをプロバイダーで返金する", func(t *testing.T) {
+		deps := newTestDeps()
+		provider, payRepo := deps.enablePayment()
+		ctx := context.Background()
+
+		// 15000円のうち座席の取り外しで5000円を返金済み
+		providerID, err := provider.Authorize(ctx, payment.AuthorizeRequest{ReservationID: "res-1", Amount: 15000, PaymentToken: "tok_visa"})
+		require.NoError(t, err)
+		require.NoError(t, provider.Capture(ctx, providerID, 15000))
+		require.NoError(t, provider.Refund(ctx, payment.RefundRequest{ProviderPaymentID: providerID, Amount: 5000}))
+		pay := &payment.Payment{ID: "pay-1", ReservationID: "res-1", ProviderPaymentID: providerID, Amount: 15000, RefundedAmount: 5000, Status: payment.StatusRefunded}
+		res := newConfirmed()
+		res.RefundedAmount = 5000
+
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(res, nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(eventStartingIn(10*24*time.Hour), nil)
+		payRepo.On("GetByReservationID", ctx, "res-1").Return(pay, nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", []string{"seat-1", "seat-2"}).Return(nil)
+		deps.resRepo.On("Update", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+		payRepo.On("UpdateTx", ctx, deps.tx, pay).Return(nil)
+		deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+
+		result, err := deps.service.RefundReservation(ctx, "res-1", testOwner)
+
+		require.NoError(t, err)
+		// 予約・決済とも返金済みの合計額を記録する
+		assert.Equal(t, 15000, result.RefundedAmount)
+		assert.Equal(t, 15000, pay.RefundedAmount)
+	})
+
+	t.Run("返金締切後は返金しない", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newConfirmed(), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(eventStartingIn(12*time.Hour), nil)
+
+		_, err := deps.service.RefundReservation(ctx, "res-1", testOwner)
+
+		assert.ErrorIs(t, err, reservation.ErrRefundPeriodEnded)
+		deps.tx.AssertNotCalled(t, "Commit")
+	})
+
+	t.Run("他のユーザーの予約は返金しない", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newConfirmed(), nil)
+
+		_, err := deps.service.RefundReservation(ctx, "res-1", auth.NewPrincipal("user-999"))
+
+		assert.ErrorIs(t, err, reservation.ErrReservationNotFound)
+		deps.eventRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+		deps.tx.AssertNotCalled(t, "Commit")
+	})
+
+	t.Run("管理者は他のユーザーの予約を返金できる", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newConfirmed(), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(eventStartingIn(10*24*time.Hour), nil)
+		deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", []string{"seat-1", "seat-2"}).Return(nil)
+		deps.resRepo.On("Update", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+		deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+
+		result, err := deps.service.RefundReservation(ctx, "res-1", auth.NewPrincipal("admin-1", auth.RoleAdmin))
+
+		require.NoError(t, err)
+		assert.Equal(t, reservation.StatusRefunded, result.Status)
+	})
+
+	t.Run("確定前の予約は返金できない", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		res := newConfirmed()
+		res.Status = reservation.StatusPending
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(res, nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(eventStartingIn(10*24*time.Hour), nil)
+
+		_, err := deps.service.RefundReservation(ctx, "res-1", testOwner)
+
+		assert.ErrorIs(t, err, reservation.ErrReservationNotConfirmed)
+	})
+
+	t.Run("ロック取得後に返金済みだった予約はプロバイダーで返金しない", func(t *testing.T) {
+		deps := newTestDeps()
+		_, payRepo := deps.enablePayment()
+		ctx := context.Background()
+
+		res := newConfirmed()
+		res.Status = reservation.StatusRefunded
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(res, nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(eventStartingIn(10*24*time.Hour), nil)
+
+		_, err := deps.service.RefundReservation(ctx, "res-1", testOwner)
+
+		assert.ErrorIs(t, err, reservation.ErrReservationAlreadyRefunded)
+		payRepo.AssertNotCalled(t, "GetByReservationID", mock.Anything, mock.Anything)
+		deps.seatRepo.AssertNotCalled(t, "ReleaseSeats", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("決済が有効な場合はプロバイダーで返金してから記録する", func(t *testing.T) {
+		deps := newTestDeps()
+		provider, payRepo := deps.enablePayment()
+		ctx := context.Background()
+
+		providerID, err := provider.Authorize(ctx, payment.AuthorizeRequest{ReservationID: "res-1", Amount: 10000, PaymentToken: "tok_visa"})
+		require.NoError(t, err)
+		require.NoError(t, provider.Capture(ctx, providerID, 10000))
+		pay := &payment.Payment{ID: "pay-1", ReservationID: "res-1", ProviderPaymentID: providerID, Amount: 10000, Status: payment.StatusCaptured}
+
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newConfirmed(), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(eventStartingIn(3*24*time.Hour), nil)
+		payRepo.On("GetByReservationID", ctx, "res-1").Return(pay, nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", []string{"seat-1", "seat-2"}).Return(nil)
+		deps.resRepo.On("Update", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+		payRepo.On("UpdateTx", ctx, deps.tx, pay).Return(nil)
+		deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+
+		_, err = deps.service.RefundReservation(ctx, "res-1", testOwner)
+
+		require.NoError(t, err)
+		assert.Equal(t, payment.StatusRefunded, pay.Status)
+		assert.Equal(t, 5000, pay.RefundedAmount)
+		// 同じ返金の再送は二重に払い戻さず、残額のみ追加で返金できる
+		assert.NoError(t, provider.Refund(ctx, payment.RefundRequest{ProviderPaymentID: providerID, Amount: 5000, IdempotencyKey: "reservation:res-1:refund"}))
+		assert.NoError(t, provider.Refund(ctx, payment.RefundRequest{ProviderPaymentID: providerID, Amount: 5000}))
+		assert.ErrorIs(t, provider.Refund(ctx, payment.RefundRequest{ProviderPaymentID: providerID, Amount: 1}), payment.ErrInvalidAmount)
+		payRepo.AssertExpectations(t)
+	})
+
+	t.Run("座席の解放に失敗した場合は返金済みにしない", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newConfirmed(), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(eventStartingIn(10*24*time.Hour), nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", []string{"seat-1", "seat-2"}).Return(errors.New("db error"))
+
+		_, err := deps.service.RefundReservation(ctx, "res-1", testOwner)
+
+		require.Error(t, err)
+		deps.resRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+		deps.tx.AssertNotCalled(t, "Commit")
+	})
+}
+
+func TestReservationService_RemoveSeats(t *testing.T) {
+	newReservation := func(status reservation.Status) *reservation.Reservation {
+		return &reservation.Reservation{
+			ID: "res-1", EventID: "event-1", UserID: "user-1",
+			SeatIDs:    []string{"seat-1", "seat-2", "seat-3"},
+			SeatPrices: map[string]int{"seat-1": 5000, "seat-2": 5000, "seat-3": 8000},
+			Status:     status, TotalAmount: 18000, ExpiresAt: time.Now().Add(10 * time.Minute),
+		}
+	}
+	eventStartingIn := func(d time.Duration) *event.Event {
+		return &event.Event{
+			ID: "event-1", StartAt: time.Now().Add(d), EndAt: time.Now().Add(d + 2*time.Hour), Status: event.StatusOnSale,
+			FullRefundBefore: 7 * 24 * time.Hour, PartialRefundPercent: 50, RefundCutoff: 24 * time.Hour,
+		}
+	}
+
+	t.Run("指定した座席だけを解放して合計金額を再計算する", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newReservation(reservation.StatusPending), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(eventStartingIn(30*24*time.Hour), nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", []string{"seat-3"}).Return(nil)
+		deps.resRepo.On("RemoveSeats", ctx, deps.tx, "res-1", []string{"seat-3"}).Return(nil)
+		deps.resRepo.On("Update", ctx, deps.tx, mock.MatchedBy(func(r *reservation.Reservation) bool {
+			return r.TotalAmount == 10000
+		})).Return(nil)
+		deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+
+		result, err := deps.service.RemoveSeats(ctx, RemoveSeatsInput{ReservationID: "res-1", SeatIDs: []string{"seat-3"}, Principal: testOwner})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"seat-1", "seat-2"}, result.SeatIDs)
+		assert.Equal(t, 10000, result.TotalAmount)
+		assert.Equal(t, reservation.StatusPending, result.Status)
+		deps.resRepo.AssertExpectations(t)
+		deps.seatRepo.AssertExpectations(t)
+	})
+
+	t.Run("確定済みの予約は減額分を返金する", func(t *testing.T) {
+		deps := newTestDeps()
+		provider, payRepo := deps.enablePayment()
+		ctx := context.Background()
+
+		providerID, err := provider.Authorize(ctx, payment.AuthorizeRequest{ReservationID: "res-1", Amount: 18000, PaymentToken: "tok_visa"})
+		require.NoError(t, err)
+		require.NoError(t, provider.Capture(ctx, providerID, 18000))
+		pay := &payment.Payment{ID: "pay-1", ReservationID: "res-1", ProviderPaymentID: providerID, Amount: 18000, Status: payment.StatusCaptured}
+
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newReservation(reservation.StatusConfirmed), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(eventStartingIn(30*24*time.Hour), nil)
+		payRepo.On("GetByReservationID", ctx, "res-1").Return(pay, nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", []string{"seat-1"}).Return(nil)
+		deps.resRepo.On("RemoveSeats", ctx, deps.tx, "res-1", []string{"seat-1"}).Return(nil)
+		deps.resRepo.On("Update", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+		payRepo.On("UpdateTx", ctx, deps.tx, pay).Return(nil)
+		deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+
+		result, err := deps.service.RemoveSeats(ctx, RemoveSeatsInput{ReservationID: "res-1", SeatIDs: []string{"seat-1"}, Principal: testOwner})
+
+		require.NoError(t, err)
+		assert.Equal(t, 13000, result.TotalAmount)
+		assert.Equal(t, 5000, result.RefundedAmount)
+		assert.Equal(t, 5000, pay.RefundedAmount)
+		// プロバイダー側の返金可能額も減っている
+		assert.ErrorIs(t, provider.Refund(ctx, payment.RefundRequest{ProviderPaymentID: providerID, Amount: 13001}), payment.ErrInvalidAmount)
+		payRepo.AssertExpectations(t)
+	})
+
+	t.Run("一部返金の期間は外した座席の価格を返金ポリシーに従って返金する", func(t *testing.T) {
+		deps := newTestDeps()
+		provider, payRepo := deps.enablePayment()
+		ctx := context.Background()
+
+		providerID, err := provider.Authorize(ctx, payment.AuthorizeRequest{ReservationID: "res-1", Amount: 18000, PaymentToken: "tok_visa"})
+		require.NoError(t, err)
+		require.NoError(t, provider.Capture(ctx, providerID, 18000))
+		pay := &payment.Payment{ID: "pay-1", ReservationID: "res-1", ProviderPaymentID: providerID, Amount: 18000, Status: payment.StatusCaptured}
+
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newReservation(reservation.StatusConfirmed), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(eventStartingIn(3*24*time.Hour), nil)
+		payRepo.On("GetByReservationID", ctx, "res-1").Return(pay, nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", []string{"seat-3"}).Return(nil)
+		deps.resRepo.On("RemoveSeats", ctx, deps.tx, "res-1", []string{"seat-3"}).Return(nil)
+		deps.resRepo.On("Update", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+		payRepo.On("UpdateTx", ctx, deps.tx, pay).Return(nil)
+		deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+
+		result, err := deps.service.RemoveSeats(ctx, RemoveSeatsInput{ReservationID: "res-1", SeatIDs: []string{"seat-3"}, Principal: testOwner})
+
+		require.NoError(t, err)
+		assert.Equal(t, 10000, result.TotalAmount)
+		assert.Equal(t, 4000, pay.RefundedAmount)
+	})
+
+	t.Run("返金期間を過ぎた確定済みの予約からは座席を外せない", func(t *testing.T) {
+		deps := newTestDeps()
+		_, payRepo := deps.enablePayment()
+		ctx := context.Background()
+
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newReservation(reservation.StatusConfirmed), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(eventStartingIn(12*time.Hour), nil)
+
+		_, err := deps.service.RemoveSeats(ctx, RemoveSeatsInput{ReservationID: "res-1", SeatIDs: []string{"seat-1", "seat-2"}, Principal: testOwner})
+
+		assert.ErrorIs(t, err, reservation.ErrRefundPeriodEnded)
+		payRepo.AssertNotCalled(t, "GetByReservationID", mock.Anything, mock.Anything)
+		deps.seatRepo.AssertNotCalled(t, "ReleaseSeats", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		deps.tx.AssertNotCalled(t, "Commit")
+	})
+
+	t.Run("他のユーザーの予約からは座席を外せない", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newReservation(reservation.StatusConfirmed), nil)
+
+		_, err := deps.service.RemoveSeats(ctx, RemoveSeatsInput{ReservationID: "res-1", SeatIDs: []string{"seat-3"}, Principal: auth.NewPrincipal("user-999")})
+
+		assert.ErrorIs(t, err, reservation.ErrReservationNotFound)
+		deps.seatRepo.AssertNotCalled(t, "ReleaseSeats", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		deps.tx.AssertNotCalled(t, "Commit")
+	})
+
+	t.Run("予約に含まれない座席は外せない", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newReservation(reservation.StatusPending), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(eventStartingIn(30*24*time.Hour), nil)
+
+		_, err := deps.service.RemoveSeats(ctx, RemoveSeatsInput{ReservationID: "res-1", SeatIDs: []string{"seat-9"}, Principal: testOwner})
+
+		assert.ErrorIs(t, err, reservation.ErrSeatNotInReservation)
+		deps.seatRepo.AssertNotCalled(t, "ReleaseSeats", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		deps.tx.AssertNotCalled(t, "Commit")
+	})
+
+	t.Run("予約後に価格が変わっても予約時点の価格だけを返金する", func(t *testing.T) {
+		deps := newTestDeps()
+		provider, payRepo := deps.enablePayment()
+		ctx := context.Background()
+
+		providerID, err := provider.Authorize(ctx, payment.AuthorizeRequest{ReservationID: "res-1", Amount: 18000, PaymentToken: "tok_visa"})
+		require.NoError(t, err)
+		require.NoError(t, provider.Capture(ctx, providerID, 18000))
+		pay := &payment.Payment{ID: "pay-1", ReservationID: "res-1", ProviderPaymentID: providerID, Amount: 18000, Status: payment.StatusCaptured}
+
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newReservation(reservation.StatusConfirmed), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(eventStartingIn(30*24*time.Hour), nil)
+		payRepo.On("GetByReservationID", ctx, "res-1").Return(pay, nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", []string{"seat-3"}).Return(nil)
+		deps.resRepo.On("RemoveSeats", ctx, deps.tx, "res-1", []string{"seat-3"}).Return(nil)
+		deps.resRepo.On("Update", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+		payRepo.On("UpdateTx", ctx, deps.tx, pay).Return(nil)
+		deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+
+		result, err := deps.service.RemoveSeats(ctx, RemoveSeatsInput{ReservationID: "res-1", SeatIDs: []string{"seat-3"}, Principal: testOwner})
+
+		require.NoError(t, err)
+		assert.Equal(t, 10000, result.TotalAmount)
+		assert.Equal(t, 8000, pay.RefundedAmount)
+		// 現在の座席の価格は参照しない
+		deps.seatRepo.AssertNotCalled(t, "GetByEventID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("関連付けの削除に失敗した場合はコミットしない", func(t *testing.T) {
+		deps := newTestDeps()
+		ctx := context.Background()
+
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(newReservation(reservation.StatusPending), nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(eventStartingIn(30*24*time.Hour), nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", []string{"seat-2"}).Return(nil)
+		deps.resRepo.On("RemoveSeats", ctx, deps.tx, "res-1", []string{"seat-2"}).Return(errors.New("db error"))
+
+		_, err := deps.service.RemoveSeats(ctx, RemoveSeatsInput{ReservationID: "res-1", SeatIDs: []string{"seat-2"}, Principal: testOwner})
+
+		require.Error(t, err)
+		deps.tx.AssertNotCalled(t, "Commit")
+		deps.seatCache.AssertNotCalled(t, "Invalidate", mock.Anything, mock.Anything)
+	})
+}
+
+func TestReservationService_RecordsDomainEvents(t *testing.T) {
+	isEvent := func(eventType, reservationID string) interface{} {
+		return mock.MatchedBy(func(m *outbox.Message) bool {
+			return m.EventType == eventType && m.AggregateType == outbox.AggregateReservation && m.AggregateID == reservationID
+		})
+	}
+
+	t.Run("予約作成と同じトランザクションで記録する", func(t *testing.T) {
+		deps := newTestDeps()
+		outboxRepo := deps.enableOutbox()
+		ctx := context.Background()
+
+		deps.resRepo.On("GetByIdempotencyKey", ctx, "key-1").Return(nil, reservation.ErrReservationNotFound)
+		deps.lockManager.On("AcquireLockWithRetry", ctx, "seats:seat-1", 10*time.Second, 3, 100*time.Millisecond).Return(deps.lock, nil)
+		deps.lock.On("Release", ctx).Return(nil)
+		deps.eventRepo.On("GetByID", ctx, "event-1").Return(&event.Event{
+			ID: "event-1", StartAt: time.Now().Add(time.Hour), EndAt: time.Now().Add(2 * time.Hour), Status: event.StatusOnSale,
+		}, nil)
+		deps.seatRepo.On("GetByEventID", ctx, "event-1").Return([]*seat.Seat{
+			{ID: "seat-1", EventID: "event-1", Price: 5000, Status: seat.StatusAvailable},
+		}, nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.resRepo.On("Create", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).
+			Run(func(args mock.Arguments) { args.Get(2).(*reservation.Reservation).ID = "res-1" }).Return(nil)
+		deps.seatRepo.On("ReserveSeats", ctx, deps.tx, []string{"seat-1"}, "res-1").Return(nil)
+		deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
+		var recorded *outbox.Message
+		outboxRepo.On("Append", ctx, deps.tx, isEvent(outbox.EventReservationCreated, "res-1")).
+			Run(func(args mock.Arguments) { recorded = args.Get(2).(*outbox.Message) }).Return(nil)
+
+		_, err := deps.service.CreateReservation(ctx, CreateReservationInput{
+			EventID: "event-1", UserID: "user-1", SeatIDs: []string{"seat-1"}, IdempotencyKey: "key-1",
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, recorded)
+		assert.JSONEq(t, `"pending"`, string(mustField(t, recorded.Payload, "status")))
+		assert.JSONEq(t, `5000`, string(mustField(t, recorded.Payload, "total_amount")))
+	})
+
+	t.Run("記録に失敗した場合は予約を作成しない", func(t *testing.T) {
+		deps := newTestDeps()
+		outboxRepo := deps.enableOutbox()
+		ctx := context.Background()
+
+		deps.resRepo.On("GetByID", ctx, "res-1").Return(&reservation.Reservation{
+			ID: "res-1", EventID: "event-1", UserID: "user-1", SeatIDs: []string{"seat-1"}, Status: reservation.StatusPending,
+		}, nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", []string{"seat-1"}).Return(nil)
+		deps.resRepo.On("UpdateFromStatus", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation"), reservation.StatusPending).Return(nil)
+		outboxRepo.On("Append", ctx, deps.tx, isEvent(outbox.EventReservationCancelled, "res-1")).Return(errors.New("db error"))
+
+		_, err := deps.service.CancelReservation(ctx, "res-1", testOwner)
+
+		require.Error(t, err)
+		deps.tx.AssertNotCalled(t, "Commit")
+	})
+
+	t.Run("確定を記録する", func(t *testing.T) {
+		deps := newTestDeps()
+		outboxRepo := deps.enableOutbox()
+		ctx := context.Background()
+
+		deps.expectConfirmableEvent(ctx)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(&reservation.Reservation{
+			ID: "res-1", EventID: "event-1", UserID: "user-1", SeatIDs: []string{"seat-1"},
+			Status: reservation.StatusPending, ExpiresAt: time.Now().Add(10 * time.Minute),
+		}, nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Rollback").Return(nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.seatRepo.On("ConfirmSeats", ctx, deps.tx, []string{"seat-1"}).Return(nil)
+		deps.resRepo.On("Update", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+		outboxRepo.On("Append", ctx, deps.tx, isEvent(outbox.EventReservationConfirmed, "res-1")).Return(nil)
+
+		_, err := deps.service.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: "res-1", Principal: testOwner})
+
+		require.NoError(t, err)
+		outboxRepo.AssertExpectations(t)
+	})
+
+	t.Run("期限切れを記録する", func(t *testing.T) {
+		deps := newTestDeps()
+		outboxRepo := deps.enableOutbox()
+		ctx := context.Background()
+
+		deps.resRepo.On("GetExpiredPending", ctx, time.Duration(0)).Return([]*reservation.Reservation{{
+			ID: "res-1", EventID: "event-1", UserID: "user-1", SeatIDs: []string{"seat-1"},
+			Status: reservation.StatusPending, ExpiresAt: time.Now().Add(-time.Minute),
+		}}, nil)
+		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+		deps.tx.On("Commit").Return(nil)
+		deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", []string{"seat-1"}).Return(nil)
+		deps.resRepo.On("UpdateFromStatus", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation"), reservation.StatusPending).Return(nil)
+		outboxRepo.On("Append", ctx, deps.tx, isEvent(outbox.EventReservationExpired, "res-1")).Return(nil)
+
+		count, err := deps.service.CancelExpiredReservations(ctx, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		outboxRepo.AssertExpectations(t)
+	})
+}
+
+// mustField はJSONオブジェクトから指定したフィールドの値を取り出す
+func mustField(t *testing.T, payload []byte, field string) json.RawMessage {
+	t.Helper()
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(payload, &fields))
+	v, ok := fields[field]
+	require.True(t, ok, "フィールド %s がありません", field)
+	return v
+}
+
+func TestReservationService_CancelExpiredReservations_LapsedOffer(t *testing.T) {
+	deps := newTestDeps()
+	deps.enableWaitlist()
+	ctx := context.Background()
+
+	expired := []*reservation.Reservation{
+		{ID: "res-1", EventID: "event-1", UserID: "user-1", SeatIDs: []string{"seat-A1"}, Status: reservation.StatusPending},
+	}
+	expireAfter := time.Duration(0)
+	deps.resRepo.On("GetExpiredPending", ctx, expireAfter).Return(expired, nil)
+	deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
+	deps.tx.On("Rollback").Return(nil)
+	deps.tx.On("Commit").Return(nil)
+	deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", []string{"seat-A1"}).Return(nil)
+	deps.resRepo.On("UpdateFromStatus", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation"), reservation.StatusPending).Return(nil)
+
+	// 期限切れになったのはオファー予約
+	lapsed := &waitlist.Entry{ID: "entry-1", EventID: "event-1", UserID: "user-1", Quantity: 1, Status: waitlist.StatusOffered}
+	deps.waitlistRepo.On("GetByReservationID", ctx, "res-1").Return(lapsed, nil)
+	deps.waitlistRepo.On("Update", ctx, lapsed).Return(nil)
+
+	// 次のユーザーは2席希望だが空席は1席のみ
+	deps.lockManager.On("AcquireLockWithRetry", ctx, "waitlist:event-1", 30*time.Second, 3, 100*time.Millisecond).
+		Return(deps.lock, nil)
+	deps.lock.On("Release", ctx).Return(nil)
+	next := &waitlist.Entry{ID: "entry-2", EventID: "event-1", UserID: "user-2", Quantity: 2, Status: waitlist.StatusWaiting}
+	deps.waitlistRepo.On("GetNextWaiting", ctx, "event-1").Return(next, nil)
+	deps.resRepo.On("GetByIdempotencyKey", ctx, "waitlist:entry-2").Return(nil, reservation.ErrReservationNotFound)
+	deps.seatRepo.On("GetByEventID", ctx, "event-1").Return(layoutSeats(seat.StatusAvailable, seat.StatusReserved), nil)
+
+	count, err := deps.service.CancelExpiredReservations(ctx, expireAfter)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, waitlist.StatusExpired, lapsed.Status)
+	assert.Equal(t, waitlist.StatusWaiting, next.Status)
+	deps.seatRepo.AssertNotCalled(t, "ReserveSeats", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReservationService_CancelExpiredReservations(t *testing.T) {
+	deps := newTestDeps()
+	ctx := context.Background()
+
+	expired := []*reservation.Reservation{
+		{
+			ID:      "res-1",
+			EventID: "event-1",
+			UserID:  "user-1",
+			SeatIDs: []string{"seat-1"},
+			Status:  reservation.StatusPending,
+		},
+		{
+			ID:      "res-2",
+			EventID: "event-2",
+			UserID:  "user-2",
+			SeatIDs: []string{"seat-2"},
+			Status:  reservation.StatusPending,
+		},
+	}
+	expireAfter := 15 * time.Minute
+
+	deps.resRepo.On("GetExpiredPending", ctx, expireAfter).Return(expired, nil)
 
 	// First reservation succeeds
 	tx1 := new(MockTx)
 	deps.txManager.On("Begin", ctx).Return(tx1, nil).Once()
 	tx1.On("Rollback").Return(nil)
 	tx1.On("Commit").Return(nil)
-	deps.seatRepo.On("ReleaseSeats", ctx, tx1, []string{"seat-1"}).Return(nil).Once()
-	deps.resRepo.On("Update", ctx, tx1, mock.AnythingOfType("*reservation.Reservation")).Return(nil).Once()
+	deps.seatRepo.On("ReleaseSeats", ctx, tx1, "res-1", []string{"seat-1"}).Return(nil).Once()
+	deps.resRepo.On("UpdateFromStatus", ctx, tx1, mock.AnythingOfType("*reservation.Reservation"), reservation.StatusPending).Return(nil).Once()
 
 	// Second reservation succeeds
 	tx2 := new(MockTx)
 	deps.txManager.On("Begin", ctx).Return(tx2, nil).Once()
 	tx2.On("Rollback").Return(nil)
 	tx2.On("Commit").Return(nil)
-	deps.seatRepo.On("ReleaseSeats", ctx, tx2, []string{"seat-2"}).Return(nil).Once()
-	deps.resRepo.On("Update", ctx, tx2, mock.AnythingOfType("*reservation.Reservation")).Return(nil).Once()
+	deps.seatRepo.On("ReleaseSeats", ctx, tx2, "res-2", []string{"seat-2"}).Return(nil).Once()
+	deps.resRepo.On("UpdateFromStatus", ctx, tx2, mock.AnythingOfType("*reservation.Reservation"), reservation.StatusPending).Return(nil).Once()
 
 	count, err := deps.service.CancelExpiredReservations(ctx, expireAfter)
 
@@ -713,8 +2398,8 @@ func TestReservationService_CancelExpiredReservations_Errors(t *testing.T) {
 		deps.txManager.On("Begin", ctx).Return(tx2, nil).Once()
 		tx2.On("Rollback").Return(nil)
 		tx2.On("Commit").Return(nil)
-		deps.seatRepo.On("ReleaseSeats", ctx, tx2, []string{"seat-2"}).Return(nil).Once()
-		deps.resRepo.On("Update", ctx, tx2, mock.AnythingOfType("*reservation.Reservation")).Return(nil).Once()
+		deps.seatRepo.On("ReleaseSeats", ctx, tx2, "res-2", []string{"seat-2"}).Return(nil).Once()
+		deps.resRepo.On("UpdateFromStatus", ctx, tx2, mock.AnythingOfType("*reservation.Reservation"), reservation.StatusPending).Return(nil).Once()
 
 		count, err := deps.service.CancelExpiredReservations(ctx, 15*time.Minute)
 
@@ -764,8 +2449,8 @@ func TestReservationService_CancelExpiredReservations_Errors(t *testing.T) {
 		deps.txManager.On("Begin", ctx).Return(tx, nil)
 		tx.On("Rollback").Return(nil)
 		tx.On("Commit").Return(errors.New("commit error"))
-		deps.seatRepo.On("ReleaseSeats", ctx, tx, []string{"seat-1"}).Return(nil)
-		deps.resRepo.On("Update", ctx, tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+		deps.seatRepo.On("ReleaseSeats", ctx, tx, "res-1", []string{"seat-1"}).Return(nil)
+		deps.resRepo.On("UpdateFromStatus", ctx, tx, mock.AnythingOfType("*reservation.Reservation"), reservation.StatusPending).Return(nil)
 
 		count, err := deps.service.CancelExpiredReservations(ctx, 15*time.Minute)
 
@@ -793,6 +2478,7 @@ func TestReservationService_CreateReservation_TransactionBeginFailed(t *testing.
 	deps.lock.On("Release", ctx).Return(nil)
 
 	openEvent := &event.Event{
+		Status:  event.StatusOnSale,
 		ID:      "event-1",
 		Name:    "Test Event",
 		StartAt: time.Now().Add(1 * time.Hour),
@@ -819,18 +2505,9 @@ func TestReservationService_ConfirmReservation_TransactionErrors(t *testing.T) {
 		deps := newTestDeps()
 		ctx := context.Background()
 
-		res := &reservation.Reservation{
-			ID:        "res-1",
-			EventID:   "event-1",
-			UserID:    "user-1",
-			SeatIDs:   []string{"seat-1"},
-			Status:    reservation.StatusPending,
-			ExpiresAt: time.Now().Add(10 * time.Minute),
-		}
-		deps.resRepo.On("GetByID", ctx, "res-1").Return(res, nil)
 		deps.txManager.On("Begin", ctx).Return(nil, errors.New("db error"))
 
-		result, err := deps.service.ConfirmReservation(ctx, "res-1")
+		result, err := deps.service.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: "res-1", Principal: testOwner})
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -849,12 +2526,13 @@ func TestReservationService_ConfirmReservation_TransactionErrors(t *testing.T) {
 			Status:    reservation.StatusPending,
 			ExpiresAt: time.Now().Add(10 * time.Minute),
 		}
-		deps.resRepo.On("GetByID", ctx, "res-1").Return(res, nil)
+		deps.expectConfirmableEvent(ctx)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(res, nil)
 		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
 		deps.tx.On("Rollback").Return(nil)
 		deps.seatRepo.On("ConfirmSeats", ctx, deps.tx, res.SeatIDs).Return(errors.New("seat confirm error"))
 
-		result, err := deps.service.ConfirmReservation(ctx, "res-1")
+		result, err := deps.service.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: "res-1", Principal: testOwner})
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -872,13 +2550,14 @@ func TestReservationService_ConfirmReservation_TransactionErrors(t *testing.T) {
 			Status:    reservation.StatusPending,
 			ExpiresAt: time.Now().Add(10 * time.Minute),
 		}
-		deps.resRepo.On("GetByID", ctx, "res-1").Return(res, nil)
+		deps.expectConfirmableEvent(ctx)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(res, nil)
 		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
 		deps.tx.On("Rollback").Return(nil)
 		deps.seatRepo.On("ConfirmSeats", ctx, deps.tx, res.SeatIDs).Return(nil)
 		deps.resRepo.On("Update", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(errors.New("update error"))
 
-		result, err := deps.service.ConfirmReservation(ctx, "res-1")
+		result, err := deps.service.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: "res-1", Principal: testOwner})
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -896,14 +2575,15 @@ func TestReservationService_ConfirmReservation_TransactionErrors(t *testing.T) {
 			Status:    reservation.StatusPending,
 			ExpiresAt: time.Now().Add(10 * time.Minute),
 		}
-		deps.resRepo.On("GetByID", ctx, "res-1").Return(res, nil)
+		deps.expectConfirmableEvent(ctx)
+		deps.resRepo.On("GetForUpdate", ctx, deps.tx, "res-1").Return(res, nil)
 		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
 		deps.tx.On("Rollback").Return(nil)
 		deps.tx.On("Commit").Return(errors.New("commit error"))
 		deps.seatRepo.On("ConfirmSeats", ctx, deps.tx, res.SeatIDs).Return(nil)
 		deps.resRepo.On("Update", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
 
-		result, err := deps.service.ConfirmReservation(ctx, "res-1")
+		result, err := deps.service.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: "res-1", Principal: testOwner})
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -918,7 +2598,7 @@ func TestReservationService_CancelReservation_Errors(t *testing.T) {
 
 		deps.resRepo.On("GetByID", ctx, "nonexistent").Return(nil, reservation.ErrReservationNotFound)
 
-		result, err := deps.service.CancelReservation(ctx, "nonexistent")
+		result, err := deps.service.CancelReservation(ctx, "nonexistent", testOwner)
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -939,7 +2619,7 @@ func TestReservationService_CancelReservation_Errors(t *testing.T) {
 		deps.resRepo.On("GetByID", ctx, "res-1").Return(res, nil)
 		deps.txManager.On("Begin", ctx).Return(nil, errors.New("db error"))
 
-		result, err := deps.service.CancelReservation(ctx, "res-1")
+		result, err := deps.service.CancelReservation(ctx, "res-1", testOwner)
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -960,9 +2640,10 @@ func TestReservationService_CancelReservation_Errors(t *testing.T) {
 		deps.resRepo.On("GetByID", ctx, "res-1").Return(res, nil)
 		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
 		deps.tx.On("Rollback").Return(nil)
-		deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, res.SeatIDs).Return(errors.New("release error"))
+		deps.resRepo.On("UpdateFromStatus", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation"), reservation.StatusPending).Return(nil)
+		deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", res.SeatIDs).Return(errors.New("release error"))
 
-		result, err := deps.service.CancelReservation(ctx, "res-1")
+		result, err := deps.service.CancelReservation(ctx, "res-1", testOwner)
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -983,11 +2664,11 @@ func TestReservationService_CancelReservation_Errors(t *testing.T) {
 		deps.txManager.On("Begin", ctx).Return(deps.tx, nil)
 		deps.tx.On("Rollback").Return(nil)
 		deps.tx.On("Commit").Return(errors.New("commit error"))
-		deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, res.SeatIDs).Return(nil)
-		deps.resRepo.On("Update", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation")).Return(nil)
+		deps.seatRepo.On("ReleaseSeats", ctx, deps.tx, "res-1", res.SeatIDs).Return(nil)
+		deps.resRepo.On("UpdateFromStatus", ctx, deps.tx, mock.AnythingOfType("*reservation.Reservation"), reservation.StatusPending).Return(nil)
 		deps.seatCache.On("Invalidate", ctx, "event-1").Return(nil)
 
-		result, err := deps.service.CancelReservation(ctx, "res-1")
+		result, err := deps.service.CancelReservation(ctx, "res-1", testOwner)
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -1014,6 +2695,7 @@ func TestReservationService_CreateReservation_SeatReserveFailed(t *testing.T) {
 	deps.lock.On("Release", ctx).Return(nil)
 
 	openEvent := &event.Event{
+		Status:  event.StatusOnSale,
 		ID:      "event-1",
 		Name:    "Test Event",
 		StartAt: time.Now().Add(1 * time.Hour),
@@ -1057,6 +2739,7 @@ func TestReservationService_CreateReservation_CommitFailed(t *testing.T) {
 	deps.lock.On("Release", ctx).Return(nil)
 
 	openEvent := &event.Event{
+		Status:  event.StatusOnSale,
 		ID:      "event-1",
 		Name:    "Test Event",
 		StartAt: time.Now().Add(1 * time.Hour),
@@ -1151,6 +2834,7 @@ func TestReservationService_CreateReservation_SeatGetError(t *testing.T) {
 	deps.lock.On("Release", ctx).Return(nil)
 
 	openEvent := &event.Event{
+		Status:  event.StatusOnSale,
 		ID:      "event-1",
 		Name:    "Test Event",
 		StartAt: time.Now().Add(1 * time.Hour),
@@ -1190,6 +2874,7 @@ func TestReservationService_CreateReservation_LockGenericError(t *testing.T) {
 	require.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "ロック取得に失敗")
+	assert.ErrorIs(t, err, seat.ErrSeatLockUnavailable)
 }
 
 func TestReservationService_CreateReservation_ReservationCreateError(t *testing.T) {
@@ -1211,6 +2896,7 @@ func TestReservationService_CreateReservation_ReservationCreateError(t *testing.
 	deps.lock.On("Release", ctx).Return(nil)
 
 	openEvent := &event.Event{
+		Status:  event.StatusOnSale,
 		ID:      "event-1",
 		Name:    "Test Event",
 		StartAt: time.Now().Add(1 * time.Hour),
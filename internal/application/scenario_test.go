@@ -5,6 +5,8 @@ package application
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -13,6 +15,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/reservation"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
 )
@@ -33,16 +36,19 @@ func TestScenario_FullReservationFlow(t *testing.T) {
 			StartAt:    time.Now().Add(30 * 24 * time.Hour),
 			EndAt:      time.Now().Add(30*24*time.Hour + 3*time.Hour),
 			TotalSeats: 100,
+			Principal:  testOrganizer,
 		})
 		require.NoError(t, err)
+		openEventSales(t, ctx, eventService, event.ID)
 		assert.NotEmpty(t, event.ID)
 
 		// 2. 座席を一括作成
 		seats, err := seatService.CreateBulkSeats(ctx, CreateBulkSeatsInput{
-			EventID: event.ID,
-			Prefix:  "A",
-			Count:   10,
-			Price:   15000,
+			EventID:   event.ID,
+			Prefix:    "A",
+			Count:     10,
+			Price:     15000,
+			Principal: testOrganizer,
 		})
 		require.NoError(t, err)
 		assert.Len(t, seats, 10)
@@ -64,7 +70,7 @@ func TestScenario_FullReservationFlow(t *testing.T) {
 		assert.Equal(t, 30000, res.TotalAmount) // 15000 * 2
 
 		// 5. 予約を確定
-		confirmed, err := reservationService.ConfirmReservation(ctx, res.ID)
+		confirmed, err := reservationService.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: res.ID, Principal: auth.NewPrincipal(res.UserID)})
 		require.NoError(t, err)
 		assert.Equal(t, reservation.StatusConfirmed, confirmed.Status)
 
@@ -90,11 +96,13 @@ func TestScenario_MultipleUsersCompeting(t *testing.T) {
 			StartAt:    time.Now().Add(14 * 24 * time.Hour),
 			EndAt:      time.Now().Add(14*24*time.Hour + 2*time.Hour),
 			TotalSeats: 1,
+			Principal:  testOrganizer,
 		})
 		require.NoError(t, err)
+		openEventSales(t, ctx, eventService, event.ID)
 
 		seats, err := seatService.CreateBulkSeats(ctx, CreateBulkSeatsInput{
-			EventID: event.ID, Prefix: "VIP", Count: 1, Price: 50000,
+			EventID: event.ID, Prefix: "VIP", Count: 1, Price: 50000, Principal: testOrganizer,
 		})
 		require.NoError(t, err)
 		targetSeatID := seats[0].ID
@@ -150,11 +158,13 @@ func TestScenario_CancelAndRebook(t *testing.T) {
 			StartAt:    time.Now().Add(7 * 24 * time.Hour),
 			EndAt:      time.Now().Add(7*24*time.Hour + 2*time.Hour),
 			TotalSeats: 1,
+			Principal:  testOrganizer,
 		})
 		require.NoError(t, err)
+		openEventSales(t, ctx, eventService, event.ID)
 
 		seats, err := seatService.CreateBulkSeats(ctx, CreateBulkSeatsInput{
-			EventID: event.ID, Prefix: "S", Count: 1, Price: 10000,
+			EventID: event.ID, Prefix: "S", Count: 1, Price: 10000, Principal: testOrganizer,
 		})
 		require.NoError(t, err)
 		seatID := seats[0].ID
@@ -178,7 +188,7 @@ func TestScenario_CancelAndRebook(t *testing.T) {
 		assert.ErrorIs(t, err, seat.ErrSeatAlreadyReserved)
 
 		// ユーザーAがキャンセル
-		cancelled, err := reservationService.CancelReservation(ctx, resA.ID)
+		cancelled, err := reservationService.CancelReservation(ctx, resA.ID, auth.NewPrincipal(resA.UserID))
 		require.NoError(t, err)
 		assert.Equal(t, reservation.StatusCancelled, cancelled.Status)
 
@@ -208,11 +218,13 @@ func TestScenario_MultiSeatReservation(t *testing.T) {
 			StartAt:    time.Now().Add(10 * 24 * time.Hour),
 			EndAt:      time.Now().Add(10*24*time.Hour + 2*time.Hour),
 			TotalSeats: 20,
+			Principal:  testOrganizer,
 		})
 		require.NoError(t, err)
+		openEventSales(t, ctx, eventService, event.ID)
 
 		seats, err := seatService.CreateBulkSeats(ctx, CreateBulkSeatsInput{
-			EventID: event.ID, Prefix: "G", Count: 10, Price: 8000,
+			EventID: event.ID, Prefix: "G", Count: 10, Price: 8000, Principal: testOrganizer,
 		})
 		require.NoError(t, err)
 
@@ -240,11 +252,13 @@ func TestScenario_MultiSeatReservation(t *testing.T) {
 			StartAt:    time.Now().Add(11 * 24 * time.Hour),
 			EndAt:      time.Now().Add(11*24*time.Hour + 2*time.Hour),
 			TotalSeats: 10,
+			Principal:  testOrganizer,
 		})
 		require.NoError(t, err)
+		openEventSales(t, ctx, eventService, event.ID)
 
 		seats, err := seatService.CreateBulkSeats(ctx, CreateBulkSeatsInput{
-			EventID: event.ID, Prefix: "P", Count: 5, Price: 5000,
+			EventID: event.ID, Prefix: "P", Count: 5, Price: 5000, Principal: testOrganizer,
 		})
 		require.NoError(t, err)
 
@@ -287,11 +301,13 @@ func TestScenario_ConfirmedCannotBeModified(t *testing.T) {
 			StartAt:    time.Now().Add(5 * 24 * time.Hour),
 			EndAt:      time.Now().Add(5*24*time.Hour + 2*time.Hour),
 			TotalSeats: 5,
+			Principal:  testOrganizer,
 		})
 		require.NoError(t, err)
+		openEventSales(t, ctx, eventService, event.ID)
 
 		seats, err := seatService.CreateBulkSeats(ctx, CreateBulkSeatsInput{
-			EventID: event.ID, Prefix: "C", Count: 1, Price: 10000,
+			EventID: event.ID, Prefix: "C", Count: 1, Price: 10000, Principal: testOrganizer,
 		})
 		require.NoError(t, err)
 
@@ -304,11 +320,94 @@ func TestScenario_ConfirmedCannotBeModified(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		_, err = reservationService.ConfirmReservation(ctx, res.ID)
+		_, err = reservationService.ConfirmReservation(ctx, ConfirmReservationInput{ReservationID: res.ID, Principal: auth.NewPrincipal(res.UserID)})
 		require.NoError(t, err)
 
 		// 確定済みの予約をキャンセルしようとしてエラー
-		_, err = reservationService.CancelReservation(ctx, res.ID)
+		_, err = reservationService.CancelReservation(ctx, res.ID, auth.NewPrincipal(res.UserID))
 		assert.Error(t, err)
 	})
 }
+
+// TestScenario_ConcurrentSeatCreation は同じイベントへの座席の一括作成が同時に行われても総座席数を超えないことをテスト
+func TestScenario_ConcurrentSeatCreation(t *testing.T) {
+	_, seatService, eventService, cleanup := setupTestEnv(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	event, err := eventService.CreateEvent(ctx, CreateEventInput{
+		Name:       "座席の同時作成テスト",
+		Venue:      "テスト会場",
+		StartAt:    time.Now().Add(9 * 24 * time.Hour),
+		EndAt:      time.Now().Add(9*24*time.Hour + 2*time.Hour),
+		TotalSeats: 10,
+		Principal:  testOrganizer,
+	})
+	require.NoError(t, err)
+
+	// 4席ずつ5リクエスト同時に作成しても、成功するのは2リクエストまで
+	const concurrency = 5
+	var wg sync.WaitGroup
+	var succeeded, exceeded int32
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := seatService.CreateBulkSeats(ctx, CreateBulkSeatsInput{
+				EventID: event.ID, Prefix: fmt.Sprintf("C%d", i), Count: 4, Price: 5000, Principal: testOrganizer,
+			})
+			switch {
+			case err == nil:
+				atomic.AddInt32(&succeeded, 1)
+			case errors.Is(err, seat.ErrCapacityExceeded):
+				atomic.AddInt32(&exceeded, 1)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), succeeded)
+	assert.Equal(t, int32(concurrency-2), exceeded)
+	seats, err := seatService.GetSeatsByEvent(ctx, event.ID)
+	require.NoError(t, err)
+	assert.Len(t, seats, 8)
+}
+
+// TestScenario_ConcurrentTotalSeatsReduction は総座席数の削減と座席の作成が同時に行われても作成済みの座席数を下回らないことをテスト
+func TestScenario_ConcurrentTotalSeatsReduction(t *testing.T) {
+	_, seatService, eventService, cleanup := setupTestEnv(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	event, err := eventService.CreateEvent(ctx, CreateEventInput{
+		Name:       "総座席数の同時変更テスト",
+		Venue:      "テスト会場",
+		StartAt:    time.Now().Add(9 * 24 * time.Hour),
+		EndAt:      time.Now().Add(9*24*time.Hour + 2*time.Hour),
+		TotalSeats: 10,
+		Principal:  testOrganizer,
+	})
+	require.NoError(t, err)
+
+	// 8席の作成と総座席数の5席への削減を同時に行う（どちらか一方だけが成功する）
+	var wg sync.WaitGroup
+	var createErr, patchErr error
+	reduced := 5
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, createErr = seatService.CreateBulkSeats(ctx, CreateBulkSeatsInput{
+			EventID: event.ID, Prefix: "R", Count: 8, Price: 5000, Principal: testOrganizer,
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		_, patchErr = eventService.PatchEvent(ctx, PatchEventInput{ID: event.ID, TotalSeats: &reduced, Principal: testOrganizer})
+	}()
+	wg.Wait()
+
+	assert.True(t, (createErr == nil) != (patchErr == nil), "create=%v patch=%v", createErr, patchErr)
+	detail, err := eventService.GetEvent(ctx, event.ID)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, detail.Event.TotalSeats, detail.Seats.Total)
+}
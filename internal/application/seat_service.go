@@ -8,7 +8,9 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pricecategory"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
 	redisinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/redis"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/logger"
@@ -19,26 +21,35 @@ const (
 )
 
 type SeatService struct {
-	seatRepo  seat.Repository
-	eventRepo event.Repository
-	cache     redisinfra.SeatCacheInterface
+	seatRepo     seat.Repository
+	eventRepo    event.Repository
+	categoryRepo pricecategory.Repository
+	cache        redisinfra.SeatCacheInterface
 }
 
-func NewSeatService(sr seat.Repository, er event.Repository, cache redisinfra.SeatCacheInterface) *SeatService {
-	return &SeatService{seatRepo: sr, eventRepo: er, cache: cache}
+func NewSeatService(sr seat.Repository, er event.Repository, cr pricecategory.Repository, cache redisinfra.SeatCacheInterface) *SeatService {
+	return &SeatService{seatRepo: sr, eventRepo: er, categoryRepo: cr, cache: cache}
 }
 
 type CreateSeatInput struct {
-	EventID    string
-	SeatNumber string
-	Price      int
+	EventID         string
+	SeatNumber      string
+	Price           int
+	PriceCategoryID string // 指定時は Price の代わりにカテゴリの金額を使用
+	// Principal は呼び出し元（イベントの主催者または管理者のみ座席を追加できる）
+	Principal auth.Principal
 }
 
 func (s *SeatService) CreateSeat(ctx context.Context, input CreateSeatInput) (*seat.Seat, error) {
-	if _, err := s.eventRepo.GetByID(ctx, input.EventID); err != nil {
-		return nil, fmt.Errorf("イベント取得に失敗: %w", err)
+	if _, err := authorizeEventManagement(ctx, s.eventRepo, input.EventID, input.Principal); err != nil {
+		return nil, err
+	}
+	category, err := s.resolvePriceCategory(ctx, input.EventID, input.PriceCategoryID)
+	if err != nil {
+		return nil, err
 	}
 	se := seat.NewSeat(input.EventID, input.SeatNumber, input.Price)
+	assignPriceCategory(se, category)
 	if err := se.Validate(); err != nil {
 		return nil, err
 	}
@@ -49,20 +60,28 @@ func (s *SeatService) CreateSeat(ctx context.Context, input CreateSeatInput) (*s
 }
 
 type CreateBulkSeatsInput struct {
-	EventID string
-	Prefix  string
-	Count   int
-	Price   int
+	EventID         string
+	Prefix          string
+	Count           int
+	Price           int
+	PriceCategoryID string // 指定時は Price の代わりにカテゴリの金額を使用
+	// Principal は呼び出し元（イベントの主催者または管理者のみ座席を追加できる）
+	Principal auth.Principal
 }
 
 func (s *SeatService) CreateBulkSeats(ctx context.Context, input CreateBulkSeatsInput) ([]*seat.Seat, error) {
-	if _, err := s.eventRepo.GetByID(ctx, input.EventID); err != nil {
-		return nil, fmt.Errorf("イベント取得に失敗: %w", err)
+	if _, err := authorizeEventManagement(ctx, s.eventRepo, input.EventID, input.Principal); err != nil {
+		return nil, err
+	}
+	category, err := s.resolvePriceCategory(ctx, input.EventID, input.PriceCategoryID)
+	if err != nil {
+		return nil, err
 	}
 	seats := make([]*seat.Seat, 0, input.Count)
 	for i := 1; i <= input.Count; i++ {
 		seatNumber := fmt.Sprintf("%s-%d", input.Prefix, i)
 		se := seat.NewSeat(input.EventID, seatNumber, input.Price)
+		assignPriceCategory(se, category)
 		if err := se.Validate(); err != nil {
 			return nil, err
 		}
@@ -74,6 +93,30 @@ func (s *SeatService) CreateBulkSeats(ctx context.Context, input CreateBulkSeats
 	return seats, nil
 }
 
+// resolvePriceCategory は座席に割り当てる価格カテゴリを取得する（未指定時は nil）
+func (s *SeatService) resolvePriceCategory(ctx context.Context, eventID, categoryID string) (*pricecategory.PriceCategory, error) {
+	if categoryID == "" || s.categoryRepo == nil {
+		return nil, nil
+	}
+	c, err := s.categoryRepo.GetByID(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	if c.EventID != eventID {
+		return nil, pricecategory.ErrEventMismatch
+	}
+	return c, nil
+}
+
+// assignPriceCategory は座席に価格カテゴリを割り当て、価格をカテゴリの金額に揃える
+func assignPriceCategory(se *seat.Seat, c *pricecategory.PriceCategory) {
+	if c == nil {
+		return
+	}
+	se.PriceCategoryID = &c.ID
+	se.Price = c.Amount
+}
+
 func (s *SeatService) GetSeat(ctx context.Context, id string) (*seat.Seat, error) {
 	return s.seatRepo.GetByID(ctx, id)
 }
@@ -123,3 +166,59 @@ func (s *SeatService) InvalidateCache(ctx context.Context, eventID string) {
 		}
 	}
 }
+
+// TotalSeatsDrift は総座席数と作成済みの座席数のずれと、その修正結果
+type TotalSeatsDrift struct {
+	seat.Drift
+	Fixed bool // 総座席数を作成済みの座席数に合わせたか
+}
+
+// ReconcileTotalSeats は総座席数が作成済みの座席数と一致しないイベントを列挙する。
+// fix が true の場合は総座席数を作成済みの座席数に合わせる（座席が未作成のイベントは報告のみ）
+func (s *SeatService) ReconcileTotalSeats(ctx context.Context, fix bool) ([]TotalSeatsDrift, error) {
+	drifts, err := s.seatRepo.ListDrift(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]TotalSeatsDrift, len(drifts))
+	for i, d := range drifts {
+		results[i] = TotalSeatsDrift{Drift: *d}
+		if !fix || d.SeatCount == 0 {
+			continue
+		}
+		fixed, err := s.fixTotalSeats(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+		results[i].Fixed = fixed
+	}
+	return results, nil
+}
+
+// fixTotalSeats はイベントの総座席数を作成済みの座席数に更新する。
+// 集計後に他のリクエストがイベントを更新していた場合は修正せず false を返す
+func (s *SeatService) fixTotalSeats(ctx context.Context, d *seat.Drift) (bool, error) {
+	e, err := s.eventRepo.GetByID(ctx, d.EventID)
+	if err != nil {
+		return false, fmt.Errorf("イベント取得に失敗: %w", err)
+	}
+	if e.TotalSeats != d.TotalSeats {
+		logger.Warn("総座席数が集計後に変更されたため修正をスキップ", zap.String("event_id", d.EventID))
+		return false, nil
+	}
+	e.TotalSeats = d.SeatCount
+	if err := s.eventRepo.Update(ctx, e); err != nil {
+		if errors.Is(err, event.ErrOptimisticLockConflict) {
+			logger.Warn("イベントが同時に更新されたため修正をスキップ", zap.String("event_id", d.EventID))
+			return false, nil
+		}
+		if errors.Is(err, event.ErrTotalSeatsBelowCreated) {
+			logger.Warn("集計後に座席が追加されたため修正をスキップ", zap.String("event_id", d.EventID))
+			return false, nil
+		}
+		return false, fmt.Errorf("総座席数の更新に失敗: %w", err)
+	}
+	logger.Info("総座席数を作成済みの座席数に修正",
+		zap.String("event_id", d.EventID), zap.Int("from", d.TotalSeats), zap.Int("to", d.SeatCount))
+	return true, nil
+}
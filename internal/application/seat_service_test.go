@@ -9,7 +9,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pricecategory"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/transaction"
 )
@@ -58,6 +60,27 @@ func (m *MockSeatRepository) CountAvailableByEventID(ctx context.Context, eventI
 	return args.Int(0), args.Error(1)
 }
 
+func (m *MockSeatRepository) CountByEventID(ctx context.Context, eventID string) (int, error) {
+	args := m.Called(ctx, eventID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockSeatRepository) GetInventoryByEventID(ctx context.Context, eventID string) (*seat.Inventory, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*seat.Inventory), args.Error(1)
+}
+
+func (m *MockSeatRepository) ListDrift(ctx context.Context) ([]*seat.Drift, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*seat.Drift), args.Error(1)
+}
+
 func (m *MockSeatRepository) ReserveSeats(ctx context.Context, tx transaction.Tx, ids []string, reservationID string) error {
 	args := m.Called(ctx, tx, ids, reservationID)
 	return args.Error(0)
@@ -68,17 +91,22 @@ func (m *MockSeatRepository) ConfirmSeats(ctx context.Context, tx transaction.Tx
 	return args.Error(0)
 }
 
-func (m *MockSeatRepository) ReleaseSeats(ctx context.Context, tx transaction.Tx, ids []string) error {
-	args := m.Called(ctx, tx, ids)
+func (m *MockSeatRepository) ReleaseSeats(ctx context.Context, tx transaction.Tx, reservationID string, ids []string) error {
+	args := m.Called(ctx, tx, reservationID, ids)
 	return args.Error(0)
 }
 
+func (m *MockSeatRepository) ReleaseByEventID(ctx context.Context, tx transaction.Tx, eventID string, limit int) (int, error) {
+	args := m.Called(ctx, tx, eventID, limit)
+	return args.Int(0), args.Error(1)
+}
+
 func TestNewSeatService(t *testing.T) {
 	mockSeatRepo := new(MockSeatRepository)
 	mockEventRepo := new(MockEventRepository)
 	mockCache := new(MockSeatCache)
 
-	service := NewSeatService(mockSeatRepo, mockEventRepo, mockCache)
+	service := NewSeatService(mockSeatRepo, mockEventRepo, nil, mockCache)
 
 	assert.NotNil(t, service)
 }
@@ -97,9 +125,10 @@ func TestSeatService_CreateSeat(t *testing.T) {
 				EventID:    "event-123",
 				SeatNumber: "A-1",
 				Price:      5000,
+				Principal:  testOrganizer,
 			},
 			setupMocks: func(sr *MockSeatRepository, er *MockEventRepository) {
-				er.On("GetByID", mock.Anything, "event-123").Return(&event.Event{ID: "event-123"}, nil)
+				er.On("GetByID", mock.Anything, "event-123").Return(&event.Event{ID: "event-123", OrganizerID: testOrganizer.UserID, TotalSeats: 100}, nil)
 				sr.On("Create", mock.Anything, mock.AnythingOfType("*seat.Seat")).Return(nil)
 			},
 			expectError: false,
@@ -110,6 +139,7 @@ func TestSeatService_CreateSeat(t *testing.T) {
 				EventID:    "nonexistent",
 				SeatNumber: "A-1",
 				Price:      5000,
+				Principal:  testOrganizer,
 			},
 			setupMocks: func(sr *MockSeatRepository, er *MockEventRepository) {
 				er.On("GetByID", mock.Anything, "nonexistent").Return(nil, event.ErrEventNotFound)
@@ -117,27 +147,58 @@ func TestSeatService_CreateSeat(t *testing.T) {
 			expectError: true,
 			errorMsg:    "イベント取得に失敗",
 		},
+		{
+			name: "イベントの主催者以外は座席を追加できない",
+			input: CreateSeatInput{
+				EventID:    "event-123",
+				SeatNumber: "A-1",
+				Price:      5000,
+				Principal:  auth.NewPrincipal("org-2", auth.RoleOrganizer),
+			},
+			setupMocks: func(sr *MockSeatRepository, er *MockEventRepository) {
+				er.On("GetByID", mock.Anything, "event-123").Return(&event.Event{ID: "event-123", OrganizerID: testOrganizer.UserID, TotalSeats: 100}, nil)
+			},
+			expectError: true,
+			errorMsg:    auth.ErrPermissionDenied.Error(),
+		},
 		{
 			name: "バリデーションエラー - 価格が負",
 			input: CreateSeatInput{
 				EventID:    "event-123",
 				SeatNumber: "A-1",
 				Price:      -1,
+				Principal:  testOrganizer,
 			},
 			setupMocks: func(sr *MockSeatRepository, er *MockEventRepository) {
-				er.On("GetByID", mock.Anything, "event-123").Return(&event.Event{ID: "event-123"}, nil)
+				er.On("GetByID", mock.Anything, "event-123").Return(&event.Event{ID: "event-123", OrganizerID: testOrganizer.UserID, TotalSeats: 100}, nil)
 			},
 			expectError: true,
 		},
+		{
+			name: "総座席数に達している場合は追加できない",
+			input: CreateSeatInput{
+				EventID:    "event-123",
+				SeatNumber: "A-101",
+				Price:      5000,
+				Principal:  testOrganizer,
+			},
+			setupMocks: func(sr *MockSeatRepository, er *MockEventRepository) {
+				er.On("GetByID", mock.Anything, "event-123").Return(&event.Event{ID: "event-123", OrganizerID: testOrganizer.UserID, TotalSeats: 100}, nil)
+				sr.On("Create", mock.Anything, mock.AnythingOfType("*seat.Seat")).Return(seat.ErrCapacityExceeded)
+			},
+			expectError: true,
+			errorMsg:    seat.ErrCapacityExceeded.Error(),
+		},
 		{
 			name: "リポジトリエラー",
 			input: CreateSeatInput{
 				EventID:    "event-123",
 				SeatNumber: "A-1",
 				Price:      5000,
+				Principal:  testOrganizer,
 			},
 			setupMocks: func(sr *MockSeatRepository, er *MockEventRepository) {
-				er.On("GetByID", mock.Anything, "event-123").Return(&event.Event{ID: "event-123"}, nil)
+				er.On("GetByID", mock.Anything, "event-123").Return(&event.Event{ID: "event-123", OrganizerID: testOrganizer.UserID, TotalSeats: 100}, nil)
 				sr.On("Create", mock.Anything, mock.AnythingOfType("*seat.Seat")).Return(errors.New("db error"))
 			},
 			expectError: true,
@@ -179,6 +240,50 @@ func TestSeatService_CreateSeat(t *testing.T) {
 	}
 }
 
+func TestSeatService_CreateSeat_WithPriceCategory(t *testing.T) {
+	t.Run("カテゴリの金額が座席価格になる", func(t *testing.T) {
+		mockSeatRepo := new(MockSeatRepository)
+		mockEventRepo := new(MockEventRepository)
+		mockCategoryRepo := new(MockPriceCategoryRepository)
+		service := &SeatService{seatRepo: mockSeatRepo, eventRepo: mockEventRepo, categoryRepo: mockCategoryRepo}
+
+		mockEventRepo.On("GetByID", mock.Anything, "event-123").Return(&event.Event{ID: "event-123", OrganizerID: testOrganizer.UserID, TotalSeats: 100}, nil)
+		mockCategoryRepo.On("GetByID", mock.Anything, "cat-s").
+			Return(&pricecategory.PriceCategory{ID: "cat-s", EventID: "event-123", Currency: "JPY", Amount: 12000}, nil)
+		mockSeatRepo.On("Create", mock.Anything, mock.AnythingOfType("*seat.Seat")).Return(nil)
+
+		result, err := service.CreateSeat(context.Background(), CreateSeatInput{
+			EventID: "event-123", SeatNumber: "S-1", PriceCategoryID: "cat-s", Principal: testOrganizer,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 12000, result.Price)
+		if assert.NotNil(t, result.PriceCategoryID) {
+			assert.Equal(t, "cat-s", *result.PriceCategoryID)
+		}
+		mockSeatRepo.AssertExpectations(t)
+	})
+
+	t.Run("別イベントのカテゴリは指定できない", func(t *testing.T) {
+		mockSeatRepo := new(MockSeatRepository)
+		mockEventRepo := new(MockEventRepository)
+		mockCategoryRepo := new(MockPriceCategoryRepository)
+		service := &SeatService{seatRepo: mockSeatRepo, eventRepo: mockEventRepo, categoryRepo: mockCategoryRepo}
+
+		mockEventRepo.On("GetByID", mock.Anything, "event-123").Return(&event.Event{ID: "event-123", OrganizerID: testOrganizer.UserID, TotalSeats: 100}, nil)
+		mockCategoryRepo.On("GetByID", mock.Anything, "cat-other").
+			Return(&pricecategory.PriceCategory{ID: "cat-other", EventID: "event-999", Currency: "JPY", Amount: 12000}, nil)
+
+		result, err := service.CreateSeat(context.Background(), CreateSeatInput{
+			EventID: "event-123", SeatNumber: "S-1", PriceCategoryID: "cat-other", Principal: testOrganizer,
+		})
+
+		assert.ErrorIs(t, err, pricecategory.ErrEventMismatch)
+		assert.Nil(t, result)
+		mockSeatRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
 func TestSeatService_CreateBulkSeats(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -190,25 +295,42 @@ func TestSeatService_CreateBulkSeats(t *testing.T) {
 		{
 			name: "正常に一括作成される",
 			input: CreateBulkSeatsInput{
-				EventID: "event-123",
-				Prefix:  "A",
-				Count:   3,
-				Price:   5000,
+				EventID:   "event-123",
+				Prefix:    "A",
+				Count:     3,
+				Price:     5000,
+				Principal: testOrganizer,
 			},
 			setupMocks: func(sr *MockSeatRepository, er *MockEventRepository) {
-				er.On("GetByID", mock.Anything, "event-123").Return(&event.Event{ID: "event-123"}, nil)
+				er.On("GetByID", mock.Anything, "event-123").Return(&event.Event{ID: "event-123", OrganizerID: testOrganizer.UserID, TotalSeats: 100}, nil)
 				sr.On("CreateBulk", mock.Anything, mock.AnythingOfType("[]*seat.Seat")).Return(nil)
 			},
 			expectError: false,
 			expectCount: 3,
 		},
+		{
+			name: "総座席数を超える一括作成はできない",
+			input: CreateBulkSeatsInput{
+				EventID:   "event-123",
+				Prefix:    "A",
+				Count:     91,
+				Price:     5000,
+				Principal: testOrganizer,
+			},
+			setupMocks: func(sr *MockSeatRepository, er *MockEventRepository) {
+				er.On("GetByID", mock.Anything, "event-123").Return(&event.Event{ID: "event-123", OrganizerID: testOrganizer.UserID, TotalSeats: 100}, nil)
+				sr.On("CreateBulk", mock.Anything, mock.AnythingOfType("[]*seat.Seat")).Return(seat.ErrCapacityExceeded)
+			},
+			expectError: true,
+		},
 		{
 			name: "イベントが存在しない",
 			input: CreateBulkSeatsInput{
-				EventID: "nonexistent",
-				Prefix:  "A",
-				Count:   5,
-				Price:   5000,
+				EventID:   "nonexistent",
+				Prefix:    "A",
+				Count:     5,
+				Price:     5000,
+				Principal: testOrganizer,
 			},
 			setupMocks: func(sr *MockSeatRepository, er *MockEventRepository) {
 				er.On("GetByID", mock.Anything, "nonexistent").Return(nil, event.ErrEventNotFound)
@@ -457,3 +579,83 @@ func TestSeatService_CountAvailableSeats_CacheSetError(t *testing.T) {
 	mockCache.AssertExpectations(t)
 	mockSeatRepo.AssertExpectations(t)
 }
+
+func TestSeatService_ReconcileTotalSeats(t *testing.T) {
+	drifts := func() []*seat.Drift {
+		return []*seat.Drift{
+			{EventID: "event-1", EventName: "超過", TotalSeats: 100, SeatCount: 500},
+			{EventID: "event-2", EventName: "座席未作成", TotalSeats: 50, SeatCount: 0},
+		}
+	}
+
+	t.Run("報告のみの場合はイベントを更新しない", func(t *testing.T) {
+		mockSeatRepo := new(MockSeatRepository)
+		mockEventRepo := new(MockEventRepository)
+		service := &SeatService{seatRepo: mockSeatRepo, eventRepo: mockEventRepo}
+		mockSeatRepo.On("ListDrift", mock.Anything).Return(drifts(), nil)
+
+		results, err := service.ReconcileTotalSeats(context.Background(), false)
+
+		assert.NoError(t, err)
+		if assert.Len(t, results, 2) {
+			assert.Equal(t, 400, results[0].Excess())
+			assert.False(t, results[0].Fixed)
+			assert.False(t, results[1].Fixed)
+		}
+		mockEventRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("修正時は総座席数を作成済みの座席数に合わせる", func(t *testing.T) {
+		mockSeatRepo := new(MockSeatRepository)
+		mockEventRepo := new(MockEventRepository)
+		service := &SeatService{seatRepo: mockSeatRepo, eventRepo: mockEventRepo}
+		mockSeatRepo.On("ListDrift", mock.Anything).Return(drifts(), nil)
+		mockEventRepo.On("GetByID", mock.Anything, "event-1").Return(&event.Event{ID: "event-1", TotalSeats: 100, Version: 2}, nil)
+		mockEventRepo.On("Update", mock.Anything, mock.MatchedBy(func(e *event.Event) bool {
+			return e.ID == "event-1" && e.TotalSeats == 500
+		})).Return(nil)
+
+		results, err := service.ReconcileTotalSeats(context.Background(), true)
+
+		assert.NoError(t, err)
+		if assert.Len(t, results, 2) {
+			assert.True(t, results[0].Fixed)
+			assert.False(t, results[1].Fixed, "座席が未作成のイベントは修正しない")
+		}
+		mockEventRepo.AssertExpectations(t)
+	})
+
+	t.Run("同時に更新されたイベントはスキップする", func(t *testing.T) {
+		mockSeatRepo := new(MockSeatRepository)
+		mockEventRepo := new(MockEventRepository)
+		service := &SeatService{seatRepo: mockSeatRepo, eventRepo: mockEventRepo}
+		mockSeatRepo.On("ListDrift", mock.Anything).Return(drifts()[:1], nil)
+		mockEventRepo.On("GetByID", mock.Anything, "event-1").Return(&event.Event{ID: "event-1", TotalSeats: 100}, nil)
+		mockEventRepo.On("Update", mock.Anything, mock.Anything).Return(event.ErrOptimisticLockConflict)
+
+		results, err := service.ReconcileTotalSeats(context.Background(), true)
+
+		assert.NoError(t, err)
+		if assert.Len(t, results, 1) {
+			assert.False(t, results[0].Fixed)
+		}
+	})
+
+	t.Run("集計後に座席が追加されたイベントはスキップする", func(t *testing.T) {
+		mockSeatRepo := new(MockSeatRepository)
+		mockEventRepo := new(MockEventRepository)
+		service := &SeatService{seatRepo: mockSeatRepo, eventRepo: mockEventRepo}
+		mockSeatRepo.On("ListDrift", mock.Anything).Return([]*seat.Drift{
+			{EventID: "event-3", EventName: "不足", TotalSeats: 100, SeatCount: 70},
+		}, nil)
+		mockEventRepo.On("GetByID", mock.Anything, "event-3").Return(&event.Event{ID: "event-3", TotalSeats: 100}, nil)
+		mockEventRepo.On("Update", mock.Anything, mock.Anything).Return(event.ErrTotalSeatsBelowCreated)
+
+		results, err := service.ReconcileTotalSeats(context.Background(), true)
+
+		assert.NoError(t, err)
+		if assert.Len(t, results, 1) {
+			assert.False(t, results[0].Fixed)
+		}
+	})
+}
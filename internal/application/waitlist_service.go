@@ -0,0 +1,124 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/waitlist"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/logger"
+)
+
+type WaitlistService struct {
+	waitlistRepo waitlist.Repository
+	eventRepo    event.Repository
+	seatRepo     seat.Repository
+}
+
+func NewWaitlistService(wr waitlist.Repository, er event.Repository, sr seat.Repository) *WaitlistService {
+	return &WaitlistService{waitlistRepo: wr, eventRepo: er, seatRepo: sr}
+}
+
+type JoinWaitlistInput struct {
+	EventID  string
+	UserID   string
+	Quantity int
+}
+
+// JoinWaitlist は売り切れイベントの順番待ちに登録する
+// 希望枚数分の空席が残っている場合は通常の予約を促すため登録しない
+func (s *WaitlistService) JoinWaitlist(ctx context.Context, input JoinWaitlistInput) (*waitlist.Entry, error) {
+	ev, err := s.eventRepo.GetByID(ctx, input.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("イベント取得に失敗: %w", err)
+	}
+	if !ev.IsBookingOpen() {
+		return nil, event.ErrEventNotOpen
+	}
+	e := waitlist.NewEntry(input.EventID, input.UserID, input.Quantity)
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+	available, err := s.seatRepo.CountAvailableByEventID(ctx, input.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("空席数取得に失敗: %w", err)
+	}
+	if available >= input.Quantity {
+		return nil, waitlist.ErrSeatsAvailable
+	}
+	if err := s.waitlistRepo.Create(ctx, e); err != nil {
+		return nil, err
+	}
+	logger.Info("順番待ちに登録",
+		zap.String("entry_id", e.ID),
+		zap.String("event_id", e.EventID),
+		zap.String("user_id", e.UserID),
+		zap.Int("quantity", e.Quantity),
+	)
+	return e, nil
+}
+
+// GetEntry はイベントに属する順番待ちエントリと待機順位（1始まり、待機中以外は0）を取得する
+// 所有者本人と管理者だけが参照でき、他のユーザーのエントリは存在を知られないよう ErrEntryNotFound を返す
+func (s *WaitlistService) GetEntry(ctx context.Context, eventID, id string, principal auth.Principal) (*waitlist.Entry, int, error) {
+	e, err := s.getEventEntry(ctx, eventID, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !principal.CanAccess(e.UserID) {
+		return nil, 0, waitlist.ErrEntryNotFound
+	}
+	if !e.IsWaiting() {
+		return e, 0, nil
+	}
+	ahead, err := s.waitlistRepo.CountWaitingBefore(ctx, e)
+	if err != nil {
+		return nil, 0, err
+	}
+	return e, ahead + 1, nil
+}
+
+// LeaveWaitlist は所有者本人が待機中の順番待ちを取り消す
+// オファー後は仮押さえ予約のキャンセルで辞退するため、待機中以外は ErrEntryNotWaiting を返す
+func (s *WaitlistService) LeaveWaitlist(ctx context.Context, eventID, id string, principal auth.Principal) (*waitlist.Entry, error) {
+	e, err := s.getEventEntry(ctx, eventID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !principal.IsAuthenticated() || e.UserID != principal.UserID {
+		return nil, waitlist.ErrEntryNotFound
+	}
+	if !e.IsWaiting() {
+		return nil, waitlist.ErrEntryNotWaiting
+	}
+	if err := e.Cancel(); err != nil {
+		return nil, err
+	}
+	// 読み取り後にオファーされていた場合は ErrEntryNotWaiting になる
+	if err := s.waitlistRepo.UpdateFromStatus(ctx, e, waitlist.StatusWaiting); err != nil {
+		return nil, err
+	}
+	logger.Info("順番待ちを取り消し",
+		zap.String("entry_id", e.ID),
+		zap.String("event_id", e.EventID),
+		zap.String("user_id", e.UserID),
+	)
+	return e, nil
+}
+
+// getEventEntry はイベントに属する順番待ちエントリを取得する
+// 別イベントのエントリは存在しないものとして扱う
+func (s *WaitlistService) getEventEntry(ctx context.Context, eventID, id string) (*waitlist.Entry, error) {
+	e, err := s.waitlistRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if e.EventID != eventID {
+		return nil, waitlist.ErrEntryNotFound
+	}
+	return e, nil
+}
@@ -0,0 +1,261 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/waitlist"
+)
+
+// MockWaitlistRepository はwaitlist.Repositoryのモック
+type MockWaitlistRepository struct {
+	mock.Mock
+}
+
+func (m *MockWaitlistRepository) Create(ctx context.Context, e *waitlist.Entry) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+func (m *MockWaitlistRepository) GetByID(ctx context.Context, id string) (*waitlist.Entry, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*waitlist.Entry), args.Error(1)
+}
+
+func (m *MockWaitlistRepository) GetByReservationID(ctx context.Context, reservationID string) (*waitlist.Entry, error) {
+	args := m.Called(ctx, reservationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*waitlist.Entry), args.Error(1)
+}
+
+func (m *MockWaitlistRepository) GetNextWaiting(ctx context.Context, eventID string) (*waitlist.Entry, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*waitlist.Entry), args.Error(1)
+}
+
+func (m *MockWaitlistRepository) CountWaitingBefore(ctx context.Context, e *waitlist.Entry) (int, error) {
+	args := m.Called(ctx, e)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockWaitlistRepository) Update(ctx context.Context, e *waitlist.Entry) error {
+	args := m.Called(ctx, e)
+	return args.Error(0)
+}
+
+func (m *MockWaitlistRepository) UpdateFromStatus(ctx context.Context, e *waitlist.Entry, from waitlist.Status) error {
+	args := m.Called(ctx, e, from)
+	return args.Error(0)
+}
+
+func TestWaitlistService_JoinWaitlist(t *testing.T) {
+	openEvent := &event.Event{
+		Status:  event.StatusOnSale,
+		ID:      "event-1",
+		StartAt: time.Now().Add(1 * time.Hour),
+		EndAt:   time.Now().Add(2 * time.Hour),
+	}
+	input := JoinWaitlistInput{EventID: "event-1", UserID: "user-1", Quantity: 2}
+
+	t.Run("売り切れのイベントに登録できる", func(t *testing.T) {
+		mockRepo := new(MockWaitlistRepository)
+		mockEventRepo := new(MockEventRepository)
+		mockSeatRepo := new(MockSeatRepository)
+		service := NewWaitlistService(mockRepo, mockEventRepo, mockSeatRepo)
+
+		mockEventRepo.On("GetByID", mock.Anything, "event-1").Return(openEvent, nil)
+		mockSeatRepo.On("CountAvailableByEventID", mock.Anything, "event-1").Return(1, nil)
+		mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*waitlist.Entry")).Return(nil)
+
+		result, err := service.JoinWaitlist(context.Background(), input)
+
+		require.NoError(t, err)
+		assert.Equal(t, waitlist.StatusWaiting, result.Status)
+		assert.Equal(t, 2, result.Quantity)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("希望枚数分の空席がある場合は登録しない", func(t *testing.T) {
+		mockRepo := new(MockWaitlistRepository)
+		mockEventRepo := new(MockEventRepository)
+		mockSeatRepo := new(MockSeatRepository)
+		service := NewWaitlistService(mockRepo, mockEventRepo, mockSeatRepo)
+
+		mockEventRepo.On("GetByID", mock.Anything, "event-1").Return(openEvent, nil)
+		mockSeatRepo.On("CountAvailableByEventID", mock.Anything, "event-1").Return(2, nil)
+
+		result, err := service.JoinWaitlist(context.Background(), input)
+
+		assert.ErrorIs(t, err, waitlist.ErrSeatsAvailable)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("予約受付期間外のイベントには登録できない", func(t *testing.T) {
+		mockRepo := new(MockWaitlistRepository)
+		mockEventRepo := new(MockEventRepository)
+		mockSeatRepo := new(MockSeatRepository)
+		service := NewWaitlistService(mockRepo, mockEventRepo, mockSeatRepo)
+
+		pastEvent := &event.Event{ID: "event-1", StartAt: time.Now().Add(-1 * time.Hour), EndAt: time.Now()}
+		mockEventRepo.On("GetByID", mock.Anything, "event-1").Return(pastEvent, nil)
+
+		_, err := service.JoinWaitlist(context.Background(), input)
+
+		assert.ErrorIs(t, err, event.ErrEventNotOpen)
+	})
+
+	t.Run("希望枚数が不正", func(t *testing.T) {
+		mockRepo := new(MockWaitlistRepository)
+		mockEventRepo := new(MockEventRepository)
+		mockSeatRepo := new(MockSeatRepository)
+		service := NewWaitlistService(mockRepo, mockEventRepo, mockSeatRepo)
+
+		mockEventRepo.On("GetByID", mock.Anything, "event-1").Return(openEvent, nil)
+
+		_, err := service.JoinWaitlist(context.Background(), JoinWaitlistInput{EventID: "event-1", UserID: "user-1", Quantity: 0})
+
+		assert.ErrorIs(t, err, waitlist.ErrInvalidQuantity)
+	})
+}
+
+func TestWaitlistService_GetEntry(t *testing.T) {
+	t.Run("待機中のエントリは順位を返す", func(t *testing.T) {
+		mockRepo := new(MockWaitlistRepository)
+		service := NewWaitlistService(mockRepo, new(MockEventRepository), new(MockSeatRepository))
+
+		entry := &waitlist.Entry{ID: "entry-1", EventID: "event-1", UserID: "user-1", Status: waitlist.StatusWaiting}
+		mockRepo.On("GetByID", mock.Anything, "entry-1").Return(entry, nil)
+		mockRepo.On("CountWaitingBefore", mock.Anything, entry).Return(2, nil)
+
+		result, position, err := service.GetEntry(context.Background(), "event-1", "entry-1", testOwner)
+
+		require.NoError(t, err)
+		assert.Equal(t, "entry-1", result.ID)
+		assert.Equal(t, 3, position)
+	})
+
+	t.Run("オファー済みのエントリは順位を返さない", func(t *testing.T) {
+		mockRepo := new(MockWaitlistRepository)
+		service := NewWaitlistService(mockRepo, new(MockEventRepository), new(MockSeatRepository))
+
+		entry := &waitlist.Entry{ID: "entry-1", EventID: "event-1", UserID: "user-1", Status: waitlist.StatusOffered}
+		mockRepo.On("GetByID", mock.Anything, "entry-1").Return(entry, nil)
+
+		_, position, err := service.GetEntry(context.Background(), "event-1", "entry-1", testOwner)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, position)
+		mockRepo.AssertNotCalled(t, "CountWaitingBefore")
+	})
+
+	t.Run("別イベントのエントリは見つからない", func(t *testing.T) {
+		mockRepo := new(MockWaitlistRepository)
+		service := NewWaitlistService(mockRepo, new(MockEventRepository), new(MockSeatRepository))
+
+		entry := &waitlist.Entry{ID: "entry-1", EventID: "event-2", UserID: "user-1", Status: waitlist.StatusWaiting}
+		mockRepo.On("GetByID", mock.Anything, "entry-1").Return(entry, nil)
+
+		_, _, err := service.GetEntry(context.Background(), "event-1", "entry-1", testOwner)
+
+		assert.ErrorIs(t, err, waitlist.ErrEntryNotFound)
+	})
+
+	t.Run("他のユーザーのエントリは見つからない", func(t *testing.T) {
+		mockRepo := new(MockWaitlistRepository)
+		service := NewWaitlistService(mockRepo, new(MockEventRepository), new(MockSeatRepository))
+
+		entry := &waitlist.Entry{ID: "entry-1", EventID: "event-1", UserID: "user-2", Status: waitlist.StatusWaiting}
+		mockRepo.On("GetByID", mock.Anything, "entry-1").Return(entry, nil)
+
+		_, _, err := service.GetEntry(context.Background(), "event-1", "entry-1", testOwner)
+
+		assert.ErrorIs(t, err, waitlist.ErrEntryNotFound)
+		mockRepo.AssertNotCalled(t, "CountWaitingBefore")
+	})
+
+	t.Run("管理者は他のユーザーのエントリを参照できる", func(t *testing.T) {
+		mockRepo := new(MockWaitlistRepository)
+		service := NewWaitlistService(mockRepo, new(MockEventRepository), new(MockSeatRepository))
+
+		entry := &waitlist.Entry{ID: "entry-1", EventID: "event-1", UserID: "user-2", Status: waitlist.StatusWaiting}
+		mockRepo.On("GetByID", mock.Anything, "entry-1").Return(entry, nil)
+		mockRepo.On("CountWaitingBefore", mock.Anything, entry).Return(0, nil)
+
+		_, position, err := service.GetEntry(context.Background(), "event-1", "entry-1", auth.NewPrincipal("admin-1", auth.RoleAdmin))
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, position)
+	})
+}
+
+func TestWaitlistService_LeaveWaitlist(t *testing.T) {
+	t.Run("待機中のエントリを取り消せる", func(t *testing.T) {
+		mockRepo := new(MockWaitlistRepository)
+		service := NewWaitlistService(mockRepo, new(MockEventRepository), new(MockSeatRepository))
+
+		entry := &waitlist.Entry{ID: "entry-1", EventID: "event-1", UserID: "user-1", Status: waitlist.StatusWaiting}
+		mockRepo.On("GetByID", mock.Anything, "entry-1").Return(entry, nil)
+		mockRepo.On("UpdateFromStatus", mock.Anything, entry, waitlist.StatusWaiting).Return(nil)
+
+		result, err := service.LeaveWaitlist(context.Background(), "event-1", "entry-1", testOwner)
+
+		require.NoError(t, err)
+		assert.Equal(t, waitlist.StatusCancelled, result.Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("他のユーザーのエントリは取り消せない", func(t *testing.T) {
+		mockRepo := new(MockWaitlistRepository)
+		service := NewWaitlistService(mockRepo, new(MockEventRepository), new(MockSeatRepository))
+
+		entry := &waitlist.Entry{ID: "entry-1", EventID: "event-1", UserID: "user-2", Status: waitlist.StatusWaiting}
+		mockRepo.On("GetByID", mock.Anything, "entry-1").Return(entry, nil)
+
+		_, err := service.LeaveWaitlist(context.Background(), "event-1", "entry-1", auth.NewPrincipal("admin-1", auth.RoleAdmin))
+
+		assert.ErrorIs(t, err, waitlist.ErrEntryNotFound)
+		mockRepo.AssertNotCalled(t, "UpdateFromStatus")
+	})
+
+	t.Run("オファー後は取り消せない", func(t *testing.T) {
+		mockRepo := new(MockWaitlistRepository)
+		service := NewWaitlistService(mockRepo, new(MockEventRepository), new(MockSeatRepository))
+
+		entry := &waitlist.Entry{ID: "entry-1", EventID: "event-1", UserID: "user-1", Status: waitlist.StatusOffered}
+		mockRepo.On("GetByID", mock.Anything, "entry-1").Return(entry, nil)
+
+		_, err := service.LeaveWaitlist(context.Background(), "event-1", "entry-1", testOwner)
+
+		assert.ErrorIs(t, err, waitlist.ErrEntryNotWaiting)
+		mockRepo.AssertNotCalled(t, "UpdateFromStatus")
+	})
+
+	t.Run("読み取り後にオファーされた場合は取り消さない", func(t *testing.T) {
+		mockRepo := new(MockWaitlistRepository)
+		service := NewWaitlistService(mockRepo, new(MockEventRepository), new(MockSeatRepository))
+
+		entry := &waitlist.Entry{ID: "entry-1", EventID: "event-1", UserID: "user-1", Status: waitlist.StatusWaiting}
+		mockRepo.On("GetByID", mock.Anything, "entry-1").Return(entry, nil)
+		mockRepo.On("UpdateFromStatus", mock.Anything, entry, waitlist.StatusWaiting).Return(waitlist.ErrEntryNotWaiting)
+
+		_, err := service.LeaveWaitlist(context.Background(), "event-1", "entry-1", testOwner)
+
+		assert.ErrorIs(t, err, waitlist.ErrEntryNotWaiting)
+	})
+}
@@ -13,6 +13,16 @@ type Config struct {
 	Server   ServerConfig
 	Database DatabaseConfig
 	Redis    RedisConfig
+	// WaitingRoom は待合室（仮想キュー）の入場設定
+	WaitingRoom WaitingRoomConfig
+	Payment     PaymentConfig
+	// Outbox はドメインイベント配信（トランザクショナルアウトボックス）の設定
+	Outbox OutboxConfig
+	// EventCancellation はイベント中止時の予約の取り消し・座席の解放の設定
+	EventCancellation EventCancellationConfig
+	Auth              AuthConfig
+	// RateLimit はAPIのレート制限（Redis必須）の設定
+	RateLimit RateLimitConfig
 }
 
 // ServerConfig はサーバー設定
@@ -20,6 +30,11 @@ type ServerConfig struct {
 	Port         string
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
+	// RequireIfMatch はイベントの更新で If-Match ヘッダーを必須にする（strict モード。ない場合は 428）
+	RequireIfMatch bool
+	// TrustedProxies は X-Forwarded-For を信頼するリバースプロキシのIP範囲（CIDR）
+	// 空の場合はクライアントIPに接続元のアドレスを使い、X-Forwarded-For は無視する
+	TrustedProxies []string
 }
 
 // DatabaseConfig はデータベース設定
@@ -40,13 +55,74 @@ type RedisConfig struct {
 	DB       int
 }
 
+// WaitingRoomConfig は待合室の設定
+// AdmitInterval ごとに AdmitBatchSize 人ずつ入場を許可する
+type WaitingRoomConfig struct {
+	AdmitBatchSize int
+	AdmitInterval  time.Duration
+	AdmissionTTL   time.Duration // 入場許可の有効期間
+}
+
+// PaymentConfig は決済プロバイダーの設定
+type PaymentConfig struct {
+	Provider      string        // 決済プロバイダー（fake: プロセス内のフェイク、none: 決済なしで確定）
+	WebhookSecret string        // Webhook署名の検証に使用する共有シークレット（fake 以外のプロバイダーでは必須）
+	Timeout       time.Duration // プロバイダー呼び出しのタイムアウト
+}
+
+// OutboxConfig はドメインイベントの配信設定
+// RelayInterval ごとに未配信のイベントを最大 BatchSize 件ずつ Sink へ配信する
+type OutboxConfig struct {
+	Sink           string        // 配信先（stdout / webhook / redis / none: 配信しない）
+	WebhookURL     string        // webhook の送信先URL
+	WebhookSecret  string        // webhook 本文の署名に使用する共有シークレット（空の場合は署名なし）
+	WebhookTimeout time.Duration // webhook 送信のタイムアウト
+	RedisStream    string        // redis の書き込み先ストリーム名
+	RelayInterval  time.Duration
+	BatchSize      int
+}
+
+// EventCancellationConfig はイベントの中止処理の設定
+// Interval ごとに完了していない中止処理を再開し、1トランザクションで最大 BatchSize 件の予約を取り消す
+type EventCancellationConfig struct {
+	BatchSize int
+	Interval  time.Duration
+}
+
+// AuthConfig はJWT認証の設定
+// HS256 は共有シークレット、RS256 はPEM公開鍵またはローカルのJWKSファイルで検証する
+type AuthConfig struct {
+	JWTSecret        string // HS256 の共有シークレット
+	JWTPublicKeyFile string // RS256 のPEM公開鍵ファイル
+	JWKSFile         string // RS256 の公開鍵セット（JWKS）ファイル
+	JWTIssuer        string // 設定時は iss を検証
+	JWTAudience      string // 設定時は aud を検証
+	// AllowUserIDHeader はトークンのないリクエストで X-User-ID ヘッダーを信頼する（ローカル開発用。本番では無効にすること）
+	AllowUserIDHeader bool
+	// AllowUserRolesHeader は AllowUserIDHeader の場合に X-User-Roles ヘッダーの役割も信頼する（ローカル開発用。本番では無効にすること）
+	AllowUserRolesHeader bool
+}
+
+// RateLimitConfig はレート制限の設定
+// 認証済みのリクエストはユーザーID、未認証のリクエストはクライアントIPごとに Window あたり Requests 回まで受け付ける
+type RateLimitConfig struct {
+	Enabled  bool
+	Requests int // API全体の上限
+	Window   time.Duration
+	// 予約作成（POST /reservations, /reservations/best-available）の上限
+	ReservationRequests int
+	ReservationWindow   time.Duration
+}
+
 // Load は環境変数から設定を読み込む
 func Load() *Config {
 	cfg := &Config{
 		Server: ServerConfig{
-			Port:         getEnv("PORT", "8080"),
-			ReadTimeout:  getDurationEnv("SERVER_READ_TIMEOUT", 30*time.Second),
-			WriteTimeout: getDurationEnv("SERVER_WRITE_TIMEOUT", 30*time.Second),
+			Port:           getEnv("PORT", "8080"),
+			ReadTimeout:    getDurationEnv("SERVER_READ_TIMEOUT", 30*time.Second),
+			WriteTimeout:   getDurationEnv("SERVER_WRITE_TIMEOUT", 30*time.Second),
+			RequireIfMatch: getBoolEnv("SERVER_REQUIRE_IF_MATCH", false),
+			TrustedProxies: getListEnv("SERVER_TRUSTED_PROXIES"),
 		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
@@ -62,6 +138,45 @@ func Load() *Config {
 			Password: getEnv("REDIS_PASSWORD", ""),
 			DB:       getIntEnv("REDIS_DB", 0),
 		},
+		WaitingRoom: WaitingRoomConfig{
+			AdmitBatchSize: getIntEnv("WAITING_ROOM_ADMIT_BATCH_SIZE", 100),
+			AdmitInterval:  getDurationEnv("WAITING_ROOM_ADMIT_INTERVAL", 5*time.Second),
+			AdmissionTTL:   getDurationEnv("WAITING_ROOM_ADMISSION_TTL", 10*time.Minute),
+		},
+		Payment: PaymentConfig{
+			Provider:      getEnv("PAYMENT_PROVIDER", "fake"),
+			WebhookSecret: getEnv("PAYMENT_WEBHOOK_SECRET", ""),
+			Timeout:       getDurationEnv("PAYMENT_TIMEOUT", 10*time.Second),
+		},
+		Outbox: OutboxConfig{
+			Sink:           getEnv("OUTBOX_SINK", "stdout"),
+			WebhookURL:     getEnv("OUTBOX_WEBHOOK_URL", ""),
+			WebhookSecret:  getEnv("OUTBOX_WEBHOOK_SECRET", ""),
+			WebhookTimeout: getDurationEnv("OUTBOX_WEBHOOK_TIMEOUT", 5*time.Second),
+			RedisStream:    getEnv("OUTBOX_REDIS_STREAM", "reservation-events"),
+			RelayInterval:  getDurationEnv("OUTBOX_RELAY_INTERVAL", 1*time.Second),
+			BatchSize:      getIntEnv("OUTBOX_BATCH_SIZE", 100),
+		},
+		EventCancellation: EventCancellationConfig{
+			BatchSize: getIntEnv("EVENT_CANCELLATION_BATCH_SIZE", 100),
+			Interval:  getDurationEnv("EVENT_CANCELLATION_INTERVAL", 5*time.Second),
+		},
+		Auth: AuthConfig{
+			JWTSecret:            getEnv("AUTH_JWT_SECRET", ""),
+			JWTPublicKeyFile:     getEnv("AUTH_JWT_PUBLIC_KEY_FILE", ""),
+			JWKSFile:             getEnv("AUTH_JWKS_FILE", ""),
+			JWTIssuer:            getEnv("AUTH_JWT_ISSUER", ""),
+			JWTAudience:          getEnv("AUTH_JWT_AUDIENCE", ""),
+			AllowUserIDHeader:    getBoolEnv("AUTH_ALLOW_USER_ID_HEADER", false),
+			AllowUserRolesHeader: getBoolEnv("AUTH_ALLOW_USER_ROLES_HEADER", false),
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:             getBoolEnv("RATE_LIMIT_ENABLED", true),
+			Requests:            getIntEnv("RATE_LIMIT_REQUESTS", 600),
+			Window:              getDurationEnv("RATE_LIMIT_WINDOW", 1*time.Minute),
+			ReservationRequests: getIntEnv("RATE_LIMIT_RESERVATION_REQUESTS", 20),
+			ReservationWindow:   getDurationEnv("RATE_LIMIT_RESERVATION_WINDOW", 1*time.Minute),
+		},
 	}
 
 	// DATABASE_URL が設定されている場合はパースして上書き（Railway対応）
@@ -123,6 +238,17 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getListEnv はカンマ区切りの環境変数を空要素を除いて返す
+func getListEnv(key string) []string {
+	var values []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
 func getIntEnv(key string, defaultValue int) int {
 	if value := os.Getenv(key); value != "" {
 		if i, err := strconv.Atoi(value); err == nil {
@@ -132,6 +258,15 @@ func getIntEnv(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getBoolEnv(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return defaultValue
+}
+
 func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
 		if d, err := time.ParseDuration(value); err == nil {
@@ -12,10 +12,13 @@ import (
 func TestLoad_DefaultValues(t *testing.T) {
 	// 環境変数をクリア
 	envVars := []string{
-		"PORT", "SERVER_READ_TIMEOUT", "SERVER_WRITE_TIMEOUT",
+		"PORT", "SERVER_READ_TIMEOUT", "SERVER_WRITE_TIMEOUT", "SERVER_TRUSTED_PROXIES",
 		"DB_HOST", "DB_PORT", "DB_USER", "DB_PASSWORD", "DB_NAME", "DB_SSLMODE",
 		"REDIS_HOST", "REDIS_PORT", "REDIS_PASSWORD", "REDIS_DB",
 		"DATABASE_URL", "REDIS_URL",
+		"WAITING_ROOM_ADMIT_BATCH_SIZE", "WAITING_ROOM_ADMIT_INTERVAL", "WAITING_ROOM_ADMISSION_TTL",
+		"RATE_LIMIT_ENABLED", "RATE_LIMIT_REQUESTS", "RATE_LIMIT_WINDOW",
+		"RATE_LIMIT_RESERVATION_REQUESTS", "RATE_LIMIT_RESERVATION_WINDOW",
 	}
 	for _, env := range envVars {
 		os.Unsetenv(env)
@@ -27,6 +30,8 @@ func TestLoad_DefaultValues(t *testing.T) {
 	assert.Equal(t, "8080", cfg.Server.Port)
 	assert.Equal(t, 30*time.Second, cfg.Server.ReadTimeout)
 	assert.Equal(t, 30*time.Second, cfg.Server.WriteTimeout)
+	assert.False(t, cfg.Server.RequireIfMatch)
+	assert.Empty(t, cfg.Server.TrustedProxies)
 
 	// Database defaults
 	assert.Equal(t, "localhost", cfg.Database.Host)
@@ -41,6 +46,39 @@ func TestLoad_DefaultValues(t *testing.T) {
 	assert.Equal(t, "6379", cfg.Redis.Port)
 	assert.Equal(t, "", cfg.Redis.Password)
 	assert.Equal(t, 0, cfg.Redis.DB)
+
+	// WaitingRoom defaults
+	assert.Equal(t, 100, cfg.WaitingRoom.AdmitBatchSize)
+	assert.Equal(t, 5*time.Second, cfg.WaitingRoom.AdmitInterval)
+	assert.Equal(t, 10*time.Minute, cfg.WaitingRoom.AdmissionTTL)
+
+	// Payment defaults
+	assert.Equal(t, "fake", cfg.Payment.Provider)
+	assert.Empty(t, cfg.Payment.WebhookSecret)
+	assert.Equal(t, 10*time.Second, cfg.Payment.Timeout)
+
+	// Outbox defaults
+	assert.Equal(t, "stdout", cfg.Outbox.Sink)
+	assert.Equal(t, "reservation-events", cfg.Outbox.RedisStream)
+	assert.Equal(t, 5*time.Second, cfg.Outbox.WebhookTimeout)
+	assert.Equal(t, 1*time.Second, cfg.Outbox.RelayInterval)
+	assert.Equal(t, 100, cfg.Outbox.BatchSize)
+
+	// EventCancellation defaults
+	assert.Equal(t, 100, cfg.EventCancellation.BatchSize)
+	assert.Equal(t, 5*time.Second, cfg.EventCancellation.Interval)
+
+	// Auth defaults
+	assert.Empty(t, cfg.Auth.JWTSecret)
+	assert.False(t, cfg.Auth.AllowUserIDHeader)
+	assert.False(t, cfg.Auth.AllowUserRolesHeader)
+
+	// RateLimit defaults
+	assert.True(t, cfg.RateLimit.Enabled)
+	assert.Equal(t, 600, cfg.RateLimit.Requests)
+	assert.Equal(t, 1*time.Minute, cfg.RateLimit.Window)
+	assert.Equal(t, 20, cfg.RateLimit.ReservationRequests)
+	assert.Equal(t, 1*time.Minute, cfg.RateLimit.ReservationWindow)
 }
 
 func TestLoad_CustomValues(t *testing.T) {
@@ -48,6 +86,8 @@ func TestLoad_CustomValues(t *testing.T) {
 	os.Setenv("PORT", "9090")
 	os.Setenv("SERVER_READ_TIMEOUT", "60s")
 	os.Setenv("SERVER_WRITE_TIMEOUT", "120s")
+	os.Setenv("SERVER_REQUIRE_IF_MATCH", "true")
+	os.Setenv("SERVER_TRUSTED_PROXIES", "10.0.0.0/8, 192.168.1.10/32,")
 	os.Setenv("DB_HOST", "db.example.com")
 	os.Setenv("DB_PORT", "5432")
 	os.Setenv("DB_USER", "testuser")
@@ -58,10 +98,14 @@ func TestLoad_CustomValues(t *testing.T) {
 	os.Setenv("REDIS_PORT", "6380")
 	os.Setenv("REDIS_PASSWORD", "redispass")
 	os.Setenv("REDIS_DB", "1")
+	os.Setenv("AUTH_ALLOW_USER_ID_HEADER", "true")
+	os.Setenv("AUTH_ALLOW_USER_ROLES_HEADER", "true")
 	defer func() {
 		os.Unsetenv("PORT")
 		os.Unsetenv("SERVER_READ_TIMEOUT")
 		os.Unsetenv("SERVER_WRITE_TIMEOUT")
+		os.Unsetenv("SERVER_REQUIRE_IF_MATCH")
+		os.Unsetenv("SERVER_TRUSTED_PROXIES")
 		os.Unsetenv("DB_HOST")
 		os.Unsetenv("DB_PORT")
 		os.Unsetenv("DB_USER")
@@ -72,6 +116,8 @@ func TestLoad_CustomValues(t *testing.T) {
 		os.Unsetenv("REDIS_PORT")
 		os.Unsetenv("REDIS_PASSWORD")
 		os.Unsetenv("REDIS_DB")
+		os.Unsetenv("AUTH_ALLOW_USER_ID_HEADER")
+		os.Unsetenv("AUTH_ALLOW_USER_ROLES_HEADER")
 	}()
 
 	cfg := Load()
@@ -79,6 +125,8 @@ func TestLoad_CustomValues(t *testing.T) {
 	assert.Equal(t, "9090", cfg.Server.Port)
 	assert.Equal(t, 60*time.Second, cfg.Server.ReadTimeout)
 	assert.Equal(t, 120*time.Second, cfg.Server.WriteTimeout)
+	assert.True(t, cfg.Server.RequireIfMatch)
+	assert.Equal(t, []string{"10.0.0.0/8", "192.168.1.10/32"}, cfg.Server.TrustedProxies)
 	assert.Equal(t, "db.example.com", cfg.Database.Host)
 	assert.Equal(t, "5432", cfg.Database.Port)
 	assert.Equal(t, "testuser", cfg.Database.User)
@@ -89,6 +137,8 @@ func TestLoad_CustomValues(t *testing.T) {
 	assert.Equal(t, "6380", cfg.Redis.Port)
 	assert.Equal(t, "redispass", cfg.Redis.Password)
 	assert.Equal(t, 1, cfg.Redis.DB)
+	assert.True(t, cfg.Auth.AllowUserIDHeader)
+	assert.True(t, cfg.Auth.AllowUserRolesHeader)
 }
 
 func TestLoad_DatabaseURL(t *testing.T) {
@@ -0,0 +1,22 @@
+package audit
+
+import "context"
+
+// SystemActor はリクエストに紐付かない変更（ワーカーや未認証の Webhook など）の実行者
+const SystemActor = "system"
+
+type actorContextKey struct{}
+
+// WithActor は変更を行うユーザーIDをコンテキストに設定する
+// リポジトリは監査ログの実行者をこのコンテキストから取得する
+func WithActor(ctx context.Context, actorID string) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actorID)
+}
+
+// ActorFromContext は変更を行うユーザーIDを返す（設定されていない場合は SystemActor）
+func ActorFromContext(ctx context.Context) string {
+	if actorID, ok := ctx.Value(actorContextKey{}).(string); ok && actorID != "" {
+		return actorID
+	}
+	return SystemActor
+}
@@ -0,0 +1,57 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pagination"
+)
+
+// EntityType は監査ログを記録するエンティティの種別
+type EntityType string
+
+const (
+	EntityEvent       EntityType = "event"
+	EntitySeat        EntityType = "seat"
+	EntityReservation EntityType = "reservation"
+)
+
+// IsValid は監査ログを記録するエンティティの種別かを返す
+func (t EntityType) IsValid() bool {
+	switch t {
+	case EntityEvent, EntitySeat, EntityReservation:
+		return true
+	}
+	return false
+}
+
+// Action はエンティティに対する変更の種類
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete" // 論理削除（deleted_at の設定）
+)
+
+// Entry は監査ログの1件（追記のみで、更新・削除しない）
+// Before / After は変更前後の行の JSON（作成時の Before は nil）
+type Entry struct {
+	ID         string
+	EntityType EntityType
+	EntityID   string
+	Action     Action
+	ActorID    string // 変更したユーザーID（バックグラウンド処理は SystemActor）
+	Before     json.RawMessage
+	After      json.RawMessage
+	CreatedAt  time.Time
+}
+
+// ListFilter は監査ログの絞り込み条件
+// 1つのエンティティの履歴を新しい順に返し、After より前の記録から Limit 件取得する
+type ListFilter struct {
+	EntityType EntityType
+	EntityID   string
+	After      *pagination.Cursor
+	Limit      int
+}
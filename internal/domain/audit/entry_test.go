@@ -0,0 +1,28 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntityType_IsValid(t *testing.T) {
+	assert.True(t, EntityEvent.IsValid())
+	assert.True(t, EntitySeat.IsValid())
+	assert.True(t, EntityReservation.IsValid())
+	assert.False(t, EntityType("payment").IsValid())
+	assert.False(t, EntityType("").IsValid())
+}
+
+func TestActorFromContext(t *testing.T) {
+	t.Run("設定したユーザーIDを返す", func(t *testing.T) {
+		ctx := WithActor(context.Background(), "user-1")
+		assert.Equal(t, "user-1", ActorFromContext(ctx))
+	})
+
+	t.Run("未設定の場合はシステム", func(t *testing.T) {
+		assert.Equal(t, SystemActor, ActorFromContext(context.Background()))
+		assert.Equal(t, SystemActor, ActorFromContext(WithActor(context.Background(), "")))
+	})
+}
@@ -0,0 +1,9 @@
+package audit
+
+import "errors"
+
+// 監査ログのエラー定義
+var (
+	ErrInvalidEntityType = errors.New("監査ログのエンティティの種別が不正です")
+	ErrEntityIDRequired  = errors.New("監査ログのエンティティIDは必須です")
+)
@@ -0,0 +1,10 @@
+package audit
+
+import "context"
+
+// Repository は監査ログリポジトリのインターフェース
+// 記録は各エンティティのリポジトリが変更と同じトランザクションで行うため、ここでは参照のみを提供する
+type Repository interface {
+	// List はエンティティの監査ログを新しい順に取得する
+	List(ctx context.Context, filter ListFilter) ([]*Entry, error)
+}
@@ -0,0 +1,9 @@
+package auth
+
+import "errors"
+
+// 認可のエラー定義
+var (
+	ErrUnauthenticated  = errors.New("認証が必要です")
+	ErrPermissionDenied = errors.New("この操作を行う権限がありません")
+)
@@ -0,0 +1,97 @@
+package auth
+
+// Role はユーザーの役割
+type Role string
+
+const (
+	// RoleCustomer はチケットを予約する一般ユーザー（役割を持たないユーザーもこれとして扱う）
+	RoleCustomer Role = "customer"
+	// RoleOrganizer はイベントを作成・管理する主催者
+	RoleOrganizer Role = "organizer"
+	// RoleAdmin は全てのイベントと予約を操作できる管理者
+	RoleAdmin Role = "admin"
+	// RoleSupport は問い合わせ対応のため変更履歴（監査ログ）を参照できるサポート担当者
+	RoleSupport Role = "support"
+)
+
+// Principal は認証済みの呼び出し元
+type Principal struct {
+	UserID string
+	Roles  []Role
+}
+
+// NewPrincipal は新しいPrincipalを作成する
+func NewPrincipal(userID string, roles ...Role) Principal {
+	return Principal{UserID: userID, Roles: roles}
+}
+
+// IsAuthenticated はユーザーIDが特定されているかを返す
+func (p Principal) IsAuthenticated() bool {
+	return p.UserID != ""
+}
+
+// HasRole は指定した役割を持つかを返す
+func (p Principal) HasRole(role Role) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAdmin は管理者かを返す
+func (p Principal) IsAdmin() bool {
+	return p.HasRole(RoleAdmin)
+}
+
+// CanAccess は指定したユーザーが所有するリソースにアクセスできるかを返す
+// 所有者本人と管理者だけがアクセスできる
+func (p Principal) CanAccess(ownerID string) bool {
+	if !p.IsAuthenticated() {
+		return false
+	}
+	return p.UserID == ownerID || p.IsAdmin()
+}
+
+// IsOrganizer はイベントを作成できる主催者かを返す（管理者を含む）
+func (p Principal) IsOrganizer() bool {
+	return p.HasRole(RoleOrganizer) || p.IsAdmin()
+}
+
+// RequireOrganizer はイベントを作成できるかを検証する
+func (p Principal) RequireOrganizer() error {
+	if !p.IsAuthenticated() {
+		return ErrUnauthenticated
+	}
+	if !p.IsOrganizer() {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// RequireSupport は監査ログを参照できるかを検証する（サポート担当者と管理者のみ）
+func (p Principal) RequireSupport() error {
+	if !p.IsAuthenticated() {
+		return ErrUnauthenticated
+	}
+	if !p.HasRole(RoleSupport) && !p.IsAdmin() {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+// AuthorizeOrganizer は指定した主催者が所有するイベントを管理できるかを検証する
+// イベントを作成した主催者本人と管理者だけが管理できる
+func (p Principal) AuthorizeOrganizer(organizerID string) error {
+	if !p.IsAuthenticated() {
+		return ErrUnauthenticated
+	}
+	if p.IsAdmin() {
+		return nil
+	}
+	if !p.HasRole(RoleOrganizer) || p.UserID != organizerID {
+		return ErrPermissionDenied
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrincipal_CanAccess(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal Principal
+		ownerID   string
+		want      bool
+	}{
+		{"所有者本人", NewPrincipal("user-1"), "user-1", true},
+		{"他のユーザー", NewPrincipal("user-2"), "user-1", false},
+		{"管理者", NewPrincipal("admin-1", RoleAdmin), "user-1", true},
+		{"未認証", Principal{}, "", false},
+		{"ユーザーIDのない管理者ロール", Principal{Roles: []Role{RoleAdmin}}, "user-1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.principal.CanAccess(tt.ownerID))
+		})
+	}
+}
+
+func TestPrincipal_HasRole(t *testing.T) {
+	p := NewPrincipal("user-1", RoleAdmin)
+
+	assert.True(t, p.HasRole(RoleAdmin))
+	assert.True(t, p.IsAdmin())
+	assert.False(t, NewPrincipal("user-1").IsAdmin())
+}
+
+func TestPrincipal_AuthorizeOrganizer(t *testing.T) {
+	tests := []struct {
+		name        string
+		principal   Principal
+		organizerID string
+		want        error
+	}{
+		{"イベントを作成した主催者", NewPrincipal("org-1", RoleOrganizer), "org-1", nil},
+		{"別の主催者", NewPrincipal("org-2", RoleOrganizer), "org-1", ErrPermissionDenied},
+		{"主催者ロールのない作成者", NewPrincipal("org-1"), "org-1", ErrPermissionDenied},
+		{"一般ユーザー", NewPrincipal("user-1", RoleCustomer), "org-1", ErrPermissionDenied},
+		{"管理者", NewPrincipal("admin-1", RoleAdmin), "org-1", nil},
+		{"未認証", Principal{}, "org-1", ErrUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.principal.AuthorizeOrganizer(tt.organizerID), tt.want)
+		})
+	}
+}
+
+func TestPrincipal_RequireOrganizer(t *testing.T) {
+	assert.NoError(t, NewPrincipal("org-1", RoleOrganizer).RequireOrganizer())
+	assert.NoError(t, NewPrincipal("admin-1", RoleAdmin).RequireOrganizer())
+	assert.ErrorIs(t, NewPrincipal("user-1").RequireOrganizer(), ErrPermissionDenied)
+	assert.ErrorIs(t, Principal{}.RequireOrganizer(), ErrUnauthenticated)
+}
+
+func TestPrincipal_RequireSupport(t *testing.T) {
+	assert.NoError(t, NewPrincipal("support-1", RoleSupport).RequireSupport())
+	assert.NoError(t, NewPrincipal("admin-1", RoleAdmin).RequireSupport())
+	assert.ErrorIs(t, NewPrincipal("org-1", RoleOrganizer).RequireSupport(), ErrPermissionDenied)
+	assert.ErrorIs(t, Principal{}.RequireSupport(), ErrUnauthenticated)
+}
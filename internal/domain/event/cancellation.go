@@ -0,0 +1,62 @@
+package event
+
+import "time"
+
+// CancellationStatus はイベントの中止処理の状態を表す
+type CancellationStatus string
+
+const (
+	CancellationRunning   CancellationStatus = "running"
+	CancellationCompleted CancellationStatus = "completed"
+)
+
+// Cancellation はイベントの中止に伴う予約の取り消しと座席の解放の進捗を表す
+// 予約のバッチごとに進捗をコミットするため、処理が途中で止まっても未処理の予約から再開できる
+type Cancellation struct {
+	EventID string
+	Status  CancellationStatus
+
+	TotalReservations         int // 中止時点で取り消しが必要だった予約数（保留中・確定済み）
+	ProcessedReservations     int
+	CancelledReservations     int // キャンセルした保留中の予約数
+	RefundPendingReservations int // 返金待ちにした確定済みの予約数
+	ReleasedSeats             int
+
+	StartedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// NewCancellation は中止処理を開始する
+func NewCancellation(eventID string, totalReservations int) *Cancellation {
+	now := time.Now()
+	return &Cancellation{
+		EventID:           eventID,
+		Status:            CancellationRunning,
+		TotalReservations: totalReservations,
+		StartedAt:         now,
+		UpdatedAt:         now,
+	}
+}
+
+// IsCompleted は中止処理が完了しているかを返す
+func (c *Cancellation) IsCompleted() bool {
+	return c.Status == CancellationCompleted
+}
+
+// RecordBatch は1バッチ分の処理結果を進捗に加える
+func (c *Cancellation) RecordBatch(cancelled, refundPending, releasedSeats int) {
+	c.ProcessedReservations += cancelled + refundPending
+	c.CancelledReservations += cancelled
+	c.RefundPendingReservations += refundPending
+	c.ReleasedSeats += releasedSeats
+	c.UpdatedAt = time.Now()
+}
+
+// Complete は中止処理を完了にする
+func (c *Cancellation) Complete() {
+	now := time.Now()
+	c.Status = CancellationCompleted
+	c.UpdatedAt = now
+	c.CompletedAt = &now
+}
@@ -0,0 +1,24 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancellation(t *testing.T) {
+	c := NewCancellation("event-1", 5)
+	assert.Equal(t, CancellationRunning, c.Status)
+	assert.False(t, c.IsCompleted())
+
+	c.RecordBatch(2, 1, 4)
+	c.RecordBatch(1, 1, 3)
+	assert.Equal(t, 5, c.ProcessedReservations)
+	assert.Equal(t, 3, c.CancelledReservations)
+	assert.Equal(t, 2, c.RefundPendingReservations)
+	assert.Equal(t, 7, c.ReleasedSeats)
+
+	c.Complete()
+	assert.True(t, c.IsCompleted())
+	assert.NotNil(t, c.CompletedAt)
+}
@@ -1,6 +1,11 @@
 package event
 
-import "time"
+import (
+	"fmt"
+	"time"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
+)
 
 // Event はイベントエンティティを表す
 type Event struct {
@@ -11,24 +16,65 @@ type Event struct {
 	StartAt     time.Time
 	EndAt       time.Time
 	TotalSeats  int
+	Status      Status
+	// 販売期間（ゼロ値の場合は制限なし。終了を指定しない場合はイベント開始まで）
+	SalesStartAt      time.Time
+	SalesEndAt        time.Time
+	HoldDuration      time.Duration // 1回あたりの仮押さえ期間
+	MaxHoldDuration   time.Duration // 延長を含めた予約作成からの最大仮押さえ期間
+	MaxHoldExtensions int           // 仮押さえを延長できる回数
+	// 返金ポリシー（開始 FullRefundBefore 前まで全額、RefundCutoff 前まで PartialRefundPercent %、以降は返金不可）
+	FullRefundBefore     time.Duration
+	PartialRefundPercent int
+	RefundCutoff         time.Duration
+	// WaitingRoomEnabled は予約作成の前に待合室（仮想キュー）を通すかどうか
+	WaitingRoomEnabled bool
+	// 購入枚数の上限（0 は無制限）
+	MaxSeatsPerReservation int // 1回の予約で確保できる座席数
+	MaxSeatsPerUser        int // 1人のユーザーが仮押さえ中・確定済みの予約で保持できる座席数の合計
+	// OrganizerID はイベントを作成した主催者のユーザーID（未設定のイベントは管理者だけが管理できる）
+	OrganizerID string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	Version     int // 楽観的ロック用
 }
 
+// 仮押さえ設定のデフォルト値
+const (
+	DefaultHoldDuration      = 15 * time.Minute
+	DefaultMaxHoldDuration   = 30 * time.Minute
+	DefaultMaxHoldExtensions = 2
+)
+
+// 返金ポリシーのデフォルト値
+const (
+	DefaultFullRefundBefore     = 7 * 24 * time.Hour
+	DefaultPartialRefundPercent = 50
+	DefaultRefundCutoff         = 24 * time.Hour
+)
+
 // NewEvent は新しいイベントを作成する
 func NewEvent(name, description, venue string, startAt, endAt time.Time, totalSeats int) *Event {
 	now := time.Now()
 	return &Event{
-		Name:        name,
-		Description: description,
-		Venue:       venue,
-		StartAt:     startAt,
-		EndAt:       endAt,
-		TotalSeats:  totalSeats,
-		CreatedAt:   now,
-		UpdatedAt:   now,
-		Version:     0,
+		Name:              name,
+		Description:       description,
+		Venue:             venue,
+		StartAt:           startAt,
+		EndAt:             endAt,
+		TotalSeats:        totalSeats,
+		Status:            StatusDraft,
+		HoldDuration:      DefaultHoldDuration,
+		MaxHoldDuration:   DefaultMaxHoldDuration,
+		MaxHoldExtensions: DefaultMaxHoldExtensions,
+
+		FullRefundBefore:     DefaultFullRefundBefore,
+		PartialRefundPercent: DefaultPartialRefundPercent,
+		RefundCutoff:         DefaultRefundCutoff,
+
+		CreatedAt: now,
+		UpdatedAt: now,
+		Version:   0,
 	}
 }
 
@@ -43,15 +89,71 @@ func (e *Event) Validate() error {
 	if e.EndAt.Before(e.StartAt) {
 		return ErrInvalidEventTime
 	}
+	if !e.SalesStartAt.IsZero() && !e.SalesEndAt.IsZero() && !e.SalesStartAt.Before(e.SalesEndAt) {
+		return ErrInvalidSalesPeriod
+	}
+	if !e.SalesEndAt.IsZero() && e.SalesEndAt.After(e.StartAt) {
+		return ErrInvalidSalesPeriod
+	}
+	if e.HoldDuration < 0 || e.MaxHoldDuration < 0 || e.MaxHoldExtensions < 0 {
+		return ErrInvalidHoldPolicy
+	}
+	if e.MaxHoldDuration > 0 && e.MaxHoldDuration < e.HoldDuration {
+		return ErrInvalidHoldPolicy
+	}
+	if e.FullRefundBefore < 0 || e.RefundCutoff < 0 || e.FullRefundBefore < e.RefundCutoff {
+		return ErrInvalidRefundPolicy
+	}
+	if e.PartialRefundPercent < 0 || e.PartialRefundPercent > 100 {
+		return ErrInvalidRefundPolicy
+	}
+	if e.MaxSeatsPerReservation < 0 || e.MaxSeatsPerUser < 0 {
+		return ErrInvalidPurchaseLimit
+	}
+	if e.MaxSeatsPerReservation > 0 && e.MaxSeatsPerUser > 0 && e.MaxSeatsPerReservation > e.MaxSeatsPerUser {
+		return ErrInvalidPurchaseLimit
+	}
 	return nil
 }
 
+// CheckPurchaseLimit は購入枚数の上限を超えないかを検証する
+// held は同じユーザーが仮押さえ中・確定済みの予約で既に保持している座席数
+func (e *Event) CheckPurchaseLimit(requested, held int) error {
+	if e.MaxSeatsPerReservation > 0 && requested > e.MaxSeatsPerReservation {
+		return fmt.Errorf("%w: 1回の予約は%d席までです", ErrPurchaseLimitExceeded, e.MaxSeatsPerReservation)
+	}
+	if e.MaxSeatsPerUser > 0 && held+requested > e.MaxSeatsPerUser {
+		return fmt.Errorf("%w: 1人あたり%d席までです（予約済み%d席）", ErrPurchaseLimitExceeded, e.MaxSeatsPerUser, held)
+	}
+	return nil
+}
+
+// AuthorizeManagement はイベントの編集・座席追加・予約一覧の閲覧ができるかを検証する
+func (e *Event) AuthorizeManagement(p auth.Principal) error {
+	return p.AuthorizeOrganizer(e.OrganizerID)
+}
+
 // IsBookingOpen は予約受付中かを返す
+// 販売中（StatusOnSale）で、販売期間内かつイベント開始前の場合に予約できる
 func (e *Event) IsBookingOpen() bool {
+	if e.Status != StatusOnSale {
+		return false
+	}
 	now := time.Now()
+	if !e.SalesStartAt.IsZero() && now.Before(e.SalesStartAt) {
+		return false
+	}
+	if !e.SalesEndAt.IsZero() && !now.Before(e.SalesEndAt) {
+		return false
+	}
 	return now.Before(e.StartAt)
 }
 
+// IsPublic は一覧・検索に表示するイベントかを返す（下書きは主催者以外に見せない）
+func (e *Event) IsPublic() bool {
+	return e.Status != StatusDraft
+}
+
 // HasStarted はイベントが開始済みかを返す
 func (e *Event) HasStarted() bool {
 	return time.Now().After(e.StartAt)
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/auth"
 )
 
 func TestNewEvent(t *testing.T) {
@@ -28,6 +30,10 @@ func TestNewEvent(t *testing.T) {
 	assert.Equal(t, endAt, event.EndAt)
 	assert.Equal(t, totalSeats, event.TotalSeats)
 	assert.Equal(t, 0, event.Version)
+	assert.Equal(t, StatusDraft, event.Status)
+	assert.Equal(t, DefaultHoldDuration, event.HoldDuration)
+	assert.Equal(t, DefaultMaxHoldDuration, event.MaxHoldDuration)
+	assert.Equal(t, DefaultMaxHoldExtensions, event.MaxHoldExtensions)
 	assert.NotZero(t, event.CreatedAt)
 	assert.NotZero(t, event.UpdatedAt)
 }
@@ -88,6 +94,98 @@ func TestEvent_Validate(t *testing.T) {
 			},
 			expectedErr: ErrInvalidEventTime,
 		},
+		{
+			name: "最大仮押さえ期間が仮押さえ期間より短い",
+			event: &Event{
+				Name:            "テストイベント",
+				TotalSeats:      100,
+				StartAt:         time.Now(),
+				EndAt:           time.Now().Add(1 * time.Hour),
+				HoldDuration:    15 * time.Minute,
+				MaxHoldDuration: 10 * time.Minute,
+			},
+			expectedErr: ErrInvalidHoldPolicy,
+		},
+		{
+			name: "延長回数が負",
+			event: &Event{
+				Name:              "テストイベント",
+				TotalSeats:        100,
+				StartAt:           time.Now(),
+				EndAt:             time.Now().Add(1 * time.Hour),
+				MaxHoldExtensions: -1,
+			},
+			expectedErr: ErrInvalidHoldPolicy,
+		},
+		{
+			name: "全額返金期限が返金締切より遅い",
+			event: &Event{
+				Name:             "テストイベント",
+				TotalSeats:       100,
+				StartAt:          time.Now(),
+				EndAt:            time.Now().Add(1 * time.Hour),
+				FullRefundBefore: 24 * time.Hour,
+				RefundCutoff:     48 * time.Hour,
+			},
+			expectedErr: ErrInvalidRefundPolicy,
+		},
+		{
+			name: "部分返金の割合が100%超",
+			event: &Event{
+				Name:                 "テストイベント",
+				TotalSeats:           100,
+				StartAt:              time.Now(),
+				EndAt:                time.Now().Add(1 * time.Hour),
+				PartialRefundPercent: 120,
+			},
+			expectedErr: ErrInvalidRefundPolicy,
+		},
+		{
+			name: "購入枚数の上限が負",
+			event: &Event{
+				Name:            "テストイベント",
+				TotalSeats:      100,
+				StartAt:         time.Now(),
+				EndAt:           time.Now().Add(1 * time.Hour),
+				MaxSeatsPerUser: -1,
+			},
+			expectedErr: ErrInvalidPurchaseLimit,
+		},
+		{
+			name: "1回の予約の上限が1人あたりの上限より大きい",
+			event: &Event{
+				Name:                   "テストイベント",
+				TotalSeats:             100,
+				StartAt:                time.Now(),
+				EndAt:                  time.Now().Add(1 * time.Hour),
+				MaxSeatsPerReservation: 6,
+				MaxSeatsPerUser:        4,
+			},
+			expectedErr: ErrInvalidPurchaseLimit,
+		},
+		{
+			name: "販売終了が販売開始より前",
+			event: &Event{
+				Name:         "テストイベント",
+				TotalSeats:   100,
+				StartAt:      time.Now().Add(48 * time.Hour),
+				EndAt:        time.Now().Add(49 * time.Hour),
+				SalesStartAt: time.Now().Add(24 * time.Hour),
+				SalesEndAt:   time.Now().Add(12 * time.Hour),
+			},
+			expectedErr: ErrInvalidSalesPeriod,
+		},
+		{
+			name: "販売終了がイベント開始より後",
+			event: &Event{
+				Name:       "テストイベント",
+				TotalSeats: 100,
+				StartAt:    time.Now().Add(48 * time.Hour),
+				EndAt:      time.Now().Add(49 * time.Hour),
+				SalesEndAt: time.Now().Add(48*time.Hour + time.Minute),
+			},
+			expectedErr: ErrInvalidSalesPeriod,
+		},
 	}
 
 	for _, tt := range tests {
@@ -103,28 +201,122 @@ func TestEvent_Validate(t *testing.T) {
 	}
 }
 
+func TestEvent_CheckPurchaseLimit(t *testing.T) {
+	e := &Event{MaxSeatsPerReservation: 4, MaxSeatsPerUser: 6}
+
+	tests := []struct {
+		name      string
+		requested int
+		held      int
+		wantErr   bool
+	}{
+		{"上限内", 4, 2, false},
+		{"1回の予約の上限を超える", 5, 0, true},
+		{"1人あたりの上限を超える", 3, 4, true},
+		{"1人あたりの上限ちょうど", 2, 4, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := e.CheckPurchaseLimit(tt.requested, tt.held)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrPurchaseLimitExceeded)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("上限が0の場合は無制限", func(t *testing.T) {
+		assert.NoError(t, (&Event{}).CheckPurchaseLimit(100, 100))
+	})
+}
+
+func TestEvent_AuthorizeManagement(t *testing.T) {
+	e := &Event{ID: "event-1", OrganizerID: "org-1"}
+
+	assert.NoError(t, e.AuthorizeManagement(auth.NewPrincipal("org-1", auth.RoleOrganizer)))
+	assert.NoError(t, e.AuthorizeManagement(auth.NewPrincipal("admin-1", auth.RoleAdmin)))
+	assert.ErrorIs(t, e.AuthorizeManagement(auth.NewPrincipal("org-2", auth.RoleOrganizer)), auth.ErrPermissionDenied)
+	assert.ErrorIs(t, e.AuthorizeManagement(auth.Principal{}), auth.ErrUnauthenticated)
+
+	t.Run("主催者が未設定のイベントは管理者だけが管理できる", func(t *testing.T) {
+		legacy := &Event{ID: "event-2"}
+		assert.ErrorIs(t, legacy.AuthorizeManagement(auth.NewPrincipal("org-1", auth.RoleOrganizer)), auth.ErrPermissionDenied)
+		assert.NoError(t, legacy.AuthorizeManagement(auth.NewPrincipal("admin-1", auth.RoleAdmin)))
+	})
+}
+
 func TestEvent_IsBookingOpen(t *testing.T) {
+	now := time.Now()
 	tests := []struct {
-		name     string
-		startAt  time.Time
-		expected bool
+		name         string
+		status       Status
+		startAt      time.Time
+		salesStartAt time.Time
+		salesEndAt   time.Time
+		expected     bool
 	}{
 		{
-			name:     "未来のイベント - 予約受付中",
-			startAt:  time.Now().Add(24 * time.Hour),
+			name:     "販売中の未来のイベント - 予約受付中",
+			status:   StatusOnSale,
+			startAt:  now.Add(24 * time.Hour),
 			expected: true,
 		},
 		{
 			name:     "過去のイベント - 予約受付終了",
-			startAt:  time.Now().Add(-1 * time.Hour),
+			status:   StatusOnSale,
+			startAt:  now.Add(-1 * time.Hour),
+			expected: false,
+		},
+		{
+			name:     "公開済み（販売前） - 予約不可",
+			status:   StatusPublished,
+			startAt:  now.Add(24 * time.Hour),
+			expected: false,
+		},
+		{
+			name:     "下書き - 予約不可",
+			status:   StatusDraft,
+			startAt:  now.Add(24 * time.Hour),
+			expected: false,
+		},
+		{
+			name:     "販売終了 - 予約不可",
+			status:   StatusClosed,
+			startAt:  now.Add(24 * time.Hour),
 			expected: false,
 		},
+		{
+			name:         "販売開始前 - 予約不可",
+			status:       StatusOnSale,
+			startAt:      now.Add(24 * time.Hour),
+			salesStartAt: now.Add(time.Hour),
+			expected:     false,
+		},
+		{
+			name:         "販売期間内 - 予約受付中",
+			status:       StatusOnSale,
+			startAt:      now.Add(24 * time.Hour),
+			salesStartAt: now.Add(-time.Hour),
+			salesEndAt:   now.Add(time.Hour),
+			expected:     true,
+		},
+		{
+			name:       "販売期間終了後 - 予約不可",
+			status:     StatusOnSale,
+			startAt:    now.Add(24 * time.Hour),
+			salesEndAt: now.Add(-time.Minute),
+			expected:   false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			event := &Event{
-				StartAt: tt.startAt,
+				Status:       tt.status,
+				StartAt:      tt.startAt,
+				SalesStartAt: tt.salesStartAt,
+				SalesEndAt:   tt.salesEndAt,
 			}
 			assert.Equal(t, tt.expected, event.IsBookingOpen())
 		})
@@ -4,10 +4,23 @@ import "errors"
 
 // Event ドメインのエラー定義
 var (
-	ErrEventNotFound          = errors.New("イベントが見つかりません")
-	ErrEventNameRequired      = errors.New("イベント名は必須です")
-	ErrInvalidTotalSeats      = errors.New("座席数は1以上である必要があります")
-	ErrInvalidEventTime       = errors.New("終了時刻は開始時刻より後である必要があります")
-	ErrEventNotOpen           = errors.New("イベントの予約受付期間外です")
-	ErrOptimisticLockConflict = errors.New("楽観的ロックの競合が発生しました")
+	ErrEventNotFound              = errors.New("イベントが見つかりません")
+	ErrEventNameRequired          = errors.New("イベント名は必須です")
+	ErrInvalidTotalSeats          = errors.New("座席数は1以上である必要があります")
+	ErrInvalidEventTime           = errors.New("終了時刻は開始時刻より後である必要があります")
+	ErrEventNotOpen               = errors.New("イベントの予約受付期間外です")
+	ErrOptimisticLockConflict     = errors.New("楽観的ロックの競合が発生しました")
+	ErrInvalidHoldPolicy          = errors.New("仮押さえ設定が不正です")
+	ErrInvalidRefundPolicy        = errors.New("返金ポリシーが不正です")
+	ErrInvalidPurchaseLimit       = errors.New("購入枚数の上限設定が不正です")
+	ErrPurchaseLimitExceeded      = errors.New("購入枚数の上限を超えています")
+	ErrInvalidSearchQuery         = errors.New("検索キーワードは1文字以上100文字以下で指定してください")
+	ErrInvalidStatusTransition    = errors.New("イベントの状態を変更できません")
+	ErrInvalidSalesPeriod         = errors.New("販売期間が不正です")
+	ErrCancellationNotFound       = errors.New("イベントの中止処理が見つかりません")
+	ErrCancellationExists         = errors.New("イベントの中止処理は既に開始されています")
+	ErrVersionMismatch            = errors.New("イベントは他のリクエストによって更新されています")
+	ErrTotalSeatsBelowCreated     = errors.New("座席数は作成済みの座席数より少なくできません")
+	ErrEventHasActiveReservations = errors.New("予約が残っているイベントは削除できません。イベントを中止してください")
+	ErrEventCancelled             = errors.New("イベントは中止されています")
 )
@@ -1,6 +1,24 @@
 package event
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pagination"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/transaction"
+)
+
+// ListFilter はイベント一覧の絞り込み条件とページの位置
+type ListFilter struct {
+	PublicOnly bool       // 下書きを除く
+	StartFrom  *time.Time // 開始日時がこの日時以降
+	StartTo    *time.Time // 開始日時がこの日時より前
+	Venue      string     // 会場（完全一致）
+	Query      string     // イベント名の部分一致（大文字・小文字を区別しない）
+
+	After *pagination.Cursor // このカーソルより後（古い）のイベントから返す。nil の場合は先頭から
+	Limit int
+}
 
 // Repository はイベントリポジトリのインターフェース
 type Repository interface {
@@ -10,12 +28,35 @@ type Repository interface {
 	// GetByID はIDからイベントを取得する
 	GetByID(ctx context.Context, id string) (*Event, error)
 
-	// List はイベント一覧を取得する
-	List(ctx context.Context, limit, offset int) ([]*Event, error)
+	// List はイベント一覧を作成日時の新しい順（created_at, id の降順）に取得する
+	List(ctx context.Context, filter ListFilter) ([]*Event, error)
+
+	// Search はキーワードに一致するイベントを関連度の高い順に取得する
+	Search(ctx context.Context, filter SearchFilter) ([]*SearchResult, error)
 
 	// Update はイベントを更新する（楽観的ロック）
+	// 総座席数を作成済みの座席数より減らす場合は ErrTotalSeatsBelowCreated を返す（座席の作成と同じイベントの行ロックの下で確認する）
 	Update(ctx context.Context, event *Event) error
 
 	// Delete はイベントを削除する
 	Delete(ctx context.Context, id string) error
 }
+
+// CancellationRepository はイベントの中止処理の進捗を保存するリポジトリのインターフェース
+type CancellationRepository interface {
+	// Create は中止処理を作成する（既に存在する場合は ErrCancellationExists）
+	Create(ctx context.Context, cancellation *Cancellation) error
+
+	// GetByEventID はイベントの中止処理を取得する
+	GetByEventID(ctx context.Context, eventID string) (*Cancellation, error)
+
+	// GetForUpdate はイベントの中止処理を行ロック付きで取得する（トランザクション必須）
+	// 複数のワーカーが同じイベントのバッチを同時に処理しないよう、トランザクション終了までロックを保持する
+	GetForUpdate(ctx context.Context, tx transaction.Tx, eventID string) (*Cancellation, error)
+
+	// Update は中止処理の進捗を更新する（トランザクション必須）
+	Update(ctx context.Context, tx transaction.Tx, cancellation *Cancellation) error
+
+	// ListRunning は完了していない中止処理を開始日時の古い順に取得する
+	ListRunning(ctx context.Context) ([]*Cancellation, error)
+}
@@ -0,0 +1,36 @@
+package event
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxSearchQueryLength は検索キーワードの最大文字数
+const MaxSearchQueryLength = 100
+
+// SearchFilter はイベント検索の条件
+type SearchFilter struct {
+	PublicOnly      bool       // 下書きを除く
+	Query           string     // 検索キーワード（空白区切りの語は全て含むものを返す）
+	StartAfter      *time.Time // 開始日時がこの日時より後のイベントだけを返す（nil の場合は全て）
+	HasAvailability bool       // 空席があるイベントだけを返す
+	Limit           int
+}
+
+// SearchResult はイベント検索の結果
+type SearchResult struct {
+	Event *Event
+	Rank  float64 // 関連度（大きいほど検索キーワードに近い）
+	// Snippet は一致した箇所の抜粋。HTML エスケープ済みで、一致した語を <mark> で囲む
+	Snippet string
+}
+
+// NormalizeSearchQuery は検索キーワードの前後の空白を除き、長さを検証する
+func NormalizeSearchQuery(q string) (string, error) {
+	q = strings.Join(strings.Fields(q), " ")
+	if q == "" || utf8.RuneCountInString(q) > MaxSearchQueryLength {
+		return "", ErrInvalidSearchQuery
+	}
+	return q, nil
+}
@@ -0,0 +1,29 @@
+package event
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSearchQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    string
+		wantErr error
+	}{
+		{"前後と連続する空白を詰める", "  東京   ライブ ", "東京 ライブ", nil},
+		{"空", "   ", "", ErrInvalidSearchQuery},
+		{"上限ちょうど", strings.Repeat("あ", MaxSearchQueryLength), strings.Repeat("あ", MaxSearchQueryLength), nil},
+		{"上限超過", strings.Repeat("あ", MaxSearchQueryLength+1), "", ErrInvalidSearchQuery},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeSearchQuery(tt.query)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
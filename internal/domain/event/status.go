@@ -0,0 +1,70 @@
+package event
+
+import "fmt"
+
+// Status はイベントのライフサイクル上の状態
+type Status string
+
+const (
+	StatusDraft     Status = "draft"     // 下書き（一覧・検索に表示しない）
+	StatusPublished Status = "published" // 公開済み（販売前）
+	StatusOnSale    Status = "on_sale"   // 販売中（販売期間内であれば予約できる）
+	StatusClosed    Status = "closed"    // 販売終了
+	StatusCancelled Status = "cancelled" // 中止
+)
+
+// transitions は状態ごとに遷移できる状態
+// 販売終了したイベントは販売を再開できる。中止したイベントはどの状態にも戻せない
+var transitions = map[Status][]Status{
+	StatusDraft:     {StatusPublished, StatusCancelled},
+	StatusPublished: {StatusOnSale, StatusCancelled},
+	StatusOnSale:    {StatusClosed, StatusCancelled},
+	StatusClosed:    {StatusOnSale, StatusCancelled},
+}
+
+// IsValid は定義済みの状態かを返す
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusDraft, StatusPublished, StatusOnSale, StatusClosed, StatusCancelled:
+		return true
+	}
+	return false
+}
+
+// CanTransitionTo は to の状態に遷移できるかを返す
+func (s Status) CanTransitionTo(to Status) bool {
+	for _, next := range transitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Publish は下書きのイベントを公開する
+func (e *Event) Publish() error {
+	return e.transitionTo(StatusPublished)
+}
+
+// OpenSales は公開済み・販売終了のイベントの販売を開始する
+func (e *Event) OpenSales() error {
+	return e.transitionTo(StatusOnSale)
+}
+
+// CloseSales は販売中のイベントの販売を終了する
+func (e *Event) CloseSales() error {
+	return e.transitionTo(StatusClosed)
+}
+
+// Cancel はイベントを中止する
+func (e *Event) Cancel() error {
+	return e.transitionTo(StatusCancelled)
+}
+
+func (e *Event) transitionTo(to Status) error {
+	if !e.Status.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %s から %s には変更できません", ErrInvalidStatusTransition, e.Status, to)
+	}
+	e.Status = to
+	return nil
+}
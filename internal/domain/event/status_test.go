@@ -0,0 +1,50 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatus_CanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from, to Status
+		want     bool
+	}{
+		{StatusDraft, StatusPublished, true},
+		{StatusDraft, StatusOnSale, false},
+		{StatusDraft, StatusCancelled, true},
+		{StatusPublished, StatusOnSale, true},
+		{StatusPublished, StatusDraft, false},
+		{StatusOnSale, StatusClosed, true},
+		{StatusOnSale, StatusPublished, false},
+		{StatusClosed, StatusOnSale, true},
+		{StatusClosed, StatusCancelled, true},
+		{StatusCancelled, StatusOnSale, false},
+		{StatusCancelled, StatusDraft, false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.from)+"→"+string(tt.to), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.from.CanTransitionTo(tt.to))
+		})
+	}
+}
+
+func TestEvent_Lifecycle(t *testing.T) {
+	e := &Event{Status: StatusDraft}
+
+	require.NoError(t, e.Publish())
+	assert.Equal(t, StatusPublished, e.Status)
+	require.NoError(t, e.OpenSales())
+	assert.Equal(t, StatusOnSale, e.Status)
+	require.NoError(t, e.CloseSales())
+	assert.Equal(t, StatusClosed, e.Status)
+	require.NoError(t, e.Cancel())
+	assert.Equal(t, StatusCancelled, e.Status)
+
+	// 中止したイベントはどの状態にも戻せない
+	err := e.OpenSales()
+	assert.ErrorIs(t, err, ErrInvalidStatusTransition)
+	assert.Equal(t, StatusCancelled, e.Status)
+}
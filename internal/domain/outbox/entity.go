@@ -0,0 +1,83 @@
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AggregateReservation は予約集約の種別
+const AggregateReservation = "reservation"
+
+// 予約のドメインイベント種別
+const (
+	EventReservationCreated      = "reservation.created"
+	EventReservationConfirmed    = "reservation.confirmed"
+	EventReservationCancelled    = "reservation.cancelled"
+	EventReservationExpired      = "reservation.expired"
+	EventReservationRefunded     = "reservation.refunded"
+	EventReservationSeatsRemoved = "reservation.seats_removed"
+	// EventReservationRefundRequested はイベントの中止により確定済みの予約が返金待ちになったことを表す
+	EventReservationRefundRequested = "reservation.refund_requested"
+)
+
+// Message はアウトボックスに記録されたドメインイベントを表す
+// 業務データと同じトランザクションで記録し、リレーが発行順に配信する
+type Message struct {
+	ID            int64 // 発行順の連番（集約内の配信順序に使用）
+	AggregateType string
+	AggregateID   string
+	EventType     string
+	Payload       json.RawMessage
+	Attempts      int    // 配信を試行した回数
+	LastError     string // 直近の配信失敗の理由
+	CreatedAt     time.Time
+	PublishedAt   *time.Time
+}
+
+// NewMessage は新しいメッセージを作成する
+func NewMessage(aggregateType, aggregateID, eventType string, payload any) (*Message, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, ErrInvalidPayload
+	}
+	return &Message{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		EventType:     eventType,
+		Payload:       body,
+		CreatedAt:     time.Now(),
+	}, nil
+}
+
+// Validate はメッセージの検証を行う
+func (m *Message) Validate() error {
+	if m.AggregateType == "" || m.AggregateID == "" {
+		return ErrAggregateRequired
+	}
+	if m.EventType == "" {
+		return ErrEventTypeRequired
+	}
+	if !json.Valid(m.Payload) {
+		return ErrInvalidPayload
+	}
+	return nil
+}
+
+// IsPublished は配信済みかどうかを返す
+func (m *Message) IsPublished() bool {
+	return m.PublishedAt != nil
+}
+
+// MarkPublished は配信済みとして記録する
+func (m *Message) MarkPublished() {
+	now := time.Now()
+	m.Attempts++
+	m.LastError = ""
+	m.PublishedAt = &now
+}
+
+// RecordFailure は配信の失敗を記録する
+func (m *Message) RecordFailure(reason string) {
+	m.Attempts++
+	m.LastError = reason
+}
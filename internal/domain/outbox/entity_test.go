@@ -0,0 +1,56 @@
+package outbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMessage(t *testing.T) {
+	t.Run("ペイロードをJSONとして保持する", func(t *testing.T) {
+		m, err := NewMessage(AggregateReservation, "res-1", EventReservationCreated, map[string]int{"total_amount": 5000})
+
+		require.NoError(t, err)
+		require.NoError(t, m.Validate())
+		assert.JSONEq(t, `{"total_amount":5000}`, string(m.Payload))
+		assert.False(t, m.IsPublished())
+	})
+
+	t.Run("JSONに変換できないペイロードはエラー", func(t *testing.T) {
+		_, err := NewMessage(AggregateReservation, "res-1", EventReservationCreated, make(chan int))
+		assert.ErrorIs(t, err, ErrInvalidPayload)
+	})
+}
+
+func TestMessage_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     Message
+		wantErr error
+	}{
+		{"集約IDなし", Message{AggregateType: AggregateReservation, EventType: EventReservationCreated, Payload: []byte(`{}`)}, ErrAggregateRequired},
+		{"イベント種別なし", Message{AggregateType: AggregateReservation, AggregateID: "res-1", Payload: []byte(`{}`)}, ErrEventTypeRequired},
+		{"不正なペイロード", Message{AggregateType: AggregateReservation, AggregateID: "res-1", EventType: EventReservationCreated, Payload: []byte(`{`)}, ErrInvalidPayload},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.msg.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func TestMessage_DeliveryResult(t *testing.T) {
+	m, err := NewMessage(AggregateReservation, "res-1", EventReservationConfirmed, struct{}{})
+	require.NoError(t, err)
+
+	m.RecordFailure("connection refused")
+	assert.Equal(t, 1, m.Attempts)
+	assert.Equal(t, "connection refused", m.LastError)
+	assert.False(t, m.IsPublished())
+
+	m.MarkPublished()
+	assert.Equal(t, 2, m.Attempts)
+	assert.Empty(t, m.LastError)
+	assert.True(t, m.IsPublished())
+}
@@ -0,0 +1,11 @@
+package outbox
+
+import "errors"
+
+// Outbox ドメインのエラー定義
+var (
+	ErrAggregateRequired = errors.New("集約の種別とIDは必須です")
+	ErrEventTypeRequired = errors.New("イベント種別は必須です")
+	ErrInvalidPayload    = errors.New("イベントの内容が不正です")
+	ErrMessageNotFound   = errors.New("アウトボックスのメッセージが見つかりません")
+)
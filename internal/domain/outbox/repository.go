@@ -0,0 +1,19 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/transaction"
+)
+
+// Repository はアウトボックスリポジトリのインターフェース
+type Repository interface {
+	// Append はメッセージを記録する（トランザクション必須。業務データの更新と同じトランザクションで呼び出す）
+	Append(ctx context.Context, tx transaction.Tx, msg *Message) error
+
+	// GetUnpublished は未配信のメッセージを発行順に取得する
+	GetUnpublished(ctx context.Context, limit int) ([]*Message, error)
+
+	// Update は配信結果（配信日時・試行回数・失敗理由）を更新する
+	Update(ctx context.Context, msg *Message) error
+}
@@ -0,0 +1,11 @@
+package outbox
+
+import "context"
+
+// Sink はドメインイベントの配信先のインターフェース
+// リレーは配信に成功するまで同じメッセージを再送するため（at-least-once）、
+// 受信側はメッセージIDで重複を除外する必要がある
+type Sink interface {
+	Name() string
+	Publish(ctx context.Context, msg *Message) error
+}
@@ -0,0 +1,65 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidCursor はクライアントが送ったカーソルを解釈できない場合のエラー
+var ErrInvalidCursor = errors.New("カーソルが不正です")
+
+// 1ページの件数
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// Cursor はキーセットページネーションの位置（前のページの最後の行の created_at と id）
+// 一覧は (created_at, id) の降順で返し、次のページはこの位置より前の行から始める
+type Cursor struct {
+	CreatedAt time.Time `json:"c"`
+	ID        string    `json:"i"`
+}
+
+// Encode はカーソルをクライアントに返す不透明な文字列にする
+func (c Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor はクライアントが送ったカーソルを解釈する（空文字の場合は先頭ページとして nil を返す）
+func DecodeCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if c.CreatedAt.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	if _, err := uuid.Parse(c.ID); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// NormalizeLimit は1ページの件数を DefaultLimit〜MaxLimit に収める（0以下はデフォルト）
+func NormalizeLimit(limit int) int {
+	if limit <= 0 {
+		return DefaultLimit
+	}
+	if limit > MaxLimit {
+		return MaxLimit
+	}
+	return limit
+}
@@ -0,0 +1,24 @@
+package pagination
+
+// Page は一覧の1ページ
+type Page[T any] struct {
+	Items      []T
+	NextCursor string // 次のページがない場合は空
+	Limit      int
+}
+
+// HasMore は次のページがあるかを返す
+func (p *Page[T]) HasMore() bool {
+	return p.NextCursor != ""
+}
+
+// NewPage はリポジトリから limit+1 件まで取得した結果からページを作る
+// limit 件を超えて取得できた場合だけ、limit 件目の位置を次のページのカーソルにする
+func NewPage[T any](items []T, limit int, cursorOf func(T) Cursor) *Page[T] {
+	page := &Page[T]{Items: items, Limit: limit}
+	if len(items) > limit {
+		page.Items = items[:limit]
+		page.NextCursor = cursorOf(page.Items[limit-1]).Encode()
+	}
+	return page
+}
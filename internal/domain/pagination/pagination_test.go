@@ -0,0 +1,74 @@
+package pagination
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	c := Cursor{CreatedAt: time.Date(2025, 12, 6, 10, 0, 0, 123456000, time.UTC), ID: "550e8400-e29b-41d4-a716-446655440000"}
+
+	decoded, err := DecodeCursor(c.Encode())
+
+	require.NoError(t, err)
+	assert.True(t, c.CreatedAt.Equal(decoded.CreatedAt))
+	assert.Equal(t, c.ID, decoded.ID)
+}
+
+func TestDecodeCursor(t *testing.T) {
+	t.Run("空文字は先頭ページ", func(t *testing.T) {
+		c, err := DecodeCursor("")
+		require.NoError(t, err)
+		assert.Nil(t, c)
+	})
+
+	for name, s := range map[string]string{
+		"base64でない":  "!!!",
+		"JSONでない":    "bm90LWpzb24",
+		"時刻がない":      Cursor{ID: "550e8400-e29b-41d4-a716-446655440000"}.Encode(),
+		"IDがUUIDでない": Cursor{CreatedAt: time.Now(), ID: "1; DROP TABLE events"}.Encode(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := DecodeCursor(s)
+			assert.ErrorIs(t, err, ErrInvalidCursor)
+		})
+	}
+}
+
+func TestNormalizeLimit(t *testing.T) {
+	assert.Equal(t, DefaultLimit, NormalizeLimit(0))
+	assert.Equal(t, DefaultLimit, NormalizeLimit(-1))
+	assert.Equal(t, 10, NormalizeLimit(10))
+	assert.Equal(t, MaxLimit, NormalizeLimit(MaxLimit+1))
+}
+
+func TestNewPage(t *testing.T) {
+	base := time.Date(2025, 12, 6, 10, 0, 0, 0, time.UTC)
+	ids := []string{
+		"00000000-0000-0000-0000-000000000003",
+		"00000000-0000-0000-0000-000000000002",
+		"00000000-0000-0000-0000-000000000001",
+	}
+	cursorOf := func(id string) Cursor { return Cursor{CreatedAt: base, ID: id} }
+
+	t.Run("limit件を超えて取得できた場合は次のページがある", func(t *testing.T) {
+		page := NewPage(ids, 2, cursorOf)
+
+		assert.Equal(t, ids[:2], page.Items)
+		assert.True(t, page.HasMore())
+		next, err := DecodeCursor(page.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, ids[1], next.ID)
+	})
+
+	t.Run("limit件以下の場合は最後のページ", func(t *testing.T) {
+		page := NewPage(ids, 3, cursorOf)
+
+		assert.Equal(t, ids, page.Items)
+		assert.False(t, page.HasMore())
+		assert.Empty(t, page.NextCursor)
+	})
+}
@@ -0,0 +1,106 @@
+package payment
+
+import "time"
+
+// DefaultCurrency は決済に使用する通貨コード（ISO 4217）
+const DefaultCurrency = "JPY"
+
+// Status は決済の状態を表す
+type Status string
+
+const (
+	StatusPending    Status = "pending"    // プロバイダーへのオーソリ前
+	StatusAuthorized Status = "authorized" // 与信確保済み
+	StatusCaptured   Status = "captured"   // 売上確定済み
+	StatusFailed     Status = "failed"     // 拒否・タイムアウト等で失敗
+	StatusRefunded   Status = "refunded"   // 返金済み
+)
+
+// Payment は予約に紐づく決済を表す
+type Payment struct {
+	ID                string
+	ReservationID     string
+	Provider          string
+	ProviderPaymentID string // プロバイダー側の決済ID（オーソリ成功時に設定）
+	Amount            int
+	Currency          string
+	Status            Status
+	RefundedAmount    int
+	FailureReason     string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// NewPayment は新しい決済を作成する
+func NewPayment(reservationID, provider string, amount int) *Payment {
+	now := time.Now()
+	return &Payment{
+		ReservationID: reservationID,
+		Provider:      provider,
+		Amount:        amount,
+		Currency:      DefaultCurrency,
+		Status:        StatusPending,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// Validate は決済の妥当性を検証する
+func (p *Payment) Validate() error {
+	if p.ReservationID == "" {
+		return ErrReservationIDRequired
+	}
+	if p.Amount < 0 {
+		return ErrInvalidAmount
+	}
+	return nil
+}
+
+// Authorize はオーソリ成功を記録する
+func (p *Payment) Authorize(providerPaymentID string) error {
+	if p.Status != StatusPending {
+		return ErrInvalidStatusTransition
+	}
+	p.ProviderPaymentID = providerPaymentID
+	p.Status = StatusAuthorized
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// Capture は売上確定を記録する
+func (p *Payment) Capture() error {
+	if p.Status == StatusCaptured {
+		return nil
+	}
+	if p.Status != StatusAuthorized {
+		return ErrInvalidStatusTransition
+	}
+	p.Status = StatusCaptured
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// Fail は決済の失敗を記録する（売上確定済み・返金済みの決済は失敗にできない）
+func (p *Payment) Fail(reason string) error {
+	if p.Status == StatusCaptured || p.Status == StatusRefunded {
+		return ErrInvalidStatusTransition
+	}
+	p.Status = StatusFailed
+	p.FailureReason = reason
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// Refund は返金を記録する（一部返金の場合も状態は返金済みになる）
+func (p *Payment) Refund(amount int) error {
+	if p.Status != StatusCaptured && p.Status != StatusRefunded {
+		return ErrInvalidStatusTransition
+	}
+	if amount < 0 || p.RefundedAmount+amount > p.Amount {
+		return ErrInvalidAmount
+	}
+	p.RefundedAmount += amount
+	p.Status = StatusRefunded
+	p.UpdatedAt = time.Now()
+	return nil
+}
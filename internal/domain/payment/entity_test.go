@@ -0,0 +1,71 @@
+package payment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPayment(t *testing.T) {
+	p := NewPayment("res-1", "fake", 5000)
+
+	assert.Equal(t, "res-1", p.ReservationID)
+	assert.Equal(t, "fake", p.Provider)
+	assert.Equal(t, 5000, p.Amount)
+	assert.Equal(t, DefaultCurrency, p.Currency)
+	assert.Equal(t, StatusPending, p.Status)
+	assert.NoError(t, p.Validate())
+}
+
+func TestPayment_Validate(t *testing.T) {
+	assert.ErrorIs(t, (&Payment{Amount: 100}).Validate(), ErrReservationIDRequired)
+	assert.ErrorIs(t, (&Payment{ReservationID: "res-1", Amount: -1}).Validate(), ErrInvalidAmount)
+}
+
+func TestPayment_Lifecycle(t *testing.T) {
+	t.Run("オーソリ→売上確定→返金", func(t *testing.T) {
+		p := NewPayment("res-1", "fake", 5000)
+
+		require.NoError(t, p.Authorize("pay_1"))
+		assert.Equal(t, StatusAuthorized, p.Status)
+		assert.Equal(t, "pay_1", p.ProviderPaymentID)
+
+		require.NoError(t, p.Capture())
+		assert.Equal(t, StatusCaptured, p.Status)
+		// Webhookの重複通知を想定し、売上確定済みでもエラーにしない
+		require.NoError(t, p.Capture())
+
+		require.NoError(t, p.Refund(2000))
+		assert.Equal(t, StatusRefunded, p.Status)
+		assert.Equal(t, 2000, p.RefundedAmount)
+		require.NoError(t, p.Refund(3000))
+		assert.Equal(t, 5000, p.RefundedAmount)
+		assert.ErrorIs(t, p.Refund(1), ErrInvalidAmount)
+	})
+
+	t.Run("オーソリ前は売上確定できない", func(t *testing.T) {
+		p := NewPayment("res-1", "fake", 5000)
+		assert.ErrorIs(t, p.Capture(), ErrInvalidStatusTransition)
+	})
+
+	t.Run("売上確定前は返金できない", func(t *testing.T) {
+		p := NewPayment("res-1", "fake", 5000)
+		require.NoError(t, p.Authorize("pay_1"))
+		assert.ErrorIs(t, p.Refund(5000), ErrInvalidStatusTransition)
+	})
+
+	t.Run("失敗を記録できる", func(t *testing.T) {
+		p := NewPayment("res-1", "fake", 5000)
+		require.NoError(t, p.Fail(ErrPaymentDeclined.Error()))
+		assert.Equal(t, StatusFailed, p.Status)
+		assert.Equal(t, ErrPaymentDeclined.Error(), p.FailureReason)
+	})
+
+	t.Run("売上確定済みの決済は失敗にできない", func(t *testing.T) {
+		p := NewPayment("res-1", "fake", 5000)
+		require.NoError(t, p.Authorize("pay_1"))
+		require.NoError(t, p.Capture())
+		assert.ErrorIs(t, p.Fail("late failure"), ErrInvalidStatusTransition)
+	})
+}
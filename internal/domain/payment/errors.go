@@ -0,0 +1,15 @@
+package payment
+
+import "errors"
+
+var (
+	ErrPaymentNotFound         = errors.New("決済が見つかりません")
+	ErrReservationIDRequired   = errors.New("予約IDは必須です")
+	ErrInvalidAmount           = errors.New("決済金額が不正です")
+	ErrPaymentMethodRequired   = errors.New("決済手段が必要です")
+	ErrPaymentDeclined         = errors.New("決済が拒否されました")
+	ErrPaymentTimeout          = errors.New("決済プロバイダーの応答がタイムアウトしました")
+	ErrInvalidStatusTransition = errors.New("決済の状態を変更できません")
+	ErrInvalidWebhookSignature = errors.New("Webhookの署名が不正です")
+	ErrUnknownWebhookEvent     = errors.New("不明なWebhookイベントです")
+)
@@ -0,0 +1,54 @@
+package payment
+
+import "context"
+
+// AuthorizeRequest はオーソリ（与信確保）の要求を表す
+type AuthorizeRequest struct {
+	ReservationID  string
+	Amount         int
+	Currency       string
+	PaymentToken   string // クライアントでトークン化されたカード情報等
+	IdempotencyKey string
+}
+
+// RefundRequest は売上確定済みの決済の返金要求を表す
+type RefundRequest struct {
+	ProviderPaymentID string
+	Amount            int
+	IdempotencyKey    string // 同じキーの返金はプロバイダー側で1回だけ実行される
+}
+
+// WebhookEventType はプロバイダーから通知されるイベントの種類
+type WebhookEventType string
+
+const (
+	WebhookPaymentCaptured WebhookEventType = "payment.captured"
+	WebhookPaymentFailed   WebhookEventType = "payment.failed"
+	WebhookPaymentRefunded WebhookEventType = "payment.refunded"
+)
+
+// WebhookEvent は署名検証済みのWebhook通知を表す
+type WebhookEvent struct {
+	Type              WebhookEventType
+	ProviderPaymentID string
+	Reason            string
+}
+
+// Gateway は決済プロバイダーのポート
+// 実装はインフラ層に置き、アプリケーション層はこのインターフェースにのみ依存する
+type Gateway interface {
+	// Name はプロバイダー名を返す（決済レコードに記録する）
+	Name() string
+
+	// Authorize は与信を確保し、プロバイダー側の決済IDを返す
+	Authorize(ctx context.Context, req AuthorizeRequest) (string, error)
+
+	// Capture は与信を確保した決済の売上を確定する
+	Capture(ctx context.Context, providerPaymentID string, amount int) error
+
+	// Refund は売上確定済みの決済を返金する
+	Refund(ctx context.Context, req RefundRequest) error
+
+	// VerifyWebhook はWebhookの署名を検証し、通知内容を返す
+	VerifyWebhook(payload []byte, signature string) (*WebhookEvent, error)
+}
@@ -0,0 +1,31 @@
+package payment
+
+import (
+	"context"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/transaction"
+)
+
+// Repository は決済リポジトリのインターフェース
+type Repository interface {
+	// Create は新しい決済を作成する
+	Create(ctx context.Context, payment *Payment) error
+
+	// GetByID はIDから決済を取得する
+	GetByID(ctx context.Context, id string) (*Payment, error)
+
+	// GetByReservationID は予約の最新の決済を取得する
+	GetByReservationID(ctx context.Context, reservationID string) (*Payment, error)
+
+	// CountByReservationID は予約の決済の試行回数（決済レコードの件数）を返す
+	CountByReservationID(ctx context.Context, reservationID string) (int, error)
+
+	// GetByProviderPaymentID はプロバイダー側の決済IDから決済を取得する
+	GetByProviderPaymentID(ctx context.Context, provider, providerPaymentID string) (*Payment, error)
+
+	// Update は決済を更新する
+	Update(ctx context.Context, payment *Payment) error
+
+	// UpdateTx はトランザクション内で決済を更新する（予約の確定と同時に売上確定を記録する）
+	UpdateTx(ctx context.Context, tx transaction.Tx, payment *Payment) error
+}
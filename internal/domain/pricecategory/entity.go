@@ -0,0 +1,53 @@
+package pricecategory
+
+import (
+	"strings"
+	"time"
+)
+
+// DefaultCurrency は通貨未指定時に使用する通貨コード（ISO 4217）
+const DefaultCurrency = "JPY"
+
+// PriceCategory はイベントごとの価格カテゴリ（S席・A席など）を表す
+type PriceCategory struct {
+	ID        string
+	EventID   string
+	Name      string
+	Currency  string // ISO 4217 通貨コード
+	Amount    int    // 通貨の最小単位での金額
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewPriceCategory は新しい価格カテゴリを作成する
+func NewPriceCategory(eventID, name, currency string, amount int) *PriceCategory {
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	now := time.Now()
+	return &PriceCategory{
+		EventID:   eventID,
+		Name:      name,
+		Currency:  strings.ToUpper(currency),
+		Amount:    amount,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Validate は価格カテゴリの検証を行う
+func (c *PriceCategory) Validate() error {
+	if c.EventID == "" {
+		return ErrEventIDRequired
+	}
+	if c.Name == "" {
+		return ErrNameRequired
+	}
+	if len(c.Currency) != 3 {
+		return ErrInvalidCurrency
+	}
+	if c.Amount < 0 {
+		return ErrInvalidAmount
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package pricecategory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPriceCategory(t *testing.T) {
+	c := NewPriceCategory("event-1", "S席", "usd", 12000)
+
+	assert.Equal(t, "event-1", c.EventID)
+	assert.Equal(t, "S席", c.Name)
+	assert.Equal(t, "USD", c.Currency)
+	assert.Equal(t, 12000, c.Amount)
+	assert.NotZero(t, c.CreatedAt)
+	assert.NotZero(t, c.UpdatedAt)
+}
+
+func TestNewPriceCategory_DefaultCurrency(t *testing.T) {
+	c := NewPriceCategory("event-1", "A席", "", 8000)
+
+	assert.Equal(t, DefaultCurrency, c.Currency)
+}
+
+func TestPriceCategory_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		category    *PriceCategory
+		expectedErr error
+	}{
+		{
+			name:        "有効な価格カテゴリ",
+			category:    &PriceCategory{EventID: "event-1", Name: "S席", Currency: "JPY", Amount: 12000},
+			expectedErr: nil,
+		},
+		{
+			name:        "無料カテゴリ",
+			category:    &PriceCategory{EventID: "event-1", Name: "招待", Currency: "JPY", Amount: 0},
+			expectedErr: nil,
+		},
+		{
+			name:        "イベントIDが空",
+			category:    &PriceCategory{Name: "S席", Currency: "JPY", Amount: 12000},
+			expectedErr: ErrEventIDRequired,
+		},
+		{
+			name:        "名前が空",
+			category:    &PriceCategory{EventID: "event-1", Currency: "JPY", Amount: 12000},
+			expectedErr: ErrNameRequired,
+		},
+		{
+			name:        "通貨コードが不正",
+			category:    &PriceCategory{EventID: "event-1", Name: "S席", Currency: "YEN!", Amount: 12000},
+			expectedErr: ErrInvalidCurrency,
+		},
+		{
+			name:        "金額が負",
+			category:    &PriceCategory{EventID: "event-1", Name: "S席", Currency: "JPY", Amount: -1},
+			expectedErr: ErrInvalidAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.category.Validate()
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package pricecategory
+
+import "errors"
+
+// PriceCategory ドメインのエラー定義
+var (
+	ErrPriceCategoryNotFound = errors.New("価格カテゴリが見つかりません")
+	ErrEventIDRequired       = errors.New("イベントIDは必須です")
+	ErrNameRequired          = errors.New("価格カテゴリ名は必須です")
+	ErrInvalidCurrency       = errors.New("通貨コードが不正です")
+	ErrInvalidAmount         = errors.New("金額は0以上である必要があります")
+	ErrDuplicateName         = errors.New("同じ名前の価格カテゴリが既に存在します")
+	ErrPriceCategoryInUse    = errors.New("座席に割り当てられている価格カテゴリは削除できません")
+	ErrEventMismatch         = errors.New("価格カテゴリが別のイベントに属しています")
+	ErrCurrencyMismatch      = errors.New("異なる通貨の価格カテゴリを同一予約に含めることはできません")
+)
@@ -0,0 +1,21 @@
+package pricecategory
+
+import "context"
+
+// Repository は価格カテゴリリポジトリのインターフェース
+type Repository interface {
+	// Create は新しい価格カテゴリを作成する
+	Create(ctx context.Context, category *PriceCategory) error
+
+	// GetByID はIDから価格カテゴリを取得する
+	GetByID(ctx context.Context, id string) (*PriceCategory, error)
+
+	// GetByEventID はイベントIDから価格カテゴリ一覧を取得する
+	GetByEventID(ctx context.Context, eventID string) ([]*PriceCategory, error)
+
+	// Update は価格カテゴリを更新し、割り当て済み座席の価格にも反映する
+	Update(ctx context.Context, category *PriceCategory) error
+
+	// Delete は価格カテゴリを削除する（座席に割り当て済みの場合は削除不可）
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,27 @@
+package queue
+
+import "time"
+
+// Status は待合室チケットの状態を表す
+type Status string
+
+const (
+	StatusWaiting  Status = "waiting"  // 入場待ち
+	StatusAdmitted Status = "admitted" // 入場許可済み（予約作成が可能）
+	StatusExpired  Status = "expired"  // 入場許可の期限切れ（並び直しが必要）
+)
+
+// Ticket はイベントの待合室に並んだユーザーのチケットを表す
+type Ticket struct {
+	Token         string
+	EventID       string
+	UserID        string
+	Status        Status
+	Position      int        // 入場待ちの順位（1始まり。入場許可済みの場合は0）
+	AdmittedUntil *time.Time // 入場許可の有効期限
+}
+
+// IsAdmitted は入場許可が有効かを返す
+func (t *Ticket) IsAdmitted() bool {
+	return t.Status == StatusAdmitted && t.AdmittedUntil != nil && time.Now().Before(*t.AdmittedUntil)
+}
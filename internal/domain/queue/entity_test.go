@@ -0,0 +1,41 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTicket_IsAdmitted(t *testing.T) {
+	future := time.Now().Add(5 * time.Minute)
+	past := time.Now().Add(-1 * time.Second)
+
+	tests := []struct {
+		name     string
+		ticket   *Ticket
+		expected bool
+	}{
+		{
+			name:     "入場許可の有効期限内",
+			ticket:   &Ticket{Status: StatusAdmitted, AdmittedUntil: &future},
+			expected: true,
+		},
+		{
+			name:     "入場許可の有効期限切れ",
+			ticket:   &Ticket{Status: StatusAdmitted, AdmittedUntil: &past},
+			expected: false,
+		},
+		{
+			name:     "入場待ち",
+			ticket:   &Ticket{Status: StatusWaiting, Position: 3},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.ticket.IsAdmitted())
+		})
+	}
+}
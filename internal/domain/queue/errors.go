@@ -0,0 +1,10 @@
+package queue
+
+import "errors"
+
+// Queue ドメインのエラー定義
+var (
+	ErrTicketNotFound      = errors.New("待合室のチケットが見つかりません")
+	ErrNotAdmitted         = errors.New("待合室からの入場が許可されていません")
+	ErrWaitingRoomDisabled = errors.New("このイベントでは待合室は利用できません")
+)
@@ -9,19 +9,35 @@ const (
 	StatusPending   Status = "pending"
 	StatusConfirmed Status = "confirmed"
 	StatusCancelled Status = "cancelled"
+	StatusRefunded  Status = "refunded"
+	// StatusRefundPending はイベントの中止により返金が必要になった確定済みの予約
+	StatusRefundPending Status = "refund_pending"
 )
 
+// IsValid は定義済みの状態かを返す
+func (s Status) IsValid() bool {
+	switch s {
+	case StatusPending, StatusConfirmed, StatusCancelled, StatusRefunded, StatusRefundPending:
+		return true
+	}
+	return false
+}
+
 // Reservation は予約エンティティを表す
 type Reservation struct {
 	ID             string
 	EventID        string
 	UserID         string
 	SeatIDs        []string
+	SeatPrices     map[string]int // 座席ID → 予約時点の価格（部分キャンセルで外した座席の分だけを返金する）
 	Status         Status
 	IdempotencyKey string
 	ExpiresAt      time.Time
 	ConfirmedAt    *time.Time
 	TotalAmount    int
+	ExtensionCount int // 仮押さえを延長した回数
+	RefundedAmount int // 返金済みの合計額（座席の取り外しによる返金を含む。返金ポリシーにより支払額未満になりうる）
+	RefundedAt     *time.Time
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
@@ -29,6 +45,34 @@ type Reservation struct {
 // ReservationExpiration は予約の有効期限（デフォルト15分）
 const ReservationExpiration = 15 * time.Minute
 
+// HoldPolicy は仮押さえ期間と延長のルールを表す
+type HoldPolicy struct {
+	Duration      time.Duration // 1回あたりの仮押さえ期間
+	MaxDuration   time.Duration // 予約作成からの最大仮押さえ期間
+	MaxExtensions int           // 延長できる回数
+}
+
+// RefundPolicy は確定済み予約の返金ルールを表す
+// イベント開始の FullRefundBefore 前までは全額、RefundCutoff 前までは PartialPercent % を返金し、それ以降は返金しない
+type RefundPolicy struct {
+	FullRefundBefore time.Duration
+	RefundCutoff     time.Duration
+	PartialPercent   int
+}
+
+// RefundAmount は now 時点で返金する金額を返す
+func (p RefundPolicy) RefundAmount(total int, startAt, now time.Time) (int, error) {
+	remaining := startAt.Sub(now)
+	switch {
+	case remaining >= p.FullRefundBefore:
+		return total, nil
+	case remaining >= p.RefundCutoff:
+		return total * p.PartialPercent / 100, nil
+	default:
+		return 0, ErrRefundPeriodEnded
+	}
+}
+
 // NewReservation は新しい予約を作成する
 func NewReservation(eventID, userID, idempotencyKey string, seatIDs []string, totalAmount int) *Reservation {
 	now := time.Now()
@@ -45,6 +89,39 @@ func NewReservation(eventID, userID, idempotencyKey string, seatIDs []string, to
 	}
 }
 
+// ApplyHoldPolicy は作成時刻を起点に仮押さえ期間を設定する
+func (r *Reservation) ApplyHoldPolicy(policy HoldPolicy) {
+	if policy.Duration > 0 {
+		r.ExpiresAt = r.CreatedAt.Add(policy.Duration)
+	}
+}
+
+// Extend は仮押さえ期間を延長する
+// 有効期限を Duration だけ後ろにずらし、作成時刻 + MaxDuration を上限とする
+func (r *Reservation) Extend(policy HoldPolicy) error {
+	if r.Status != StatusPending {
+		return ErrReservationNotPending
+	}
+	if r.IsExpired() {
+		return ErrReservationExpired
+	}
+	if r.ExtensionCount >= policy.MaxExtensions {
+		return ErrHoldExtensionLimitReached
+	}
+	limit := r.CreatedAt.Add(policy.MaxDuration)
+	if !r.ExpiresAt.Before(limit) {
+		return ErrHoldMaxDurationReached
+	}
+	expiresAt := r.ExpiresAt.Add(policy.Duration)
+	if expiresAt.After(limit) {
+		expiresAt = limit
+	}
+	r.ExpiresAt = expiresAt
+	r.ExtensionCount++
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
 // IsExpired は予約が期限切れかを返す
 func (r *Reservation) IsExpired() bool {
 	return time.Now().After(r.ExpiresAt)
@@ -78,11 +155,113 @@ func (r *Reservation) Cancel() error {
 	if r.Status == StatusConfirmed {
 		return ErrReservationAlreadyConfirmed
 	}
+	if r.Status == StatusRefunded {
+		return ErrReservationAlreadyRefunded
+	}
+	if r.Status == StatusRefundPending {
+		return ErrReservationRefundPending
+	}
 	r.Status = StatusCancelled
 	r.UpdatedAt = time.Now()
 	return nil
 }
 
+// MarkForRefund はイベントの中止により確定済みの予約を返金待ちにする
+func (r *Reservation) MarkForRefund() error {
+	if r.Status != StatusConfirmed {
+		return ErrReservationNotConfirmed
+	}
+	r.Status = StatusRefundPending
+	r.UpdatedAt = time.Now()
+	return nil
+}
+
+// Refund は確定済みの予約を返金ポリシーに従って返金済みにする
+// 返金待ち（イベントの中止）の予約は返金ポリシーによらず全額を返金する
+// 返金額は座席の取り外しで返金済みの額に加算する
+func (r *Reservation) Refund(policy RefundPolicy, startAt time.Time) error {
+	if r.Status == StatusRefunded {
+		return ErrReservationAlreadyRefunded
+	}
+	if r.Status != StatusConfirmed && r.Status != StatusRefundPending {
+		return ErrReservationNotConfirmed
+	}
+	now := time.Now()
+	amount := r.TotalAmount
+	if r.Status == StatusConfirmed {
+		var err error
+		if amount, err = policy.RefundAmount(r.TotalAmount, startAt, now); err != nil {
+			return err
+		}
+	}
+	r.Status = StatusRefunded
+	r.RefundedAmount += amount
+	r.RefundedAt = &now
+	r.UpdatedAt = now
+	return nil
+}
+
+// RemoveSeats は予約から指定した座席を外し、外した座席IDを予約内の順序と返金額を返す
+// 合計金額からは外した座席の予約時点の価格を差し引く（予約後の価格改定は影響しない）
+// 確定済みの予約は外した座席の価格を返金ポリシーに従って返金済みの額に加え、返金期間を過ぎている場合は外せない
+func (r *Reservation) RemoveSeats(seatIDs []string, policy RefundPolicy, startAt time.Time) ([]string, int, error) {
+	switch r.Status {
+	case StatusCancelled:
+		return nil, 0, ErrReservationAlreadyCancelled
+	case StatusRefunded:
+		return nil, 0, ErrReservationAlreadyRefunded
+	case StatusRefundPending:
+		return nil, 0, ErrReservationRefundPending
+	case StatusPending:
+		if r.IsExpired() {
+			return nil, 0, ErrReservationExpired
+		}
+	}
+	if len(seatIDs) == 0 {
+		return nil, 0, ErrSeatIDsRequired
+	}
+
+	target := make(map[string]bool, len(seatIDs))
+	for _, id := range seatIDs {
+		target[id] = true
+	}
+	var removed, remaining []string
+	for _, id := range r.SeatIDs {
+		if target[id] {
+			removed = append(removed, id)
+			delete(target, id)
+		} else {
+			remaining = append(remaining, id)
+		}
+	}
+	if len(target) > 0 {
+		return nil, 0, ErrSeatNotInReservation
+	}
+	if len(remaining) == 0 {
+		return nil, 0, ErrCannotRemoveAllSeats
+	}
+	now := time.Now()
+	var reduction int
+	for _, id := range removed {
+		reduction += r.SeatPrices[id]
+	}
+	var refund int
+	if r.Status == StatusConfirmed {
+		var err error
+		if refund, err = policy.RefundAmount(reduction, startAt, now); err != nil {
+			return nil, 0, err
+		}
+	}
+	for _, id := range removed {
+		delete(r.SeatPrices, id)
+	}
+	r.TotalAmount -= reduction
+	r.RefundedAmount += refund
+	r.SeatIDs = remaining
+	r.UpdatedAt = now
+	return removed, refund, nil
+}
+
 // Validate は予約の検証を行う
 func (r *Reservation) Validate() error {
 	if r.EventID == "" {
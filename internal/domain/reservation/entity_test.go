@@ -93,6 +93,8 @@ func TestReservation_Cancel(t *testing.T) {
 		{"Pending状態からキャンセル", StatusPending, nil},
 		{"Cancelled状態からキャンセル", StatusCancelled, ErrReservationAlreadyCancelled},
 		{"Confirmed状態からキャンセル", StatusConfirmed, ErrReservationAlreadyConfirmed},
+		{"Refunded状態からキャンセル", StatusRefunded, ErrReservationAlreadyRefunded},
+		{"RefundPending状態からキャンセル", StatusRefundPending, ErrReservationRefundPending},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -124,8 +126,278 @@ func TestReservation_IsPending(t *testing.T) {
 	assert.False(t, r.IsPending())
 }
 
+func TestReservation_ApplyHoldPolicy(t *testing.T) {
+	r := createTestReservation(t)
+
+	r.ApplyHoldPolicy(HoldPolicy{Duration: 30 * time.Minute})
+
+	assert.Equal(t, r.CreatedAt.Add(30*time.Minute), r.ExpiresAt)
+}
+
+func TestReservation_Extend(t *testing.T) {
+	policy := HoldPolicy{Duration: 10 * time.Minute, MaxDuration: 30 * time.Minute, MaxExtensions: 2}
+
+	t.Run("有効期限を延長できる", func(t *testing.T) {
+		r := createTestReservation(t)
+		r.ApplyHoldPolicy(policy)
+		before := r.ExpiresAt
+
+		err := r.Extend(policy)
+
+		require.NoError(t, err)
+		assert.Equal(t, before.Add(10*time.Minute), r.ExpiresAt)
+		assert.Equal(t, 1, r.ExtensionCount)
+	})
+
+	t.Run("最大期間を超えない", func(t *testing.T) {
+		r := createTestReservation(t)
+		r.ExpiresAt = r.CreatedAt.Add(25 * time.Minute)
+
+		err := r.Extend(policy)
+
+		require.NoError(t, err)
+		assert.Equal(t, r.CreatedAt.Add(30*time.Minute), r.ExpiresAt)
+	})
+
+	t.Run("最大期間に達している場合はエラー", func(t *testing.T) {
+		r := createTestReservation(t)
+		r.ExpiresAt = r.CreatedAt.Add(30 * time.Minute)
+
+		err := r.Extend(policy)
+
+		assert.ErrorIs(t, err, ErrHoldMaxDurationReached)
+	})
+
+	t.Run("延長回数の上限に達している場合はエラー", func(t *testing.T) {
+		r := createTestReservation(t)
+		r.ExtensionCount = 2
+
+		err := r.Extend(policy)
+
+		assert.ErrorIs(t, err, ErrHoldExtensionLimitReached)
+	})
+
+	t.Run("保留中以外は延長できない", func(t *testing.T) {
+		r := createTestReservation(t)
+		r.Status = StatusConfirmed
+
+		err := r.Extend(policy)
+
+		assert.ErrorIs(t, err, ErrReservationNotPending)
+	})
+
+	t.Run("期限切れの予約は延長できない", func(t *testing.T) {
+		r := createTestReservation(t)
+		r.ExpiresAt = time.Now().Add(-1 * time.Minute)
+
+		err := r.Extend(policy)
+
+		assert.ErrorIs(t, err, ErrReservationExpired)
+	})
+}
+
 func createTestReservation(t *testing.T) *Reservation {
 	r := NewReservation("event-456", "user-123", "idem-key-1", []string{"seat-1"}, 10000)
 	require.NoError(t, r.Validate())
 	return r
 }
+
+func TestRefundPolicy_RefundAmount(t *testing.T) {
+	policy := RefundPolicy{
+		FullRefundBefore: 7 * 24 * time.Hour,
+		RefundCutoff:     24 * time.Hour,
+		PartialPercent:   50,
+	}
+	startAt := time.Now().Add(30 * 24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		now     time.Time
+		want    int
+		wantErr error
+	}{
+		{"全額返金期間", startAt.Add(-10 * 24 * time.Hour), 10000, nil},
+		{"全額返金期間の最終時刻", startAt.Add(-7 * 24 * time.Hour), 10000, nil},
+		{"部分返金期間", startAt.Add(-3 * 24 * time.Hour), 5000, nil},
+		{"返金締切後", startAt.Add(-12 * time.Hour), 0, ErrRefundPeriodEnded},
+		{"開始後", startAt.Add(1 * time.Hour), 0, ErrRefundPeriodEnded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := policy.RefundAmount(10000, startAt, tt.now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReservation_Refund(t *testing.T) {
+	policy := RefundPolicy{FullRefundBefore: 7 * 24 * time.Hour, RefundCutoff: 24 * time.Hour, PartialPercent: 50}
+
+	t.Run("確定済みの予約を返金できる", func(t *testing.T) {
+		r := createTestReservation(t)
+		r.Status = StatusConfirmed
+		r.TotalAmount = 10000
+
+		require.NoError(t, r.Refund(policy, time.Now().Add(3*24*time.Hour)))
+		assert.Equal(t, StatusRefunded, r.Status)
+		assert.Equal(t, 5000, r.RefundedAmount)
+		assert.NotNil(t, r.RefundedAt)
+
+		assert.ErrorIs(t, r.Refund(policy, time.Now().Add(3*24*time.Hour)), ErrReservationAlreadyRefunded)
+	})
+
+	t.Run("保留中の予約は返金できない", func(t *testing.T) {
+		r := createTestReservation(t)
+		assert.ErrorIs(t, r.Refund(policy, time.Now().Add(30*24*time.Hour)), ErrReservationNotConfirmed)
+	})
+
+	t.Run("返金期限を過ぎた予約は返金できない", func(t *testing.T) {
+		r := createTestReservation(t)
+		r.Status = StatusConfirmed
+		r.TotalAmount = 10000
+		assert.ErrorIs(t, r.Refund(policy, time.Now().Add(time.Hour)), ErrRefundPeriodEnded)
+		assert.Equal(t, StatusConfirmed, r.Status)
+	})
+
+	t.Run("返金待ちの予約は返金期限によらず全額返金する", func(t *testing.T) {
+		r := createTestReservation(t)
+		r.Status = StatusRefundPending
+		r.TotalAmount = 10000
+
+		require.NoError(t, r.Refund(policy, time.Now().Add(time.Hour)))
+		assert.Equal(t, StatusRefunded, r.Status)
+		assert.Equal(t, 10000, r.RefundedAmount)
+	})
+
+	t.Run("座席の取り外しで返金済みの額に加算する", func(t *testing.T) {
+		r := NewReservation("event-456", "user-123", "idem-key-1", []string{"seat-1", "seat-2"}, 20000)
+		r.SeatPrices = map[string]int{"seat-1": 8000, "seat-2": 12000}
+		r.Status = StatusConfirmed
+		startAt := time.Now().Add(30 * 24 * time.Hour)
+		_, _, err := r.RemoveSeats([]string{"seat-2"}, policy, startAt)
+		require.NoError(t, err)
+
+		require.NoError(t, r.Refund(policy, startAt))
+		assert.Equal(t, 20000, r.RefundedAmount)
+	})
+}
+
+func TestReservation_MarkForRefund(t *testing.T) {
+	t.Run("確定済みの予約を返金待ちにできる", func(t *testing.T) {
+		r := createTestReservation(t)
+		r.Status = StatusConfirmed
+
+		require.NoError(t, r.MarkForRefund())
+		assert.Equal(t, StatusRefundPending, r.Status)
+	})
+
+	for _, status := range []Status{StatusPending, StatusCancelled, StatusRefunded, StatusRefundPending} {
+		t.Run(string(status)+"の予約は返金待ちにできない", func(t *testing.T) {
+			r := createTestReservation(t)
+			r.Status = status
+
+			assert.ErrorIs(t, r.MarkForRefund(), ErrReservationNotConfirmed)
+			assert.Equal(t, status, r.Status)
+		})
+	}
+}
+
+func TestReservation_RemoveSeats(t *testing.T) {
+	newReservation := func(t *testing.T) *Reservation {
+		r := NewReservation("event-456", "user-123", "idem-key-1", []string{"seat-1", "seat-2", "seat-3"}, 30000)
+		r.SeatPrices = map[string]int{"seat-1": 8000, "seat-2": 10000, "seat-3": 12000}
+		require.NoError(t, r.Validate())
+		return r
+	}
+	policy := RefundPolicy{FullRefundBefore: 7 * 24 * time.Hour, RefundCutoff: 24 * time.Hour, PartialPercent: 50}
+	startAt := time.Now().Add(30 * 24 * time.Hour)
+
+	t.Run("指定した座席だけを外せる", func(t *testing.T) {
+		r := newReservation(t)
+
+		removed, refund, err := r.RemoveSeats([]string{"seat-3", "seat-1", "seat-1"}, policy, startAt)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"seat-1", "seat-3"}, removed)
+		assert.Equal(t, []string{"seat-2"}, r.SeatIDs)
+		// 外した座席の予約時点の価格だけを差し引く
+		assert.Equal(t, 10000, r.TotalAmount)
+		assert.Equal(t, map[string]int{"seat-2": 10000}, r.SeatPrices)
+		// 保留中の予約は決済前のため返金しない
+		assert.Zero(t, refund)
+	})
+
+	t.Run("確定済みの予約からも外せる", func(t *testing.T) {
+		r := newReservation(t)
+		r.Status = StatusConfirmed
+		r.ExpiresAt = time.Now().Add(-1 * time.Minute)
+
+		_, refund, err := r.RemoveSeats([]string{"seat-2"}, policy, startAt)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"seat-1", "seat-3"}, r.SeatIDs)
+		assert.Equal(t, 10000, refund)
+		assert.Equal(t, 10000, r.RefundedAmount)
+	})
+
+	t.Run("一部返金の期間は外した座席の価格の一部を返金する", func(t *testing.T) {
+		r := newReservation(t)
+		r.Status = StatusConfirmed
+
+		_, refund, err := r.RemoveSeats([]string{"seat-2"}, policy, time.Now().Add(3*24*time.Hour))
+
+		require.NoError(t, err)
+		assert.Equal(t, 5000, refund)
+		assert.Equal(t, 20000, r.TotalAmount)
+	})
+
+	t.Run("返金期間を過ぎた確定済みの予約からは外せない", func(t *testing.T) {
+		r := newReservation(t)
+		r.Status = StatusConfirmed
+
+		_, _, err := r.RemoveSeats([]string{"seat-2"}, policy, time.Now().Add(12*time.Hour))
+
+		assert.ErrorIs(t, err, ErrRefundPeriodEnded)
+		assert.Len(t, r.SeatIDs, 3)
+		assert.Equal(t, 30000, r.TotalAmount)
+		assert.Len(t, r.SeatPrices, 3)
+	})
+
+	tests := []struct {
+		name    string
+		setup   func(r *Reservation)
+		seatIDs []string
+		wantErr error
+	}{
+		{"予約に含まれない座席", func(r *Reservation) {}, []string{"seat-1", "seat-9"}, ErrSeatNotInReservation},
+		{"全ての座席", func(r *Reservation) {}, []string{"seat-1", "seat-2", "seat-3"}, ErrCannotRemoveAllSeats},
+		{"座席未指定", func(r *Reservation) {}, nil, ErrSeatIDsRequired},
+		{"キャンセル済み", func(r *Reservation) { r.Status = StatusCancelled }, []string{"seat-1"}, ErrReservationAlreadyCancelled},
+		{"返金済み", func(r *Reservation) { r.Status = StatusRefunded }, []string{"seat-1"}, ErrReservationAlreadyRefunded},
+		{"返金待ち", func(r *Reservation) { r.Status = StatusRefundPending }, []string{"seat-1"}, ErrReservationRefundPending},
+		{"期限切れ", func(r *Reservation) { r.ExpiresAt = time.Now().Add(-1 * time.Minute) }, []string{"seat-1"}, ErrReservationExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"は外せない", func(t *testing.T) {
+			r := newReservation(t)
+			tt.setup(r)
+
+			_, _, err := r.RemoveSeats(tt.seatIDs, policy, startAt)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Len(t, r.SeatIDs, 3)
+			assert.Equal(t, 30000, r.TotalAmount)
+		})
+	}
+}
+
+func TestNotInStatusError(t *testing.T) {
+	assert.ErrorIs(t, NotInStatusError(StatusPending), ErrReservationNotPending)
+	assert.ErrorIs(t, NotInStatusError(StatusConfirmed), ErrReservationNotConfirmed)
+	assert.ErrorIs(t, NotInStatusError(StatusCancelled), ErrInvalidStatus)
+}
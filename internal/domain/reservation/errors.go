@@ -14,4 +14,24 @@ var (
 	ErrSeatIDsRequired             = errors.New("座席IDは必須です")
 	ErrIdempotencyKeyRequired      = errors.New("冪等性キーは必須です")
 	ErrIdempotencyKeyAlreadyExists = errors.New("同じ冪等性キーの予約が既に存在します")
+	ErrHoldExtensionLimitReached   = errors.New("仮押さえの延長回数の上限に達しています")
+	ErrHoldMaxDurationReached      = errors.New("仮押さえの最大期間に達しています")
+	ErrReservationNotConfirmed     = errors.New("予約は確定されていません")
+	ErrReservationAlreadyRefunded  = errors.New("予約は既に返金されています")
+	ErrRefundPeriodEnded           = errors.New("返金の受付期間を過ぎています")
+	ErrSeatNotInReservation        = errors.New("指定された座席は予約に含まれていません")
+	ErrCannotRemoveAllSeats        = errors.New("全ての座席を外すことはできません。予約をキャンセルしてください")
+	ErrInvalidStatus               = errors.New("予約の状態が不正です")
+	ErrReservationRefundPending    = errors.New("予約はイベントの中止により返金待ちです")
 )
+
+// NotInStatusError は予約が status の状態ではなくなっていた場合のエラーを返す
+func NotInStatusError(status Status) error {
+	switch status {
+	case StatusPending:
+		return ErrReservationNotPending
+	case StatusConfirmed:
+		return ErrReservationNotConfirmed
+	}
+	return ErrInvalidStatus
+}
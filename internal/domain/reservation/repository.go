@@ -4,9 +4,19 @@ import (
 	"context"
 	"time"
 
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pagination"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/transaction"
 )
 
+// ListFilter はユーザーの予約一覧の絞り込み条件とページの位置
+type ListFilter struct {
+	Status  Status // 空の場合は全ての状態
+	EventID string // 空の場合は全てのイベント
+
+	After *pagination.Cursor // このカーソルより後（古い）の予約から返す。nil の場合は先頭から
+	Limit int
+}
+
 // Repository は予約リポジトリのインターフェース
 type Repository interface {
 	// Create は新しい予約を作成する（トランザクション必須）
@@ -15,15 +25,39 @@ type Repository interface {
 	// GetByID はIDから予約を取得する
 	GetByID(ctx context.Context, id string) (*Reservation, error)
 
+	// GetForUpdate はIDから予約を取得し、トランザクション終了まで行ロックを保持する（トランザクション必須）
+	// 決済プロバイダーの呼び出しを含む状態遷移を同じ予約について直列化するために使う
+	GetForUpdate(ctx context.Context, tx transaction.Tx, id string) (*Reservation, error)
+
 	// GetByIdempotencyKey は冪等性キーから予約を取得する
 	GetByIdempotencyKey(ctx context.Context, key string) (*Reservation, error)
 
-	// GetByUserID はユーザーIDから予約一覧を取得する
-	GetByUserID(ctx context.Context, userID string, limit, offset int) ([]*Reservation, error)
+	// GetByUserID はユーザーの予約一覧を作成日時の新しい順（created_at, id の降順）に取得する
+	GetByUserID(ctx context.Context, userID string, filter ListFilter) ([]*Reservation, error)
+
+	// GetByEventID はイベントIDから予約一覧を取得する
+	GetByEventID(ctx context.Context, eventID string, limit, offset int) ([]*Reservation, error)
+
+	// CountSeatsHeldByUser はユーザーがイベントで仮押さえ中・確定済みの予約に保持している座席数を返す（トランザクション必須）
+	// 同じユーザーの予約作成を直列化するため、トランザクション終了までユーザー単位のロックを保持する
+	CountSeatsHeldByUser(ctx context.Context, tx transaction.Tx, eventID, userID string) (int, error)
 
 	// Update は予約を更新する（トランザクション必須）
 	Update(ctx context.Context, tx transaction.Tx, reservation *Reservation) error
 
+	// UpdateFromStatus は予約が from の状態のままの場合だけ更新する（トランザクション必須）
+	// 他の処理が先に状態を変えていた場合は NotInStatusError(from) を返す
+	UpdateFromStatus(ctx context.Context, tx transaction.Tx, reservation *Reservation, from Status) error
+
+	// RemoveSeats は予約と座席の関連付けを削除する（トランザクション必須）
+	RemoveSeats(ctx context.Context, tx transaction.Tx, reservationID string, seatIDs []string) error
+
 	// GetExpiredPending は期限切れの保留中予約を取得する
 	GetExpiredPending(ctx context.Context, expireAfter time.Duration) ([]*Reservation, error)
+
+	// CountActiveByEventID はイベントの保留中・確定済み・返金待ちの予約数を返す（返金待ちは中止処理の開始後にだけ発生する）
+	CountActiveByEventID(ctx context.Context, eventID string) (int, error)
+
+	// LockActiveByEventID はイベントの保留中・確定済みの予約を作成日時の古い順に最大 limit 件、行ロック付きで取得する（トランザクション必須）
+	LockActiveByEventID(ctx context.Context, tx transaction.Tx, eventID string, limit int) ([]*Reservation, error)
 }
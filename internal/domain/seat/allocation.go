@@ -0,0 +1,144 @@
+package seat
+
+import "sort"
+
+// BlockCriteria は最適座席ブロックを選ぶ条件を表す
+type BlockCriteria struct {
+	Quantity        int
+	Section         string // 空の場合はセクションを問わない
+	PriceCategoryID string // 空の場合は価格カテゴリを問わない
+	Adjacent        bool   // true の場合は同じ列で連続した座席のみ
+}
+
+// FindBestBlock は空席の中から条件に合う最適な座席ブロックを選ぶ
+//
+// seats はレイアウト順（セクション・列・座席番号順）に並んでいる前提で、
+// 同じ列で連続した座席を優先し、その中では
+// 見切れ席の少なさ > 前方の列 > 列の中央に近い の順で評価する。
+// 連続した座席が見つからず Adjacent が false の場合は、レイアウト順に空席を選ぶ。
+func FindBestBlock(seats []*Seat, c BlockCriteria) ([]*Seat, error) {
+	if c.Quantity <= 0 {
+		return nil, ErrInvalidQuantity
+	}
+
+	// 列ごとの座席番号の範囲（列の中央の算出に使用。予約済み座席も含める）
+	type rowRange struct{ min, max int }
+	ranges := make(map[[2]string]*rowRange)
+	for _, s := range seats {
+		if s.SeatIndex <= 0 {
+			continue
+		}
+		key := [2]string{s.Section, s.Row}
+		r, ok := ranges[key]
+		if !ok {
+			ranges[key] = &rowRange{min: s.SeatIndex, max: s.SeatIndex}
+			continue
+		}
+		if s.SeatIndex < r.min {
+			r.min = s.SeatIndex
+		}
+		if s.SeatIndex > r.max {
+			r.max = s.SeatIndex
+		}
+	}
+
+	// 条件に合う空席を列ごとにまとめる
+	candidates := make([]*Seat, 0, len(seats))
+	var rowOrder [][2]string
+	rows := make(map[[2]string][]*Seat)
+	for _, s := range seats {
+		if !s.IsAvailable() || !c.matches(s) {
+			continue
+		}
+		candidates = append(candidates, s)
+		if s.SeatIndex <= 0 {
+			continue
+		}
+		key := [2]string{s.Section, s.Row}
+		if _, ok := rows[key]; !ok {
+			rowOrder = append(rowOrder, key)
+		}
+		rows[key] = append(rows[key], s)
+	}
+	if len(candidates) < c.Quantity {
+		return nil, ErrInsufficientSeats
+	}
+
+	// 同じ列で連続した座席ブロックを探す
+	var best []*Seat
+	var bestScore blockScore
+	for order, key := range rowOrder {
+		rowSeats := rows[key]
+		sort.SliceStable(rowSeats, func(i, j int) bool { return rowSeats[i].SeatIndex < rowSeats[j].SeatIndex })
+		center := float64(ranges[key].min+ranges[key].max) / 2
+		for i := 0; i+c.Quantity <= len(rowSeats); i++ {
+			block := rowSeats[i : i+c.Quantity]
+			if block[len(block)-1].SeatIndex-block[0].SeatIndex != c.Quantity-1 {
+				continue
+			}
+			score := blockScore{obstructed: countObstructed(block), row: order, centerDistance: centerDistance(block, center)}
+			if best == nil || score.less(bestScore) {
+				best, bestScore = block, score
+			}
+		}
+	}
+	if best != nil {
+		result := make([]*Seat, len(best))
+		copy(result, best)
+		return result, nil
+	}
+	if c.Adjacent {
+		return nil, ErrNoAdjacentSeats
+	}
+
+	// 連続した座席がない場合は見切れ席以外を優先してレイアウト順に選ぶ
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return !candidates[i].ObstructedView && candidates[j].ObstructedView
+	})
+	return candidates[:c.Quantity], nil
+}
+
+func (c BlockCriteria) matches(s *Seat) bool {
+	if c.Section != "" && s.Section != c.Section {
+		return false
+	}
+	if c.PriceCategoryID != "" && (s.PriceCategoryID == nil || *s.PriceCategoryID != c.PriceCategoryID) {
+		return false
+	}
+	return true
+}
+
+// blockScore は座席ブロックの評価値（小さいほど良い）
+type blockScore struct {
+	obstructed     int
+	row            int
+	centerDistance float64
+}
+
+func (a blockScore) less(b blockScore) bool {
+	if a.obstructed != b.obstructed {
+		return a.obstructed < b.obstructed
+	}
+	if a.row != b.row {
+		return a.row < b.row
+	}
+	return a.centerDistance < b.centerDistance
+}
+
+func countObstructed(block []*Seat) int {
+	var n int
+	for _, s := range block {
+		if s.ObstructedView {
+			n++
+		}
+	}
+	return n
+}
+
+func centerDistance(block []*Seat, center float64) float64 {
+	mid := float64(block[0].SeatIndex+block[len(block)-1].SeatIndex) / 2
+	if mid > center {
+		return mid - center
+	}
+	return center - mid
+}
@@ -0,0 +1,127 @@
+package seat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rowOf は指定セクション・列の座席を生成する（reserved に含まれる番号は予約済み）
+func rowOf(section, row string, n int, reserved ...int) []*Seat {
+	taken := make(map[int]bool, len(reserved))
+	for _, i := range reserved {
+		taken[i] = true
+	}
+	seats := make([]*Seat, 0, n)
+	for i := 1; i <= n; i++ {
+		s := &Seat{ID: section + "-" + row + "-" + string(rune('0'+i)), Section: section, Row: row, SeatIndex: i, Status: StatusAvailable}
+		if taken[i] {
+			s.Status = StatusReserved
+		}
+		seats = append(seats, s)
+	}
+	return seats
+}
+
+func seatIndexes(seats []*Seat) []int {
+	idx := make([]int, len(seats))
+	for i, s := range seats {
+		idx[i] = s.SeatIndex
+	}
+	return idx
+}
+
+func TestFindBestBlock(t *testing.T) {
+	t.Run("前方の列の中央に近い連続ブロックを選ぶ", func(t *testing.T) {
+		seats := append(rowOf("ARENA", "A", 7), rowOf("ARENA", "B", 7)...)
+
+		block, err := FindBestBlock(seats, BlockCriteria{Quantity: 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{3, 4, 5}, seatIndexes(block))
+		assert.Equal(t, "A", block[0].Row)
+	})
+
+	t.Run("前の列に連続した空席がなければ次の列から選ぶ", func(t *testing.T) {
+		seats := append(rowOf("ARENA", "A", 5, 2, 4), rowOf("ARENA", "B", 5)...)
+
+		block, err := FindBestBlock(seats, BlockCriteria{Quantity: 2, Adjacent: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, "B", block[0].Row)
+		assert.Len(t, block, 2)
+	})
+
+	t.Run("見切れ席を含まないブロックを優先する", func(t *testing.T) {
+		a := rowOf("ARENA", "A", 2)
+		a[0].ObstructedView = true
+		seats := append(a, rowOf("ARENA", "B", 2)...)
+
+		block, err := FindBestBlock(seats, BlockCriteria{Quantity: 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, "B", block[0].Row)
+	})
+
+	t.Run("セクションと価格カテゴリで絞り込む", func(t *testing.T) {
+		cat := "cat-s"
+		stand := rowOf("STAND", "1", 3)
+		for _, s := range stand {
+			s.PriceCategoryID = &cat
+		}
+		seats := append(rowOf("ARENA", "A", 3), stand...)
+
+		block, err := FindBestBlock(seats, BlockCriteria{Quantity: 2, Section: "STAND", PriceCategoryID: cat})
+
+		require.NoError(t, err)
+		for _, s := range block {
+			assert.Equal(t, "STAND", s.Section)
+		}
+	})
+
+	t.Run("連続必須で連続した空席がない", func(t *testing.T) {
+		seats := rowOf("ARENA", "A", 5, 2, 4)
+
+		_, err := FindBestBlock(seats, BlockCriteria{Quantity: 2, Adjacent: true})
+
+		assert.ErrorIs(t, err, ErrNoAdjacentSeats)
+	})
+
+	t.Run("連続不要なら離れた座席でも選ぶ", func(t *testing.T) {
+		seats := rowOf("ARENA", "A", 5, 2, 4)
+
+		block, err := FindBestBlock(seats, BlockCriteria{Quantity: 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 3}, seatIndexes(block))
+	})
+
+	t.Run("レイアウトのない座席は並び順に選ぶ", func(t *testing.T) {
+		seats := []*Seat{
+			{ID: "s1", Status: StatusAvailable},
+			{ID: "s2", Status: StatusReserved},
+			{ID: "s3", Status: StatusAvailable},
+		}
+
+		block, err := FindBestBlock(seats, BlockCriteria{Quantity: 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, "s1", block[0].ID)
+		assert.Equal(t, "s3", block[1].ID)
+	})
+
+	t.Run("空席が不足している", func(t *testing.T) {
+		seats := rowOf("ARENA", "A", 3, 1, 2)
+
+		_, err := FindBestBlock(seats, BlockCriteria{Quantity: 2})
+
+		assert.ErrorIs(t, err, ErrInsufficientSeats)
+	})
+
+	t.Run("座席数が0", func(t *testing.T) {
+		_, err := FindBestBlock(rowOf("ARENA", "A", 3), BlockCriteria{Quantity: 0})
+
+		assert.ErrorIs(t, err, ErrInvalidQuantity)
+	})
+}
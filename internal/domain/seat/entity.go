@@ -13,16 +13,24 @@ const (
 
 // Seat は座席エンティティを表す
 type Seat struct {
-	ID         string
-	EventID    string
-	SeatNumber string
-	Status     Status
-	Price      int
-	ReservedBy *string // reservation_id
-	ReservedAt *time.Time
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	Version    int // 楽観的ロック用
+	ID              string
+	EventID         string
+	SeatNumber      string
+	Status          Status
+	Price           int
+	PriceCategoryID *string // 価格カテゴリ（設定時は Price はカテゴリ金額の写し）
+	Section         string  // 会場レイアウト上のセクション（レイアウト未使用時は空）
+	Row             string  // セクション内の列ラベル
+	SeatIndex       int     // 列内の座席番号（1始まり）
+	X               float64 // 座席表上のX座標
+	Y               float64 // 座席表上のY座標
+	Accessible      bool    // 車椅子対応席
+	ObstructedView  bool    // 見切れ席
+	ReservedBy      *string // reservation_id
+	ReservedAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	Version         int // 楽観的ロック用
 }
 
 // NewSeat は新しい座席を作成する
@@ -12,4 +12,13 @@ var (
 	ErrSeatNumberRequired     = errors.New("座席番号は必須です")
 	ErrInvalidPrice           = errors.New("価格は0以上である必要があります")
 	ErrOptimisticLockConflict = errors.New("楽観的ロックの競合が発生しました")
+	ErrEmptyLayout            = errors.New("座席レイアウトに座席が含まれていません")
+	ErrInvalidLayout          = errors.New("座席レイアウトが不正です")
+	ErrLayoutExceedsCapacity  = errors.New("座席レイアウトの座席数がイベントの総座席数を超えています")
+	ErrInvalidQuantity        = errors.New("座席数は1以上である必要があります")
+	ErrInsufficientSeats      = errors.New("条件に合う空席が不足しています")
+	ErrNoAdjacentSeats        = errors.New("条件に合う連続した空席がありません")
+	ErrSeatLockContention     = errors.New("座席が他のユーザーによって処理中です")
+	ErrSeatLockUnavailable    = errors.New("座席のロックを取得できません")
+	ErrCapacityExceeded       = errors.New("作成済みの座席数がイベントの総座席数を超えます")
 )
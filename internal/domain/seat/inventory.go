@@ -0,0 +1,30 @@
+package seat
+
+// Inventory はイベントの作成済みの座席の状態別の数
+type Inventory struct {
+	Total     int
+	Available int
+	Reserved  int
+	Confirmed int
+}
+
+// Drift はイベントの総座席数（Event.TotalSeats）と作成済みの座席数のずれ
+type Drift struct {
+	EventID    string
+	EventName  string
+	TotalSeats int
+	SeatCount  int
+}
+
+// Excess は総座席数を超えて作成された座席数を返す（負の場合は総座席数に対して不足している座席数）
+func (d Drift) Excess() int {
+	return d.SeatCount - d.TotalSeats
+}
+
+// CheckCapacity は作成済みの座席に count 席を追加しても総座席数を超えないかを確認する
+func CheckCapacity(totalSeats, created, count int) error {
+	if created+count > totalSeats {
+		return ErrCapacityExceeded
+	}
+	return nil
+}
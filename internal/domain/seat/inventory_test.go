@@ -0,0 +1,19 @@
+package seat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckCapacity(t *testing.T) {
+	assert.NoError(t, CheckCapacity(10, 0, 10))
+	assert.NoError(t, CheckCapacity(10, 8, 2))
+	assert.ErrorIs(t, CheckCapacity(10, 8, 3), ErrCapacityExceeded)
+	assert.ErrorIs(t, CheckCapacity(10, 12, 1), ErrCapacityExceeded)
+}
+
+func TestDrift_Excess(t *testing.T) {
+	assert.Equal(t, 400, Drift{TotalSeats: 100, SeatCount: 500}.Excess())
+	assert.Equal(t, -30, Drift{TotalSeats: 100, SeatCount: 70}.Excess())
+}
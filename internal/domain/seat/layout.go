@@ -0,0 +1,104 @@
+package seat
+
+import "fmt"
+
+// Layout は会場の座席配置（セクション > 列 > 座席）を表す
+type Layout struct {
+	Sections []LayoutSection
+}
+
+// LayoutSection は会場のセクション（アリーナ、1階スタンドなど）を表す
+type LayoutSection struct {
+	Name  string
+	Price int // セクション内の座席の価格
+	Rows  []LayoutRow
+}
+
+// LayoutRow はセクション内の列を表す
+type LayoutRow struct {
+	Label string
+	Seats []LayoutSeat
+}
+
+// LayoutSeat は列内の座席の位置と属性を表す
+type LayoutSeat struct {
+	Index          int     // 列内の座席番号（1始まり、隣接判定に使用）
+	X              float64 // 座席表上のX座標
+	Y              float64 // 座席表上のY座標
+	Accessible     bool    // 車椅子対応席
+	ObstructedView bool    // 見切れ席
+}
+
+// Validate はレイアウトの検証を行う
+func (l *Layout) Validate() error {
+	if l.SeatCount() == 0 {
+		return ErrEmptyLayout
+	}
+	sections := make(map[string]struct{}, len(l.Sections))
+	for _, sec := range l.Sections {
+		if sec.Name == "" {
+			return fmt.Errorf("%w: セクション名は必須です", ErrInvalidLayout)
+		}
+		if _, dup := sections[sec.Name]; dup {
+			return fmt.Errorf("%w: セクション %q が重複しています", ErrInvalidLayout, sec.Name)
+		}
+		sections[sec.Name] = struct{}{}
+		if sec.Price < 0 {
+			return ErrInvalidPrice
+		}
+		rows := make(map[string]struct{}, len(sec.Rows))
+		for _, row := range sec.Rows {
+			if row.Label == "" {
+				return fmt.Errorf("%w: セクション %q の列ラベルは必須です", ErrInvalidLayout, sec.Name)
+			}
+			if _, dup := rows[row.Label]; dup {
+				return fmt.Errorf("%w: セクション %q の列 %q が重複しています", ErrInvalidLayout, sec.Name, row.Label)
+			}
+			rows[row.Label] = struct{}{}
+			indexes := make(map[int]struct{}, len(row.Seats))
+			for _, s := range row.Seats {
+				if s.Index <= 0 {
+					return fmt.Errorf("%w: 座席番号は1以上である必要があります（%s %s列）", ErrInvalidLayout, sec.Name, row.Label)
+				}
+				if _, dup := indexes[s.Index]; dup {
+					return fmt.Errorf("%w: 座席番号 %d が重複しています（%s %s列）", ErrInvalidLayout, s.Index, sec.Name, row.Label)
+				}
+				indexes[s.Index] = struct{}{}
+			}
+		}
+	}
+	return nil
+}
+
+// SeatCount はレイアウトに含まれる座席数を返す
+func (l *Layout) SeatCount() int {
+	var n int
+	for _, sec := range l.Sections {
+		for _, row := range sec.Rows {
+			n += len(row.Seats)
+		}
+	}
+	return n
+}
+
+// BuildSeats はレイアウトからイベントの座席を生成する
+// 座席番号は「セクション-列-番号」形式で採番する
+func (l *Layout) BuildSeats(eventID string) []*Seat {
+	seats := make([]*Seat, 0, l.SeatCount())
+	for _, sec := range l.Sections {
+		for _, row := range sec.Rows {
+			for _, ls := range row.Seats {
+				s := NewSeat(eventID, fmt.Sprintf("%s-%s-%d", sec.Name, row.Label, ls.Index), sec.Price)
+				s.Section = sec.Name
+				s.Row = row.Label
+				s.SeatIndex = ls.Index
+				s.X = ls.X
+				s.Y = ls.Y
+				s.Accessible = ls.Accessible
+				s.ObstructedView = ls.ObstructedView
+				seats = append(seats, s)
+			}
+		}
+	}
+	return seats
+}
@@ -0,0 +1,125 @@
+package seat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLayout() *Layout {
+	return &Layout{
+		Sections: []LayoutSection{
+			{
+				Name:  "ARENA",
+				Price: 12000,
+				Rows: []LayoutRow{
+					{Label: "A", Seats: []LayoutSeat{
+						{Index: 1, X: 10, Y: 20, Accessible: true},
+						{Index: 2, X: 20, Y: 20},
+					}},
+					{Label: "B", Seats: []LayoutSeat{
+						{Index: 1, X: 10, Y: 30, ObstructedView: true},
+					}},
+				},
+			},
+			{
+				Name:  "STAND",
+				Price: 8000,
+				Rows: []LayoutRow{
+					{Label: "1", Seats: []LayoutSeat{{Index: 1, X: 10, Y: 100}}},
+				},
+			},
+		},
+	}
+}
+
+func TestLayout_SeatCount(t *testing.T) {
+	assert.Equal(t, 4, newTestLayout().SeatCount())
+	assert.Equal(t, 0, (&Layout{}).SeatCount())
+}
+
+func TestLayout_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(l *Layout)
+		expectedErr error
+	}{
+		{
+			name:        "有効なレイアウト",
+			modify:      func(l *Layout) {},
+			expectedErr: nil,
+		},
+		{
+			name:        "座席が空",
+			modify:      func(l *Layout) { l.Sections = nil },
+			expectedErr: ErrEmptyLayout,
+		},
+		{
+			name:        "セクション名が空",
+			modify:      func(l *Layout) { l.Sections[0].Name = "" },
+			expectedErr: ErrInvalidLayout,
+		},
+		{
+			name:        "セクション名が重複",
+			modify:      func(l *Layout) { l.Sections[1].Name = "ARENA" },
+			expectedErr: ErrInvalidLayout,
+		},
+		{
+			name:        "列ラベルが重複",
+			modify:      func(l *Layout) { l.Sections[0].Rows[1].Label = "A" },
+			expectedErr: ErrInvalidLayout,
+		},
+		{
+			name:        "座席番号が0",
+			modify:      func(l *Layout) { l.Sections[0].Rows[0].Seats[0].Index = 0 },
+			expectedErr: ErrInvalidLayout,
+		},
+		{
+			name:        "座席番号が重複",
+			modify:      func(l *Layout) { l.Sections[0].Rows[0].Seats[1].Index = 1 },
+			expectedErr: ErrInvalidLayout,
+		},
+		{
+			name:        "価格が負",
+			modify:      func(l *Layout) { l.Sections[1].Price = -1 },
+			expectedErr: ErrInvalidPrice,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newTestLayout()
+			tt.modify(l)
+			err := l.Validate()
+			if tt.expectedErr != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLayout_BuildSeats(t *testing.T) {
+	seats := newTestLayout().BuildSeats("event-123")
+
+	require.Len(t, seats, 4)
+
+	first := seats[0]
+	assert.Equal(t, "event-123", first.EventID)
+	assert.Equal(t, "ARENA-A-1", first.SeatNumber)
+	assert.Equal(t, "ARENA", first.Section)
+	assert.Equal(t, "A", first.Row)
+	assert.Equal(t, 1, first.SeatIndex)
+	assert.Equal(t, 10.0, first.X)
+	assert.Equal(t, 20.0, first.Y)
+	assert.True(t, first.Accessible)
+	assert.Equal(t, 12000, first.Price)
+	assert.Equal(t, StatusAvailable, first.Status)
+
+	assert.True(t, seats[2].ObstructedView)
+	assert.Equal(t, "STAND-1-1", seats[3].SeatNumber)
+	assert.Equal(t, 8000, seats[3].Price)
+}
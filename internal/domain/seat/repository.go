@@ -9,9 +9,12 @@ import (
 // Repository は座席リポジトリのインターフェース
 type Repository interface {
 	// Create は新しい座席を作成する
+	// 作成済みの座席数がイベントの総座席数を超える場合は ErrCapacityExceeded を返す
 	Create(ctx context.Context, seat *Seat) error
 
-	// CreateBulk は複数の座席を一括作成する
+	// CreateBulk は同じイベントの複数の座席を一括作成する
+	// 作成済みの座席数がイベントの総座席数を超える場合は ErrCapacityExceeded を返し、1席も作成しない。
+	// 並行した作成でも超えないよう、座席数の確認と作成はイベントの行ロックの下で行う
 	CreateBulk(ctx context.Context, seats []*Seat) error
 
 	// GetByID はIDから座席を取得する
@@ -29,9 +32,22 @@ type Repository interface {
 	// ConfirmSeats は座席を確定状態に更新する（トランザクション必須）
 	ConfirmSeats(ctx context.Context, tx transaction.Tx, seatIDs []string) error
 
-	// ReleaseSeats は座席を解放する（トランザクション必須）
-	ReleaseSeats(ctx context.Context, tx transaction.Tx, seatIDs []string) error
+	// ReleaseSeats は予約 reservationID が保持している座席を解放する（トランザクション必須）
+	// 既に解放されて別の予約に渡った座席は対象外にする
+	ReleaseSeats(ctx context.Context, tx transaction.Tx, reservationID string, seatIDs []string) error
+
+	// ReleaseByEventID はイベントの空席でない座席を最大 limit 席解放し、解放した座席数を返す（トランザクション必須）
+	ReleaseByEventID(ctx context.Context, tx transaction.Tx, eventID string, limit int) (int, error)
 
 	// CountAvailableByEventID はイベントの利用可能座席数を取得する
 	CountAvailableByEventID(ctx context.Context, eventID string) (int, error)
+
+	// CountByEventID はイベントの作成済みの座席数（状態を問わない）を取得する
+	CountByEventID(ctx context.Context, eventID string) (int, error)
+
+	// GetInventoryByEventID はイベントの作成済みの座席の状態別の数を取得する
+	GetInventoryByEventID(ctx context.Context, eventID string) (*Inventory, error)
+
+	// ListDrift は総座席数と作成済みの座席数が一致しないイベントを取得する
+	ListDrift(ctx context.Context) ([]*Drift, error)
 }
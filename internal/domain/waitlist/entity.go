@@ -0,0 +1,118 @@
+package waitlist
+
+import "time"
+
+// Status は順番待ちエントリの状態を表す
+type Status string
+
+const (
+	StatusWaiting   Status = "waiting"   // 空席の発生待ち
+	StatusOffered   Status = "offered"   // 仮押さえ予約をオファー中
+	StatusAccepted  Status = "accepted"  // オファーされた予約を確定済み
+	StatusExpired   Status = "expired"   // オファーの有効期限切れ
+	StatusCancelled Status = "cancelled" // 取り消し・オファー辞退
+	StatusSkipped   Status = "skipped"   // オファーできないため順番を飛ばした
+)
+
+// MaxQuantity は1エントリで待機できる最大座席数
+const MaxQuantity = 10
+
+// Entry は売り切れイベントの順番待ちエントリを表す
+type Entry struct {
+	ID            string
+	EventID       string
+	UserID        string
+	Quantity      int
+	Status        Status
+	ReservationID *string // オファーとして作成された仮押さえ予約
+	OfferedAt     *time.Time
+	SkipReason    *string // 順番を飛ばした理由
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewEntry は新しい順番待ちエントリを作成する
+func NewEntry(eventID, userID string, quantity int) *Entry {
+	now := time.Now()
+	return &Entry{
+		EventID:   eventID,
+		UserID:    userID,
+		Quantity:  quantity,
+		Status:    StatusWaiting,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Validate は順番待ちエントリの検証を行う
+func (e *Entry) Validate() error {
+	if e.EventID == "" {
+		return ErrEventIDRequired
+	}
+	if e.UserID == "" {
+		return ErrUserIDRequired
+	}
+	if e.Quantity < 1 || e.Quantity > MaxQuantity {
+		return ErrInvalidQuantity
+	}
+	return nil
+}
+
+// IsWaiting は空席の発生待ちかを返す
+func (e *Entry) IsWaiting() bool {
+	return e.Status == StatusWaiting
+}
+
+// Offer は仮押さえ予約をオファーした状態にする
+func (e *Entry) Offer(reservationID string) error {
+	if e.Status != StatusWaiting {
+		return ErrEntryNotWaiting
+	}
+	now := time.Now()
+	e.Status = StatusOffered
+	e.ReservationID = &reservationID
+	e.OfferedAt = &now
+	e.UpdatedAt = now
+	return nil
+}
+
+// Accept はオファーされた予約が確定された状態にする
+func (e *Entry) Accept() error {
+	if e.Status != StatusOffered {
+		return ErrEntryNotOffered
+	}
+	e.Status = StatusAccepted
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// Lapse はオファーの有効期限切れを記録する
+func (e *Entry) Lapse() error {
+	if e.Status != StatusOffered {
+		return ErrEntryNotOffered
+	}
+	e.Status = StatusExpired
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// Cancel は順番待ちの取り消し、またはオファーの辞退を記録する
+func (e *Entry) Cancel() error {
+	if e.Status != StatusWaiting && e.Status != StatusOffered {
+		return ErrEntryClosed
+	}
+	e.Status = StatusCancelled
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// Skip は購入枚数の上限超過など、オファーを作成できないため順番を飛ばしたことを記録する
+func (e *Entry) Skip(reason string) error {
+	if e.Status != StatusWaiting {
+		return ErrEntryNotWaiting
+	}
+	e.Status = StatusSkipped
+	e.SkipReason = &reason
+	e.UpdatedAt = time.Now()
+	return nil
+}
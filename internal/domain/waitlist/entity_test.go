@@ -0,0 +1,135 @@
+package waitlist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEntry(t *testing.T) {
+	e := NewEntry("event-1", "user-1", 2)
+
+	assert.Equal(t, "event-1", e.EventID)
+	assert.Equal(t, "user-1", e.UserID)
+	assert.Equal(t, 2, e.Quantity)
+	assert.Equal(t, StatusWaiting, e.Status)
+	assert.True(t, e.IsWaiting())
+	assert.Nil(t, e.ReservationID)
+	assert.NotZero(t, e.CreatedAt)
+}
+
+func TestEntry_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		entry       *Entry
+		expectedErr error
+	}{
+		{
+			name:        "有効なエントリ",
+			entry:       &Entry{EventID: "event-1", UserID: "user-1", Quantity: 2},
+			expectedErr: nil,
+		},
+		{
+			name:        "イベントIDが空",
+			entry:       &Entry{UserID: "user-1", Quantity: 2},
+			expectedErr: ErrEventIDRequired,
+		},
+		{
+			name:        "ユーザーIDが空",
+			entry:       &Entry{EventID: "event-1", Quantity: 2},
+			expectedErr: ErrUserIDRequired,
+		},
+		{
+			name:        "座席数が0",
+			entry:       &Entry{EventID: "event-1", UserID: "user-1", Quantity: 0},
+			expectedErr: ErrInvalidQuantity,
+		},
+		{
+			name:        "座席数が上限超過",
+			entry:       &Entry{EventID: "event-1", UserID: "user-1", Quantity: MaxQuantity + 1},
+			expectedErr: ErrInvalidQuantity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedErr, tt.entry.Validate())
+		})
+	}
+}
+
+func TestEntry_Offer(t *testing.T) {
+	t.Run("待機中のエントリにオファーできる", func(t *testing.T) {
+		e := NewEntry("event-1", "user-1", 2)
+
+		require.NoError(t, e.Offer("res-1"))
+		assert.Equal(t, StatusOffered, e.Status)
+		require.NotNil(t, e.ReservationID)
+		assert.Equal(t, "res-1", *e.ReservationID)
+		assert.NotNil(t, e.OfferedAt)
+	})
+
+	t.Run("オファー済みのエントリにはオファーできない", func(t *testing.T) {
+		e := NewEntry("event-1", "user-1", 2)
+		require.NoError(t, e.Offer("res-1"))
+
+		assert.ErrorIs(t, e.Offer("res-2"), ErrEntryNotWaiting)
+		assert.Equal(t, "res-1", *e.ReservationID)
+	})
+}
+
+func TestEntry_Accept(t *testing.T) {
+	e := NewEntry("event-1", "user-1", 2)
+	assert.ErrorIs(t, e.Accept(), ErrEntryNotOffered)
+
+	require.NoError(t, e.Offer("res-1"))
+	require.NoError(t, e.Accept())
+	assert.Equal(t, StatusAccepted, e.Status)
+}
+
+func TestEntry_Lapse(t *testing.T) {
+	e := NewEntry("event-1", "user-1", 2)
+	assert.ErrorIs(t, e.Lapse(), ErrEntryNotOffered)
+
+	require.NoError(t, e.Offer("res-1"))
+	require.NoError(t, e.Lapse())
+	assert.Equal(t, StatusExpired, e.Status)
+}
+
+func TestEntry_Cancel(t *testing.T) {
+	t.Run("待機中のエントリを取り消せる", func(t *testing.T) {
+		e := NewEntry("event-1", "user-1", 2)
+
+		require.NoError(t, e.Cancel())
+		assert.Equal(t, StatusCancelled, e.Status)
+	})
+
+	t.Run("オファー中のエントリを辞退できる", func(t *testing.T) {
+		e := NewEntry("event-1", "user-1", 2)
+		require.NoError(t, e.Offer("res-1"))
+
+		require.NoError(t, e.Cancel())
+		assert.Equal(t, StatusCancelled, e.Status)
+	})
+
+	t.Run("終了したエントリは取り消せない", func(t *testing.T) {
+		e := NewEntry("event-1", "user-1", 2)
+		require.NoError(t, e.Offer("res-1"))
+		require.NoError(t, e.Accept())
+
+		assert.ErrorIs(t, e.Cancel(), ErrEntryClosed)
+	})
+}
+
+func TestEntry_Skip(t *testing.T) {
+	e := NewEntry("event-1", "user-1", 2)
+
+	require.NoError(t, e.Skip("購入枚数の上限を超えています"))
+	assert.Equal(t, StatusSkipped, e.Status)
+	require.NotNil(t, e.SkipReason)
+	assert.Equal(t, "購入枚数の上限を超えています", *e.SkipReason)
+	assert.False(t, e.IsWaiting())
+
+	assert.ErrorIs(t, e.Skip("再度"), ErrEntryNotWaiting)
+}
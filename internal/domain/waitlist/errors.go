@@ -0,0 +1,16 @@
+package waitlist
+
+import "errors"
+
+// Waitlist ドメインのエラー定義
+var (
+	ErrEntryNotFound   = errors.New("順番待ちエントリが見つかりません")
+	ErrEventIDRequired = errors.New("イベントIDは必須です")
+	ErrUserIDRequired  = errors.New("ユーザーIDは必須です")
+	ErrInvalidQuantity = errors.New("座席数は1以上10以下である必要があります")
+	ErrAlreadyWaiting  = errors.New("既に順番待ちに登録されています")
+	ErrSeatsAvailable  = errors.New("空席があるため順番待ちは不要です")
+	ErrEntryNotWaiting = errors.New("順番待ちエントリは待機中ではありません")
+	ErrEntryNotOffered = errors.New("順番待ちエントリはオファー中ではありません")
+	ErrEntryClosed     = errors.New("順番待ちエントリは既に終了しています")
+)
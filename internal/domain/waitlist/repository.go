@@ -0,0 +1,27 @@
+package waitlist
+
+import "context"
+
+// Repository は順番待ちリポジトリのインターフェース
+type Repository interface {
+	// Create は新しいエントリを作成する（同一ユーザーの待機中・オファー中エントリがある場合はエラー）
+	Create(ctx context.Context, entry *Entry) error
+
+	// GetByID はIDからエントリを取得する
+	GetByID(ctx context.Context, id string) (*Entry, error)
+
+	// GetByReservationID はオファーした予約IDからエントリを取得する
+	GetByReservationID(ctx context.Context, reservationID string) (*Entry, error)
+
+	// GetNextWaiting はイベントで最も早く登録された待機中エントリを取得する
+	GetNextWaiting(ctx context.Context, eventID string) (*Entry, error)
+
+	// CountWaitingBefore はエントリより先に登録された待機中エントリ数を返す
+	CountWaitingBefore(ctx context.Context, entry *Entry) (int, error)
+
+	// Update はエントリを更新する
+	Update(ctx context.Context, entry *Entry) error
+
+	// UpdateFromStatus はエントリが from の状態のままの場合だけ更新する（変わっていればエラー）
+	UpdateFromStatus(ctx context.Context, entry *Entry, from Status) error
+}
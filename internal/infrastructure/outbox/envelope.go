@@ -0,0 +1,31 @@
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/outbox"
+)
+
+// Envelope は配信先に送るドメインイベントの共通フォーマット
+// id はメッセージごとに一意で、受信側の重複除外に使う
+type Envelope struct {
+	ID            int64           `json:"id"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	EventType     string          `json:"event_type"`
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// newEnvelope はメッセージから配信用のエンベロープを作成する
+func newEnvelope(msg *outbox.Message) Envelope {
+	return Envelope{
+		ID:            msg.ID,
+		AggregateType: msg.AggregateType,
+		AggregateID:   msg.AggregateID,
+		EventType:     msg.EventType,
+		Payload:       msg.Payload,
+		CreatedAt:     msg.CreatedAt,
+	}
+}
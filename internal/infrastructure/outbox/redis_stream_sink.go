@@ -0,0 +1,46 @@
+package outbox
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/outbox"
+)
+
+// RedisStreamSinkName はRedis Streamsシンクの名前
+const RedisStreamSinkName = "redis"
+
+// RedisStreamSink はドメインイベントをRedis Streamsに追加する
+// 購読側はコンシューマーグループで読み出し、id フィールドで重複を除外する
+type RedisStreamSink struct {
+	client *redis.Client
+	stream string
+}
+
+// NewRedisStreamSink は新しいRedisStreamSinkを作成する
+func NewRedisStreamSink(client *redis.Client, stream string) *RedisStreamSink {
+	return &RedisStreamSink{client: client, stream: stream}
+}
+
+// Name はシンク名を返す
+func (s *RedisStreamSink) Name() string {
+	return RedisStreamSinkName
+}
+
+// Publish はメッセージをストリームに追加する
+func (s *RedisStreamSink) Publish(ctx context.Context, msg *outbox.Message) error {
+	return s.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: s.stream,
+		Values: map[string]interface{}{
+			"id":             strconv.FormatInt(msg.ID, 10),
+			"aggregate_type": msg.AggregateType,
+			"aggregate_id":   msg.AggregateID,
+			"event_type":     msg.EventType,
+			"payload":        string(msg.Payload),
+			"created_at":     msg.CreatedAt.UTC().Format(time.RFC3339Nano),
+		},
+	}).Err()
+}
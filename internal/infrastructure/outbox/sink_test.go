@@ -0,0 +1,96 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/outbox"
+	redisinfra "github.com/sanosuguru/go-event-ticket-reservation/internal/infrastructure/redis"
+)
+
+func newTestMessage() *outbox.Message {
+	return &outbox.Message{
+		ID: 42, AggregateType: outbox.AggregateReservation, AggregateID: "res-1",
+		EventType: outbox.EventReservationConfirmed, Payload: json.RawMessage(`{"status":"confirmed"}`),
+		CreatedAt: time.Now(),
+	}
+}
+
+func TestStdoutSink_Publish(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewStdoutSink(&buf)
+
+	require.NoError(t, sink.Publish(context.Background(), newTestMessage()))
+	require.NoError(t, sink.Publish(context.Background(), newTestMessage()))
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+	var got Envelope
+	require.NoError(t, json.Unmarshal(lines[0], &got))
+	assert.Equal(t, int64(42), got.ID)
+	assert.Equal(t, "reservation.confirmed", got.EventType)
+	assert.JSONEq(t, `{"status":"confirmed"}`, string(got.Payload))
+}
+
+func TestWebhookSink_Publish(t *testing.T) {
+	t.Run("署名付きで送信する", func(t *testing.T) {
+		var header http.Header
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header = r.Header
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		sink := NewWebhookSink(server.URL, "secret", time.Second)
+		require.NoError(t, sink.Publish(context.Background(), newTestMessage()))
+
+		assert.Equal(t, "42", header.Get(HeaderMessageID))
+		assert.Equal(t, "reservation.confirmed", header.Get(HeaderEventType))
+		assert.Equal(t, Sign([]byte("secret"), body), header.Get(HeaderSignature))
+		var got Envelope
+		require.NoError(t, json.Unmarshal(body, &got))
+		assert.Equal(t, "res-1", got.AggregateID)
+	})
+
+	t.Run("2xx以外の応答はエラー", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		sink := NewWebhookSink(server.URL, "", time.Second)
+		assert.Error(t, sink.Publish(context.Background(), newTestMessage()))
+	})
+}
+
+func TestRedisStreamSink_Publish(t *testing.T) {
+	client, err := redisinfra.NewClient(&redisinfra.Config{Host: "localhost", Port: "6379"})
+	if err != nil {
+		t.Skip("Redis not available")
+	}
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+	stream := "test-outbox-stream"
+	client.Del(ctx, stream)
+	t.Cleanup(func() { client.Del(ctx, stream) })
+
+	sink := NewRedisStreamSink(client, stream)
+	require.NoError(t, sink.Publish(ctx, newTestMessage()))
+
+	entries, err := client.XRange(ctx, stream, "-", "+").Result()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "42", entries[0].Values["id"])
+	assert.Equal(t, "reservation.confirmed", entries[0].Values["event_type"])
+}
@@ -0,0 +1,42 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/outbox"
+)
+
+// StdoutSinkName は標準出力シンクの名前
+const StdoutSinkName = "stdout"
+
+// StdoutSink はドメインイベントを1行1件のJSONで書き出す
+// ローカル開発や、ログ収集基盤経由で取り込む構成向け
+type StdoutSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewStdoutSink は新しいStdoutSinkを作成する
+func NewStdoutSink(w io.Writer) *StdoutSink {
+	return &StdoutSink{w: w}
+}
+
+// Name はシンク名を返す
+func (s *StdoutSink) Name() string {
+	return StdoutSinkName
+}
+
+// Publish はメッセージをJSONの1行として書き出す
+func (s *StdoutSink) Publish(_ context.Context, msg *outbox.Message) error {
+	line, err := json.Marshal(newEnvelope(msg))
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(line, '\n'))
+	return err
+}
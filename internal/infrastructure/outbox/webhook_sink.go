@@ -0,0 +1,89 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/outbox"
+)
+
+// WebhookSinkName はWebhookシンクの名前
+const WebhookSinkName = "webhook"
+
+// Webhookで送るヘッダー
+const (
+	HeaderMessageID = "X-Outbox-Message-ID"
+	HeaderEventType = "X-Outbox-Event-Type"
+	HeaderSignature = "X-Outbox-Signature" // 本文の HMAC-SHA256（secret 設定時のみ）
+)
+
+// WebhookSink はドメインイベントを指定URLへHTTP POSTで送る
+// 2xx 以外の応答は配信失敗として扱い、リレーが再送する
+type WebhookSink struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+// NewWebhookSink は新しいWebhookSinkを作成する
+// secret が空の場合は署名ヘッダーを付けない
+func NewWebhookSink(url, secret string, timeout time.Duration) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		secret: []byte(secret),
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Name はシンク名を返す
+func (s *WebhookSink) Name() string {
+	return WebhookSinkName
+}
+
+// Publish はメッセージをWebhookで送る
+func (s *WebhookSink) Publish(ctx context.Context, msg *outbox.Message) error {
+	body, err := json.Marshal(newEnvelope(msg))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderMessageID, strconv.FormatInt(msg.ID, 10))
+	req.Header.Set(HeaderEventType, msg.EventType)
+	if len(s.secret) > 0 {
+		req.Header.Set(HeaderSignature, Sign(s.secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("Webhookの応答が不正です: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign は本文の HMAC-SHA256 署名を16進文字列で返す
+// 受信側は同じ secret で計算した値と X-Outbox-Signature を比較して検証する
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
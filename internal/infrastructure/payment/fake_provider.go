@@ -0,0 +1,177 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/payment"
+)
+
+// FakeProviderName はフェイクプロバイダーの名前
+const FakeProviderName = "fake"
+
+// フェイクプロバイダーの挙動を切り替える決済トークン
+// それ以外の空でないトークンはすべてオーソリ成功になる
+const (
+	FakeTokenDecline = "tok_decline" // オーソリが拒否される
+	FakeTokenTimeout = "tok_timeout" // プロバイダーが応答せずタイムアウトする
+)
+
+// FakeProvider はローカル開発・テスト用のプロセス内決済プロバイダー
+// 外部に通信せず、決済トークンで拒否・タイムアウトを再現する
+type FakeProvider struct {
+	webhookSecret []byte
+	timeout       time.Duration
+
+	mu         sync.Mutex
+	payments   map[string]*fakePayment
+	authorized map[string]string // 冪等性キー → 決済ID
+	refunded   map[string]bool   // 処理済みの返金の冪等性キー
+}
+
+type fakePayment struct {
+	amount   int
+	captured bool
+	refunded int
+}
+
+// fakeWebhookPayload はフェイクプロバイダーのWebhook本文
+type fakeWebhookPayload struct {
+	Type      string `json:"type"`
+	PaymentID string `json:"payment_id"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// NewFakeProvider は新しいFakeProviderを作成する
+// timeout は FakeTokenTimeout を受け取ったときに応答を待たせる時間
+func NewFakeProvider(webhookSecret string, timeout time.Duration) *FakeProvider {
+	return &FakeProvider{
+		webhookSecret: []byte(webhookSecret),
+		timeout:       timeout,
+		payments:      make(map[string]*fakePayment),
+		authorized:    make(map[string]string),
+		refunded:      make(map[string]bool),
+	}
+}
+
+// Name はプロバイダー名を返す
+func (p *FakeProvider) Name() string {
+	return FakeProviderName
+}
+
+// Authorize は与信を確保する
+func (p *FakeProvider) Authorize(ctx context.Context, req payment.AuthorizeRequest) (string, error) {
+	switch req.PaymentToken {
+	case "":
+		return "", payment.ErrPaymentMethodRequired
+	case FakeTokenDecline:
+		return "", payment.ErrPaymentDeclined
+	case FakeTokenTimeout:
+		return "", p.hang(ctx)
+	}
+	if req.Amount < 0 {
+		return "", payment.ErrInvalidAmount
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// 同じ冪等性キーの要求には最初のオーソリの決済IDを返す
+	if id, ok := p.authorized[req.IdempotencyKey]; ok && req.IdempotencyKey != "" {
+		return id, nil
+	}
+	id := "fake_pay_" + uuid.New().String()
+	p.payments[id] = &fakePayment{amount: req.Amount}
+	if req.IdempotencyKey != "" {
+		p.authorized[req.IdempotencyKey] = id
+	}
+	return id, nil
+}
+
+// Capture は売上を確定する
+func (p *FakeProvider) Capture(ctx context.Context, providerPaymentID string, amount int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	fp, ok := p.payments[providerPaymentID]
+	if !ok {
+		return payment.ErrPaymentNotFound
+	}
+	if amount > fp.amount {
+		return payment.ErrInvalidAmount
+	}
+	fp.captured = true
+	return nil
+}
+
+// Refund は返金する
+// 同じ冪等性キーの返金は2回目以降何もしない
+func (p *FakeProvider) Refund(ctx context.Context, req payment.RefundRequest) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if req.IdempotencyKey != "" && p.refunded[req.IdempotencyKey] {
+		return nil
+	}
+	fp, ok := p.payments[req.ProviderPaymentID]
+	if !ok {
+		return payment.ErrPaymentNotFound
+	}
+	if !fp.captured {
+		return payment.ErrInvalidStatusTransition
+	}
+	if req.Amount < 0 || fp.refunded+req.Amount > fp.amount {
+		return payment.ErrInvalidAmount
+	}
+	fp.refunded += req.Amount
+	if req.IdempotencyKey != "" {
+		p.refunded[req.IdempotencyKey] = true
+	}
+	return nil
+}
+
+// VerifyWebhook はHMAC-SHA256署名を検証し、通知内容を返す
+func (p *FakeProvider) VerifyWebhook(payload []byte, signature string) (*payment.WebhookEvent, error) {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expected, p.mac(payload)) {
+		return nil, payment.ErrInvalidWebhookSignature
+	}
+	var body fakeWebhookPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, payment.ErrUnknownWebhookEvent
+	}
+	eventType := payment.WebhookEventType(body.Type)
+	switch eventType {
+	case payment.WebhookPaymentCaptured, payment.WebhookPaymentFailed, payment.WebhookPaymentRefunded:
+	default:
+		return nil, payment.ErrUnknownWebhookEvent
+	}
+	return &payment.WebhookEvent{Type: eventType, ProviderPaymentID: body.PaymentID, Reason: body.Reason}, nil
+}
+
+// SignWebhook はWebhook本文の署名を返す（テストやローカルでの通知再現用）
+func (p *FakeProvider) SignWebhook(payload []byte) string {
+	return hex.EncodeToString(p.mac(payload))
+}
+
+func (p *FakeProvider) mac(payload []byte) []byte {
+	m := hmac.New(sha256.New, p.webhookSecret)
+	m.Write(payload)
+	return m.Sum(nil)
+}
+
+// hang は応答しないプロバイダーを再現する
+// 呼び出し側のコンテキストが先に終了した場合もタイムアウトとして扱う
+func (p *FakeProvider) hang(ctx context.Context) error {
+	timer := time.NewTimer(p.timeout)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+	return payment.ErrPaymentTimeout
+}
@@ -0,0 +1,127 @@
+package payment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/payment"
+)
+
+func TestFakeProvider_AuthorizeCaptureRefund(t *testing.T) {
+	p := NewFakeProvider("secret", 10*time.Millisecond)
+	ctx := context.Background()
+
+	id, err := p.Authorize(ctx, payment.AuthorizeRequest{ReservationID: "res-1", Amount: 5000, PaymentToken: "tok_visa"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, id)
+
+	t.Run("売上確定前は返金できない", func(t *testing.T) {
+		assert.ErrorIs(t, p.Refund(ctx, payment.RefundRequest{ProviderPaymentID: id, Amount: 1000}), payment.ErrInvalidStatusTransition)
+	})
+
+	t.Run("オーソリ金額を超えて売上確定できない", func(t *testing.T) {
+		assert.ErrorIs(t, p.Capture(ctx, id, 6000), payment.ErrInvalidAmount)
+	})
+
+	t.Run("売上確定と返金ができる", func(t *testing.T) {
+		require.NoError(t, p.Capture(ctx, id, 5000))
+		require.NoError(t, p.Refund(ctx, payment.RefundRequest{ProviderPaymentID: id, Amount: 3000}))
+		assert.ErrorIs(t, p.Refund(ctx, payment.RefundRequest{ProviderPaymentID: id, Amount: 3000}), payment.ErrInvalidAmount)
+	})
+
+	t.Run("存在しない決済は見つからない", func(t *testing.T) {
+		assert.ErrorIs(t, p.Capture(ctx, "unknown", 100), payment.ErrPaymentNotFound)
+	})
+}
+
+func TestFakeProvider_AuthorizeIdempotency(t *testing.T) {
+	p := NewFakeProvider("secret", 10*time.Millisecond)
+	ctx := context.Background()
+	req := payment.AuthorizeRequest{ReservationID: "res-1", Amount: 5000, PaymentToken: "tok_visa", IdempotencyKey: "reservation:res-1:authorize:1"}
+
+	first, err := p.Authorize(ctx, req)
+	require.NoError(t, err)
+	second, err := p.Authorize(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	req.IdempotencyKey = "reservation:res-1:authorize:2"
+	third, err := p.Authorize(ctx, req)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, third)
+}
+
+func TestFakeProvider_RefundIdempotency(t *testing.T) {
+	p := NewFakeProvider("secret", 10*time.Millisecond)
+	ctx := context.Background()
+	id, err := p.Authorize(ctx, payment.AuthorizeRequest{ReservationID: "res-1", Amount: 5000, PaymentToken: "tok_visa"})
+	require.NoError(t, err)
+	require.NoError(t, p.Capture(ctx, id, 5000))
+
+	req := payment.RefundRequest{ProviderPaymentID: id, Amount: 3000, IdempotencyKey: "reservation:res-1:refund"}
+	require.NoError(t, p.Refund(ctx, req))
+	// 同じキーの再送は二重に返金しない
+	require.NoError(t, p.Refund(ctx, req))
+	assert.NoError(t, p.Refund(ctx, payment.RefundRequest{ProviderPaymentID: id, Amount: 2000}))
+}
+
+func TestFakeProvider_AuthorizeFailures(t *testing.T) {
+	p := NewFakeProvider("secret", 20*time.Millisecond)
+	ctx := context.Background()
+
+	t.Run("決済トークンがない場合エラー", func(t *testing.T) {
+		_, err := p.Authorize(ctx, payment.AuthorizeRequest{Amount: 100})
+		assert.ErrorIs(t, err, payment.ErrPaymentMethodRequired)
+	})
+
+	t.Run("拒否されるカード", func(t *testing.T) {
+		_, err := p.Authorize(ctx, payment.AuthorizeRequest{Amount: 100, PaymentToken: FakeTokenDecline})
+		assert.ErrorIs(t, err, payment.ErrPaymentDeclined)
+	})
+
+	t.Run("プロバイダーのタイムアウト", func(t *testing.T) {
+		start := time.Now()
+		_, err := p.Authorize(ctx, payment.AuthorizeRequest{Amount: 100, PaymentToken: FakeTokenTimeout})
+		assert.ErrorIs(t, err, payment.ErrPaymentTimeout)
+		assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	})
+
+	t.Run("呼び出し側のコンテキストが先に切れてもタイムアウト", func(t *testing.T) {
+		slow := NewFakeProvider("secret", time.Minute)
+		cctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		_, err := slow.Authorize(cctx, payment.AuthorizeRequest{Amount: 100, PaymentToken: FakeTokenTimeout})
+		assert.ErrorIs(t, err, payment.ErrPaymentTimeout)
+	})
+}
+
+func TestFakeProvider_VerifyWebhook(t *testing.T) {
+	p := NewFakeProvider("secret", time.Second)
+	payload := []byte(`{"type":"payment.captured","payment_id":"fake_pay_1"}`)
+
+	t.Run("正しい署名を検証できる", func(t *testing.T) {
+		ev, err := p.VerifyWebhook(payload, p.SignWebhook(payload))
+		require.NoError(t, err)
+		assert.Equal(t, payment.WebhookPaymentCaptured, ev.Type)
+		assert.Equal(t, "fake_pay_1", ev.ProviderPaymentID)
+	})
+
+	t.Run("署名が不正な場合エラー", func(t *testing.T) {
+		other := NewFakeProvider("other-secret", time.Second)
+		_, err := p.VerifyWebhook(payload, other.SignWebhook(payload))
+		assert.ErrorIs(t, err, payment.ErrInvalidWebhookSignature)
+
+		_, err = p.VerifyWebhook(payload, "not-hex")
+		assert.ErrorIs(t, err, payment.ErrInvalidWebhookSignature)
+	})
+
+	t.Run("不明なイベントはエラー", func(t *testing.T) {
+		unknown := []byte(`{"type":"payment.disputed","payment_id":"fake_pay_1"}`)
+		_, err := p.VerifyWebhook(unknown, p.SignWebhook(unknown))
+		assert.ErrorIs(t, err, payment.ErrUnknownWebhookEvent)
+	})
+}
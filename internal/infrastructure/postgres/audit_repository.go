@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/audit"
+)
+
+// auditExcludedColumns は監査ログの JSON に含めないカラム（イベント検索用の生成列）
+var auditExcludedColumns = pq.StringArray{"search_vector", "search_text"}
+
+// auditArgs は監査ログの記録に使う引数を args の後ろに追加し、エンティティ種別・操作・実行者・除外カラムのプレースホルダーを返す
+func auditArgs(ctx context.Context, args []interface{}, entity audit.EntityType, action audit.Action) ([]interface{}, string, string, string, string) {
+	n := len(args)
+	args = append(args, string(entity), string(action), audit.ActorFromContext(ctx), auditExcludedColumns)
+	return args, fmt.Sprintf("$%d", n+1), fmt.Sprintf("$%d", n+2), fmt.Sprintf("$%d", n+3), fmt.Sprintf("$%d::text[]", n+4)
+}
+
+// auditedInsert は INSERT 文（RETURNING なし）を実行して挿入した行を監査ログに記録し、挿入した行のIDを挿入順に返す
+// 挿入と記録は1つの文で行うため、トランザクション外で呼び出しても片方だけが反映されることはない
+func auditedInsert(ctx context.Context, q sqlx.QueryerContext, entity audit.EntityType, insert string, args ...interface{}) ([]string, error) {
+	args, entityArg, actionArg, actorArg, excluded := auditArgs(ctx, args, entity, audit.ActionCreate)
+	query := `
+		WITH new_rows AS (` + insert + ` RETURNING *),
+		logged AS (
+			INSERT INTO audit_logs (entity_type, entity_id, action, actor_id, after_data)
+			SELECT ` + entityArg + `, id::text, ` + actionArg + `, ` + actorArg + `, to_jsonb(new_rows) - ` + excluded + ` FROM new_rows
+		)
+		SELECT id FROM new_rows`
+
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// auditedUpdate は table の cond に一致する行を set で更新し、更新前後の行を監査ログに記録して更新した行数を返す
+// 更新前の行は FOR UPDATE でロックして取得するため、同時に更新された場合も記録する変更前の値と実際の更新が一致する
+func auditedUpdate(ctx context.Context, q sqlx.ExecerContext, entity audit.EntityType, action audit.Action, table, set, cond string, args ...interface{}) (int, error) {
+	args, entityArg, actionArg, actorArg, excluded := auditArgs(ctx, args, entity, action)
+	query := `
+		WITH old_rows AS (SELECT * FROM ` + table + ` WHERE ` + cond + ` FOR UPDATE),
+		new_rows AS (UPDATE ` + table + ` SET ` + set + ` WHERE id IN (SELECT id FROM old_rows) RETURNING *)
+		INSERT INTO audit_logs (entity_type, entity_id, action, actor_id, before_data, after_data)
+		SELECT ` + entityArg + `, new_rows.id::text, ` + actionArg + `, ` + actorArg + `,
+			to_jsonb(old_rows) - ` + excluded + `, to_jsonb(new_rows) - ` + excluded + `
+		FROM new_rows JOIN old_rows ON old_rows.id = new_rows.id`
+
+	result, err := q.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("更新結果の確認に失敗しました: %w", err)
+	}
+	return int(rows), nil
+}
+
+type auditRow struct {
+	ID         string    `db:"id"`
+	EntityType string    `db:"entity_type"`
+	EntityID   string    `db:"entity_id"`
+	Action     string    `db:"action"`
+	ActorID    string    `db:"actor_id"`
+	BeforeData []byte    `db:"before_data"`
+	AfterData  []byte    `db:"after_data"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+// auditColumns はSELECT対象のカラム一覧
+const auditColumns = `id, entity_type, entity_id, action, actor_id, before_data, after_data, created_at`
+
+func (r *auditRow) toEntity() *audit.Entry {
+	return &audit.Entry{
+		ID: r.ID, EntityType: audit.EntityType(r.EntityType), EntityID: r.EntityID,
+		Action: audit.Action(r.Action), ActorID: r.ActorID,
+		Before: json.RawMessage(r.BeforeData), After: json.RawMessage(r.AfterData),
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+// AuditRepository は監査ログリポジトリのPostgreSQL実装
+type AuditRepository struct{ db *sqlx.DB }
+
+// NewAuditRepository はAuditRepositoryを作成する
+func NewAuditRepository(db *sqlx.DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+// List はエンティティの監査ログを記録日時の新しい順に取得する
+func (r *AuditRepository) List(ctx context.Context, filter audit.ListFilter) ([]*audit.Entry, error) {
+	var q queryBuilder
+	q.where("entity_type = " + q.arg(string(filter.EntityType)))
+	q.where("entity_id = " + q.arg(filter.EntityID))
+	q.after(filter.After)
+	query := `SELECT ` + auditColumns + ` FROM audit_logs` + q.whereClause() +
+		` ORDER BY created_at DESC, id DESC LIMIT ` + q.arg(filter.Limit)
+
+	var rows []auditRow
+	if err := r.db.SelectContext(ctx, &rows, query, q.args...); err != nil {
+		return nil, fmt.Errorf("監査ログの取得に失敗: %w", err)
+	}
+	entries := make([]*audit.Entry, len(rows))
+	for i := range rows {
+		entries[i] = rows[i].toEntity()
+	}
+	return entries, nil
+}
+
+var _ audit.Repository = (*AuditRepository)(nil)
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/transaction"
+)
+
+type eventCancellationRow struct {
+	EventID                   string     `db:"event_id"`
+	Status                    string     `db:"status"`
+	TotalReservations         int        `db:"total_reservations"`
+	ProcessedReservations     int        `db:"processed_reservations"`
+	CancelledReservations     int        `db:"cancelled_reservations"`
+	RefundPendingReservations int        `db:"refund_pending_reservations"`
+	ReleasedSeats             int        `db:"released_seats"`
+	StartedAt                 time.Time  `db:"started_at"`
+	UpdatedAt                 time.Time  `db:"updated_at"`
+	CompletedAt               *time.Time `db:"completed_at"`
+}
+
+// eventCancellationColumns はSELECT対象のカラム一覧
+const eventCancellationColumns = `event_id, status, total_reservations, processed_reservations, cancelled_reservations, refund_pending_reservations, released_seats, started_at, updated_at, completed_at`
+
+func (r *eventCancellationRow) toEntity() *event.Cancellation {
+	return &event.Cancellation{
+		EventID: r.EventID, Status: event.CancellationStatus(r.Status),
+		TotalReservations:         r.TotalReservations,
+		ProcessedReservations:     r.ProcessedReservations,
+		CancelledReservations:     r.CancelledReservations,
+		RefundPendingReservations: r.RefundPendingReservations,
+		ReleasedSeats:             r.ReleasedSeats,
+		StartedAt:                 r.StartedAt, UpdatedAt: r.UpdatedAt, CompletedAt: r.CompletedAt,
+	}
+}
+
+// EventCancellationRepository はイベントの中止処理リポジトリのPostgreSQL実装
+type EventCancellationRepository struct{ db *sqlx.DB }
+
+// NewEventCancellationRepository はEventCancellationRepositoryを作成する
+func NewEventCancellationRepository(db *sqlx.DB) *EventCancellationRepository {
+	return &EventCancellationRepository{db: db}
+}
+
+func (r *EventCancellationRepository) Create(ctx context.Context, c *event.Cancellation) error {
+	query := `INSERT INTO event_cancellations (event_id, status, total_reservations, started_at, updated_at) VALUES ($1, $2, $3, $4, $5)`
+	if _, err := r.db.ExecContext(ctx, query, c.EventID, string(c.Status), c.TotalReservations, c.StartedAt, c.UpdatedAt); err != nil {
+		if pgErr, ok := err.(*pq.Error); ok && pgErr.Code == "23505" {
+			return event.ErrCancellationExists
+		}
+		return fmt.Errorf("中止処理の作成に失敗: %w", err)
+	}
+	return nil
+}
+
+func (r *EventCancellationRepository) GetByEventID(ctx context.Context, eventID string) (*event.Cancellation, error) {
+	return r.getOne(ctx, r.db, `SELECT `+eventCancellationColumns+` FROM event_cancellations WHERE event_id = $1`, eventID)
+}
+
+func (r *EventCancellationRepository) GetForUpdate(ctx context.Context, tx transaction.Tx, eventID string) (*event.Cancellation, error) {
+	sqlxTx := UnwrapTx(tx)
+	if sqlxTx == nil {
+		return nil, fmt.Errorf("無効なトランザクション")
+	}
+	return r.getOne(ctx, sqlxTx, `SELECT `+eventCancellationColumns+` FROM event_cancellations WHERE event_id = $1 FOR UPDATE`, eventID)
+}
+
+func (r *EventCancellationRepository) Update(ctx context.Context, tx transaction.Tx, c *event.Cancellation) error {
+	sqlxTx := UnwrapTx(tx)
+	if sqlxTx == nil {
+		return fmt.Errorf("無効なトランザクション")
+	}
+	query := `UPDATE event_cancellations SET status = $1, processed_reservations = $2, cancelled_reservations = $3, refund_pending_reservations = $4, released_seats = $5, updated_at = $6, completed_at = $7 WHERE event_id = $8`
+	result, err := sqlxTx.ExecContext(ctx, query, string(c.Status), c.ProcessedReservations, c.CancelledReservations,
+		c.RefundPendingReservations, c.ReleasedSeats, c.UpdatedAt, c.CompletedAt, c.EventID)
+	if err != nil {
+		return fmt.Errorf("中止処理の更新に失敗: %w", err)
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return event.ErrCancellationNotFound
+	}
+	return nil
+}
+
+func (r *EventCancellationRepository) ListRunning(ctx context.Context) ([]*event.Cancellation, error) {
+	var rows []eventCancellationRow
+	query := `SELECT ` + eventCancellationColumns + ` FROM event_cancellations WHERE status = $1 ORDER BY started_at`
+	if err := r.db.SelectContext(ctx, &rows, query, string(event.CancellationRunning)); err != nil {
+		return nil, fmt.Errorf("中止処理の一覧取得に失敗: %w", err)
+	}
+	result := make([]*event.Cancellation, len(rows))
+	for i := range rows {
+		result[i] = rows[i].toEntity()
+	}
+	return result, nil
+}
+
+func (r *EventCancellationRepository) getOne(ctx context.Context, q sqlx.QueryerContext, query string, args ...interface{}) (*event.Cancellation, error) {
+	var row eventCancellationRow
+	if err := sqlx.GetContext(ctx, q, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, event.ErrCancellationNotFound
+		}
+		return nil, fmt.Errorf("中止処理の取得に失敗: %w", err)
+	}
+	return row.toEntity(), nil
+}
+
+var _ event.CancellationRepository = (*EventCancellationRepository)(nil)
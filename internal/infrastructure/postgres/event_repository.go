@@ -9,43 +9,86 @@ import (
 
 	"github.com/jmoiron/sqlx"
 
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/audit"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
 )
 
 // eventRow はDBの行を表す構造体
 type eventRow struct {
-	ID          string    `db:"id"`
-	Name        string    `db:"name"`
-	Description *string   `db:"description"`
-	Venue       *string   `db:"venue"`
-	StartAt     time.Time `db:"start_at"`
-	EndAt       time.Time `db:"end_at"`
-	TotalSeats  int       `db:"total_seats"`
-	CreatedAt   time.Time `db:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at"`
-	Version     int       `db:"version"`
+	ID                     string     `db:"id"`
+	Name                   string     `db:"name"`
+	Description            *string    `db:"description"`
+	Venue                  *string    `db:"venue"`
+	StartAt                time.Time  `db:"start_at"`
+	EndAt                  time.Time  `db:"end_at"`
+	TotalSeats             int        `db:"total_seats"`
+	Status                 string     `db:"status"`
+	SalesStartAt           *time.Time `db:"sales_start_at"`
+	SalesEndAt             *time.Time `db:"sales_end_at"`
+	HoldDurationSeconds    int        `db:"hold_duration_seconds"`
+	MaxHoldDurationSeconds int        `db:"max_hold_duration_seconds"`
+	MaxHoldExtensions      int        `db:"max_hold_extensions"`
+	WaitingRoomEnabled     bool       `db:"waiting_room_enabled"`
+	FullRefundBeforeSecs   int        `db:"full_refund_before_seconds"`
+	PartialRefundPercent   int        `db:"partial_refund_percent"`
+	RefundCutoffSeconds    int        `db:"refund_cutoff_seconds"`
+	MaxSeatsPerReservation int        `db:"max_seats_per_reservation"`
+	MaxSeatsPerUser        int        `db:"max_seats_per_user"`
+	OrganizerID            *string    `db:"organizer_id"`
+	CreatedAt              time.Time  `db:"created_at"`
+	UpdatedAt              time.Time  `db:"updated_at"`
+	Version                int        `db:"version"`
 }
 
+// eventColumns はSELECT対象のカラム一覧
+const eventColumns = `id, name, description, venue, start_at, end_at, total_seats, status, sales_start_at, sales_end_at,
+	hold_duration_seconds, max_hold_duration_seconds, max_hold_extensions,
+	waiting_room_enabled, full_refund_before_seconds, partial_refund_percent, refund_cutoff_seconds,
+	max_seats_per_reservation, max_seats_per_user, organizer_id, created_at, updated_at, version`
+
 // toEntity はeventRowをEventエンティティに変換する
 func (r *eventRow) toEntity() *event.Event {
-	var desc, venue string
+	var desc, venue, organizerID string
 	if r.Description != nil {
 		desc = *r.Description
 	}
 	if r.Venue != nil {
 		venue = *r.Venue
 	}
+	if r.OrganizerID != nil {
+		organizerID = *r.OrganizerID
+	}
+	var salesStartAt, salesEndAt time.Time
+	if r.SalesStartAt != nil {
+		salesStartAt = *r.SalesStartAt
+	}
+	if r.SalesEndAt != nil {
+		salesEndAt = *r.SalesEndAt
+	}
 	return &event.Event{
-		ID:          r.ID,
-		Name:        r.Name,
-		Description: desc,
-		Venue:       venue,
-		StartAt:     r.StartAt,
-		EndAt:       r.EndAt,
-		TotalSeats:  r.TotalSeats,
-		CreatedAt:   r.CreatedAt,
-		UpdatedAt:   r.UpdatedAt,
-		Version:     r.Version,
+		ID:                     r.ID,
+		Name:                   r.Name,
+		Description:            desc,
+		Venue:                  venue,
+		StartAt:                r.StartAt,
+		EndAt:                  r.EndAt,
+		TotalSeats:             r.TotalSeats,
+		Status:                 event.Status(r.Status),
+		SalesStartAt:           salesStartAt,
+		SalesEndAt:             salesEndAt,
+		HoldDuration:           time.Duration(r.HoldDurationSeconds) * time.Second,
+		MaxHoldDuration:        time.Duration(r.MaxHoldDurationSeconds) * time.Second,
+		MaxHoldExtensions:      r.MaxHoldExtensions,
+		WaitingRoomEnabled:     r.WaitingRoomEnabled,
+		FullRefundBefore:       time.Duration(r.FullRefundBeforeSecs) * time.Second,
+		PartialRefundPercent:   r.PartialRefundPercent,
+		RefundCutoff:           time.Duration(r.RefundCutoffSeconds) * time.Second,
+		MaxSeatsPerReservation: r.MaxSeatsPerReservation,
+		MaxSeatsPerUser:        r.MaxSeatsPerUser,
+		OrganizerID:            organizerID,
+		CreatedAt:              r.CreatedAt,
+		UpdatedAt:              r.UpdatedAt,
+		Version:                r.Version,
 	}
 }
 
@@ -59,33 +102,45 @@ func NewEventRepository(db *sqlx.DB) *EventRepository {
 	return &EventRepository{db: db}
 }
 
-// Create は新しいイベントを作成する
+// Create は新しいイベントを作成し、監査ログに記録する
 func (r *EventRepository) Create(ctx context.Context, e *event.Event) error {
 	query := `
-		INSERT INTO events (name, description, venue, start_at, end_at, total_seats, created_at, updated_at, version)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-		RETURNING id
+		INSERT INTO events (name, description, venue, start_at, end_at, total_seats,
+		                    hold_duration_seconds, max_hold_duration_seconds, max_hold_extensions,
+		                    waiting_room_enabled, full_refund_before_seconds, partial_refund_percent,
+		                    refund_cutoff_seconds, max_seats_per_reservation, max_seats_per_user,
+		                    organizer_id, created_at, updated_at, version, status, sales_start_at, sales_end_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
 	`
-	var desc, venue *string
+	var desc, venue, organizerID *string
 	if e.Description != "" {
 		desc = &e.Description
 	}
 	if e.Venue != "" {
 		venue = &e.Venue
 	}
+	if e.OrganizerID != "" {
+		organizerID = &e.OrganizerID
+	}
 
-	err := r.db.QueryRowContext(ctx, query,
-		e.Name, desc, venue, e.StartAt, e.EndAt, e.TotalSeats, e.CreatedAt, e.UpdatedAt, e.Version,
-	).Scan(&e.ID)
+	ids, err := auditedInsert(ctx, r.db, audit.EntityEvent, query,
+		e.Name, desc, venue, e.StartAt, e.EndAt, e.TotalSeats,
+		int(e.HoldDuration/time.Second), int(e.MaxHoldDuration/time.Second), e.MaxHoldExtensions,
+		e.WaitingRoomEnabled, int(e.FullRefundBefore/time.Second), e.PartialRefundPercent,
+		int(e.RefundCutoff/time.Second), e.MaxSeatsPerReservation, e.MaxSeatsPerUser,
+		organizerID, e.CreatedAt, e.UpdatedAt, e.Version,
+		string(e.Status), nullableTime(e.SalesStartAt), nullableTime(e.SalesEndAt),
+	)
 	if err != nil {
 		return fmt.Errorf("イベント作成に失敗しました: %w", err)
 	}
+	e.ID = ids[0]
 	return nil
 }
 
-// GetByID はIDからイベントを取得する
+// GetByID はIDからイベントを取得する（削除済みのイベントは ErrEventNotFound）
 func (r *EventRepository) GetByID(ctx context.Context, id string) (*event.Event, error) {
-	query := `SELECT id, name, description, venue, start_at, end_at, total_seats, created_at, updated_at, version FROM events WHERE id = $1`
+	query := `SELECT ` + eventColumns + ` FROM events WHERE id = $1 AND deleted_at IS NULL`
 
 	var row eventRow
 	err := r.db.GetContext(ctx, &row, query, id)
@@ -98,17 +153,32 @@ func (r *EventRepository) GetByID(ctx context.Context, id string) (*event.Event,
 	return row.toEntity(), nil
 }
 
-// List はイベント一覧を取得する
-func (r *EventRepository) List(ctx context.Context, limit, offset int) ([]*event.Event, error) {
-	query := `
-		SELECT id, name, description, venue, start_at, end_at, total_seats, created_at, updated_at, version 
-		FROM events 
-		ORDER BY start_at DESC 
-		LIMIT $1 OFFSET $2
-	`
+// List はイベント一覧を作成日時の新しい順に取得する
+// (created_at, id) のキーセットでページングするため、途中で行が追加されてもページ間で重複しない
+func (r *EventRepository) List(ctx context.Context, filter event.ListFilter) ([]*event.Event, error) {
+	var q queryBuilder
+	q.where("deleted_at IS NULL")
+	if filter.PublicOnly {
+		q.where("status <> " + q.arg(string(event.StatusDraft)))
+	}
+	if filter.StartFrom != nil {
+		q.where("start_at >= " + q.arg(*filter.StartFrom))
+	}
+	if filter.StartTo != nil {
+		q.where("start_at < " + q.arg(*filter.StartTo))
+	}
+	if filter.Venue != "" {
+		q.where("venue = " + q.arg(filter.Venue))
+	}
+	if filter.Query != "" {
+		q.where("name ILIKE " + q.arg("%"+escapeLike(filter.Query)+"%"))
+	}
+	q.after(filter.After)
+	query := `SELECT ` + eventColumns + ` FROM events` + q.whereClause() +
+		` ORDER BY created_at DESC, id DESC LIMIT ` + q.arg(filter.Limit)
 
 	var rows []eventRow
-	err := r.db.SelectContext(ctx, &rows, query, limit, offset)
+	err := r.db.SelectContext(ctx, &rows, query, q.args...)
 	if err != nil {
 		return nil, fmt.Errorf("イベント一覧取得に失敗しました: %w", err)
 	}
@@ -120,14 +190,16 @@ func (r *EventRepository) List(ctx context.Context, limit, offset int) ([]*event
 	return events, nil
 }
 
-// Update はイベントを更新する（楽観的ロック）
+// Update はイベントを更新し、変更前後を監査ログに記録する（楽観的ロック）
+// 総座席数を減らす場合は、座席の作成（SeatRepository.CreateBulk）と同じイベントの行ロックの下で作成済みの座席数を下回らないことを確認する
 func (r *EventRepository) Update(ctx context.Context, e *event.Event) error {
-	query := `
-		UPDATE events
-		SET name = $1, description = $2, venue = $3, start_at = $4, end_at = $5, 
-		    total_seats = $6, updated_at = $7, version = version + 1
-		WHERE id = $8 AND version = $9
-	`
+	set := `name = $1, description = $2, venue = $3, start_at = $4, end_at = $5,
+		    total_seats = $6, hold_duration_seconds = $7, max_hold_duration_seconds = $8,
+		    max_hold_extensions = $9, waiting_room_enabled = $10, full_refund_before_seconds = $11,
+		    partial_refund_percent = $12, refund_cutoff_seconds = $13, max_seats_per_reservation = $14,
+		    max_seats_per_user = $15, status = $16, sales_start_at = $17, sales_end_at = $18,
+		    updated_at = $19, version = version + 1`
+	cond := `id = $20 AND version = $21 AND deleted_at IS NULL`
 
 	var desc, venue *string
 	if e.Description != "" {
@@ -137,43 +209,78 @@ func (r *EventRepository) Update(ctx context.Context, e *event.Event) error {
 		venue = &e.Venue
 	}
 
-	result, err := r.db.ExecContext(ctx, query,
-		e.Name, desc, venue, e.StartAt, e.EndAt, e.TotalSeats, time.Now(), e.ID, e.Version,
-	)
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("イベント更新に失敗しました: %w", err)
+		return fmt.Errorf("トランザクション開始に失敗しました: %w", err)
 	}
+	defer tx.Rollback()
 
-	rowsAffected, err := result.RowsAffected()
+	totalSeats, created, err := lockEventSeatCapacity(ctx, tx, e.ID)
 	if err != nil {
-		return fmt.Errorf("更新結果の確認に失敗しました: %w", err)
+		return err
+	}
+	if e.TotalSeats < totalSeats && e.TotalSeats < created {
+		return event.ErrTotalSeatsBelowCreated
+	}
+
+	rowsAffected, err := auditedUpdate(ctx, tx, audit.EntityEvent, audit.ActionUpdate, "events", set, cond,
+		e.Name, desc, venue, e.StartAt, e.EndAt, e.TotalSeats,
+		int(e.HoldDuration/time.Second), int(e.MaxHoldDuration/time.Second), e.MaxHoldExtensions,
+		e.WaitingRoomEnabled, int(e.FullRefundBefore/time.Second), e.PartialRefundPercent,
+		int(e.RefundCutoff/time.Second), e.MaxSeatsPerReservation, e.MaxSeatsPerUser,
+		string(e.Status), nullableTime(e.SalesStartAt), nullableTime(e.SalesEndAt),
+		time.Now(), e.ID, e.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("イベント更新に失敗しました: %w", err)
 	}
 	if rowsAffected == 0 {
-		return event.ErrEventNotFound
+		// ロックした行が残っているため、バージョンが変わっている（他のリクエストが先に更新した）
+		return event.ErrOptimisticLockConflict
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("イベント更新のコミットに失敗しました: %w", err)
 	}
 
 	e.Version++
 	return nil
 }
 
-// Delete はイベントを削除する
+// Delete はイベントとその座席を論理削除（deleted_at を設定）し、監査ログに記録する
+// 予約や監査ログから参照できるよう行は残し、以降の取得・一覧・検索の対象から外す
 func (r *EventRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM events WHERE id = $1`
-
-	result, err := r.db.ExecContext(ctx, query, id)
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("イベント削除に失敗しました: %w", err)
+		return fmt.Errorf("トランザクション開始に失敗しました: %w", err)
 	}
+	defer tx.Rollback()
 
-	rowsAffected, err := result.RowsAffected()
+	now := time.Now()
+	rowsAffected, err := auditedUpdate(ctx, tx, audit.EntityEvent, audit.ActionDelete, "events",
+		`deleted_at = $2, updated_at = $2, version = version + 1`, `id = $1 AND deleted_at IS NULL`, id, now)
 	if err != nil {
-		return fmt.Errorf("削除結果の確認に失敗しました: %w", err)
+		return fmt.Errorf("イベント削除に失敗しました: %w", err)
 	}
 	if rowsAffected == 0 {
 		return event.ErrEventNotFound
 	}
+	if _, err := auditedUpdate(ctx, tx, audit.EntitySeat, audit.ActionDelete, "seats",
+		`deleted_at = $2, updated_at = $2, version = version + 1`, `event_id = $1 AND deleted_at IS NULL`, id, now); err != nil {
+		return fmt.Errorf("座席の削除に失敗しました: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("イベント削除のコミットに失敗しました: %w", err)
+	}
 	return nil
 }
 
 // インターフェースを満たしているか確認
 var _ event.Repository = (*EventRepository)(nil)
+
+// nullableTime はゼロ値の日時を NULL として保存する
+func nullableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
+)
+
+// ts_headline が一致した語を囲む区切り文字
+// イベントのテキストに含まれない制御文字を使い、HTML エスケープした後で <mark> に置き換える
+const (
+	headlineStartSel = "\x02"
+	headlineStopSel  = "\x03"
+)
+
+// headlineOptions は ts_headline のオプション
+const headlineOptions = "StartSel=" + headlineStartSel + ", StopSel=" + headlineStopSel +
+	`, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" … "`
+
+// snippetRadius は部分一致の抜粋で一致箇所の前後に含める文字数
+const snippetRadius = 30
+
+// eventSearchRow は検索結果の行
+type eventSearchRow struct {
+	eventRow
+	Rank     float64 `db:"rank"`
+	Headline string  `db:"headline"`
+}
+
+// Search はキーワードに一致するイベントを関連度の高い順に取得する
+// tsvector の全文検索に加え、空白で区切られない日本語のために search_text の部分一致（ILIKE）と
+// pg_trgm の類似度（<%）でも一致を判定する
+func (r *EventRepository) Search(ctx context.Context, filter event.SearchFilter) ([]*event.SearchResult, error) {
+	var q queryBuilder
+	query := q.arg(filter.Query)
+	options := q.arg(headlineOptions)
+
+	terms := strings.Fields(filter.Query)
+	likes := make([]string, len(terms))
+	for i, term := range terms {
+		likes[i] = "search_text ILIKE " + q.arg("%"+escapeLike(term)+"%")
+	}
+	q.where("deleted_at IS NULL")
+	q.where("(search_vector @@ tsq OR (" + strings.Join(likes, " AND ") + ") OR " + query + " <% search_text)")
+	if filter.PublicOnly {
+		q.where("status <> " + q.arg(string(event.StatusDraft)))
+	}
+	if filter.StartAfter != nil {
+		q.where("start_at > " + q.arg(*filter.StartAfter))
+	}
+	if filter.HasAvailability {
+		q.where("EXISTS (SELECT 1 FROM seats WHERE seats.event_id = events.id AND seats.status = 'available' AND seats.deleted_at IS NULL)")
+	}
+
+	sqlQuery := `
+		SELECT ` + eventColumns + `,
+			ts_rank_cd(search_vector, tsq) + word_similarity(` + query + `, search_text) AS rank,
+			CASE WHEN search_vector @@ tsq THEN ts_headline('simple', search_text, tsq, ` + options + `) ELSE '' END AS headline
+		FROM events, websearch_to_tsquery('simple', ` + query + `) AS tsq` + q.whereClause() + `
+		ORDER BY rank DESC, start_at ASC, id ASC
+		LIMIT ` + q.arg(filter.Limit)
+
+	var rows []eventSearchRow
+	if err := r.db.SelectContext(ctx, &rows, sqlQuery, q.args...); err != nil {
+		return nil, fmt.Errorf("イベント検索に失敗しました: %w", err)
+	}
+
+	results := make([]*event.SearchResult, len(rows))
+	for i := range rows {
+		e := rows[i].toEntity()
+		results[i] = &event.SearchResult{
+			Event:   e,
+			Rank:    rows[i].Rank,
+			Snippet: buildSnippet(rows[i].Headline, searchText(e), terms),
+		}
+	}
+	return results, nil
+}
+
+// searchText は events.search_text と同じテキストを組み立てる
+func searchText(e *event.Event) string {
+	return e.Name + " " + e.Venue + " " + e.Description
+}
+
+// buildSnippet は検索結果の抜粋を HTML エスケープして一致した語を <mark> で囲む
+// ts_headline で一致箇所が得られなかった場合（日本語の部分一致など）は、最初に一致した語の前後を切り出す
+func buildSnippet(headline, text string, terms []string) string {
+	if strings.Contains(headline, headlineStartSel) {
+		return strings.NewReplacer(headlineStartSel, "<mark>", headlineStopSel, "</mark>").
+			Replace(html.EscapeString(headline))
+	}
+
+	runes := []rune(text)
+	folded := foldRunes(runes)
+	matches := make([]bool, len(runes)) // 各文字がいずれかの語に一致しているか
+	first := -1
+	for _, term := range terms {
+		t := foldRunes([]rune(term))
+		for i := 0; i+len(t) <= len(folded); i++ {
+			if !slices.Equal(folded[i:i+len(t)], t) {
+				continue
+			}
+			for j := i; j < i+len(t); j++ {
+				matches[j] = true
+			}
+			if first == -1 || i < first {
+				first = i
+			}
+		}
+	}
+
+	// 類似度だけで一致した場合は先頭から切り出す
+	start, end := 0, min(len(runes), 2*snippetRadius)
+	if first != -1 {
+		start = max(0, first-snippetRadius)
+		end = min(len(runes), first+snippetRadius)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	marked := false
+	for i := start; i < end; i++ {
+		if matches[i] != marked {
+			if matches[i] {
+				b.WriteString("<mark>")
+			} else {
+				b.WriteString("</mark>")
+			}
+			marked = matches[i]
+		}
+		b.WriteString(html.EscapeString(string(runes[i])))
+	}
+	if marked {
+		b.WriteString("</mark>")
+	}
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+// foldRunes は大文字・小文字を区別せずに比較するため小文字に揃える（文字数は変わらない）
+func foldRunes(rs []rune) []rune {
+	folded := make([]rune, len(rs))
+	for i, r := range rs {
+		folded[i] = unicode.ToLower(r)
+	}
+	return folded
+}
@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/outbox"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/transaction"
+)
+
+type outboxRow struct {
+	ID            int64      `db:"id"`
+	AggregateType string     `db:"aggregate_type"`
+	AggregateID   string     `db:"aggregate_id"`
+	EventType     string     `db:"event_type"`
+	Payload       []byte     `db:"payload"`
+	Attempts      int        `db:"attempts"`
+	LastError     string     `db:"last_error"`
+	CreatedAt     time.Time  `db:"created_at"`
+	PublishedAt   *time.Time `db:"published_at"`
+}
+
+// outboxColumns はSELECT対象のカラム一覧
+const outboxColumns = `id, aggregate_type, aggregate_id, event_type, payload, attempts, last_error, created_at, published_at`
+
+func (r *outboxRow) toEntity() *outbox.Message {
+	return &outbox.Message{
+		ID: r.ID, AggregateType: r.AggregateType, AggregateID: r.AggregateID,
+		EventType: r.EventType, Payload: json.RawMessage(r.Payload),
+		Attempts: r.Attempts, LastError: r.LastError,
+		CreatedAt: r.CreatedAt, PublishedAt: r.PublishedAt,
+	}
+}
+
+// OutboxRepository はアウトボックスリポジトリのPostgreSQL実装
+type OutboxRepository struct{ db *sqlx.DB }
+
+// NewOutboxRepository はOutboxRepositoryを作成する
+func NewOutboxRepository(db *sqlx.DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// Append は業務データと同じトランザクションでメッセージを記録する
+// id は挿入時に採番されるため、同じ集約の行ロックを取った後に呼び出すことで集約内の順序がコミット順と一致する
+func (r *OutboxRepository) Append(ctx context.Context, tx transaction.Tx, msg *outbox.Message) error {
+	sqlxTx := UnwrapTx(tx)
+	if sqlxTx == nil {
+		return fmt.Errorf("無効なトランザクション")
+	}
+	query := `INSERT INTO outbox_messages (aggregate_type, aggregate_id, event_type, payload, created_at) VALUES ($1, $2, $3, $4, $5) RETURNING id`
+	if err := sqlxTx.QueryRowContext(ctx, query,
+		msg.AggregateType, msg.AggregateID, msg.EventType, []byte(msg.Payload), msg.CreatedAt,
+	).Scan(&msg.ID); err != nil {
+		return fmt.Errorf("アウトボックスへの記録に失敗: %w", err)
+	}
+	return nil
+}
+
+func (r *OutboxRepository) GetUnpublished(ctx context.Context, limit int) ([]*outbox.Message, error) {
+	var rows []outboxRow
+	query := `SELECT ` + outboxColumns + ` FROM outbox_messages WHERE published_at IS NULL ORDER BY id LIMIT $1`
+	if err := r.db.SelectContext(ctx, &rows, query, limit); err != nil {
+		return nil, fmt.Errorf("未配信メッセージの取得に失敗: %w", err)
+	}
+	result := make([]*outbox.Message, len(rows))
+	for i := range rows {
+		result[i] = rows[i].toEntity()
+	}
+	return result, nil
+}
+
+func (r *OutboxRepository) Update(ctx context.Context, msg *outbox.Message) error {
+	query := `UPDATE outbox_messages SET attempts = $1, last_error = $2, published_at = $3 WHERE id = $4`
+	result, err := r.db.ExecContext(ctx, query, msg.Attempts, msg.LastError, msg.PublishedAt, msg.ID)
+	if err != nil {
+		return fmt.Errorf("配信結果の更新に失敗: %w", err)
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return outbox.ErrMessageNotFound
+	}
+	return nil
+}
+
+var _ outbox.Repository = (*OutboxRepository)(nil)
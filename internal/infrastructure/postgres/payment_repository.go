@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/payment"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/transaction"
+)
+
+type paymentRow struct {
+	ID                string         `db:"id"`
+	ReservationID     string         `db:"reservation_id"`
+	Provider          string         `db:"provider"`
+	ProviderPaymentID sql.NullString `db:"provider_payment_id"`
+	Amount            int            `db:"amount"`
+	Currency          string         `db:"currency"`
+	Status            string         `db:"status"`
+	RefundedAmount    int            `db:"refunded_amount"`
+	FailureReason     string         `db:"failure_reason"`
+	CreatedAt         time.Time      `db:"created_at"`
+	UpdatedAt         time.Time      `db:"updated_at"`
+}
+
+// paymentColumns はSELECT対象のカラム一覧
+const paymentColumns = `id, reservation_id, provider, provider_payment_id, amount, currency, status, refunded_amount, failure_reason, created_at, updated_at`
+
+func (r *paymentRow) toEntity() *payment.Payment {
+	return &payment.Payment{
+		ID: r.ID, ReservationID: r.ReservationID,
+		Provider: r.Provider, ProviderPaymentID: r.ProviderPaymentID.String,
+		Amount: r.Amount, Currency: r.Currency,
+		Status: payment.Status(r.Status), RefundedAmount: r.RefundedAmount,
+		FailureReason: r.FailureReason, CreatedAt: r.CreatedAt, UpdatedAt: r.UpdatedAt,
+	}
+}
+
+// nullableString は空文字を NULL として保存する
+func nullableString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// PaymentRepository は決済リポジトリのPostgreSQL実装
+type PaymentRepository struct{ db *sqlx.DB }
+
+// NewPaymentRepository はPaymentRepositoryを作成する
+func NewPaymentRepository(db *sqlx.DB) *PaymentRepository {
+	return &PaymentRepository{db: db}
+}
+
+func (r *PaymentRepository) Create(ctx context.Context, p *payment.Payment) error {
+	query := `INSERT INTO payments (reservation_id, provider, provider_payment_id, amount, currency, status, failure_reason, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id`
+	if err := r.db.QueryRowContext(ctx, query,
+		p.ReservationID, p.Provider, nullableString(p.ProviderPaymentID), p.Amount, p.Currency,
+		string(p.Status), p.FailureReason, p.CreatedAt, p.UpdatedAt,
+	).Scan(&p.ID); err != nil {
+		return fmt.Errorf("決済作成に失敗: %w", err)
+	}
+	return nil
+}
+
+func (r *PaymentRepository) GetByID(ctx context.Context, id string) (*payment.Payment, error) {
+	return r.getOne(ctx, `SELECT `+paymentColumns+` FROM payments WHERE id = $1`, id)
+}
+
+func (r *PaymentRepository) GetByReservationID(ctx context.Context, reservationID string) (*payment.Payment, error) {
+	return r.getOne(ctx, `SELECT `+paymentColumns+` FROM payments WHERE reservation_id = $1 ORDER BY created_at DESC, id DESC LIMIT 1`, reservationID)
+}
+
+func (r *PaymentRepository) CountByReservationID(ctx context.Context, reservationID string) (int, error) {
+	var count int
+	if err := r.db.GetContext(ctx, &count, `SELECT COUNT(*) FROM payments WHERE reservation_id = $1`, reservationID); err != nil {
+		return 0, fmt.Errorf("決済件数の取得に失敗: %w", err)
+	}
+	return count, nil
+}
+
+func (r *PaymentRepository) GetByProviderPaymentID(ctx context.Context, provider, providerPaymentID string) (*payment.Payment, error) {
+	return r.getOne(ctx, `SELECT `+paymentColumns+` FROM payments WHERE provider = $1 AND provider_payment_id = $2`, provider, providerPaymentID)
+}
+
+func (r *PaymentRepository) Update(ctx context.Context, p *payment.Payment) error {
+	return r.update(ctx, r.db, p)
+}
+
+func (r *PaymentRepository) UpdateTx(ctx context.Context, tx transaction.Tx, p *payment.Payment) error {
+	sqlxTx := UnwrapTx(tx)
+	if sqlxTx == nil {
+		return fmt.Errorf("無効なトランザクション")
+	}
+	return r.update(ctx, sqlxTx, p)
+}
+
+func (r *PaymentRepository) update(ctx context.Context, exec sqlx.ExecerContext, p *payment.Payment) error {
+	query := `UPDATE payments SET provider_payment_id = $1, status = $2, refunded_amount = $3, failure_reason = $4, updated_at = $5 WHERE id = $6`
+	result, err := exec.ExecContext(ctx, query, nullableString(p.ProviderPaymentID), string(p.Status), p.RefundedAmount, p.FailureReason, p.UpdatedAt, p.ID)
+	if err != nil {
+		return fmt.Errorf("決済更新に失敗: %w", err)
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return payment.ErrPaymentNotFound
+	}
+	return nil
+}
+
+func (r *PaymentRepository) getOne(ctx context.Context, query string, args ...interface{}) (*payment.Payment, error) {
+	var row paymentRow
+	if err := r.db.GetContext(ctx, &row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, payment.ErrPaymentNotFound
+		}
+		return nil, fmt.Errorf("決済取得に失敗: %w", err)
+	}
+	return row.toEntity(), nil
+}
+
+var _ payment.Repository = (*PaymentRepository)(nil)
@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/audit"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pricecategory"
+)
+
+type priceCategoryRow struct {
+	ID        string    `db:"id"`
+	EventID   string    `db:"event_id"`
+	Name      string    `db:"name"`
+	Currency  string    `db:"currency"`
+	Amount    int       `db:"amount"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// priceCategoryColumns はSELECT対象のカラム一覧
+const priceCategoryColumns = `id, event_id, name, currency, amount, created_at, updated_at`
+
+func (r *priceCategoryRow) toEntity() *pricecategory.PriceCategory {
+	return &pricecategory.PriceCategory{
+		ID: r.ID, EventID: r.EventID, Name: r.Name,
+		Currency: r.Currency, Amount: r.Amount,
+		CreatedAt: r.CreatedAt, UpdatedAt: r.UpdatedAt,
+	}
+}
+
+// PriceCategoryRepository は価格カテゴリリポジトリのPostgreSQL実装
+type PriceCategoryRepository struct{ db *sqlx.DB }
+
+// NewPriceCategoryRepository はPriceCategoryRepositoryを作成する
+func NewPriceCategoryRepository(db *sqlx.DB) *PriceCategoryRepository {
+	return &PriceCategoryRepository{db: db}
+}
+
+func (r *PriceCategoryRepository) Create(ctx context.Context, c *pricecategory.PriceCategory) error {
+	query := `INSERT INTO price_categories (event_id, name, currency, amount, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`
+	if err := r.db.QueryRowContext(ctx, query, c.EventID, c.Name, c.Currency, c.Amount, c.CreatedAt, c.UpdatedAt).Scan(&c.ID); err != nil {
+		if pgErr, ok := err.(*pq.Error); ok && pgErr.Code == "23505" {
+			return pricecategory.ErrDuplicateName
+		}
+		return fmt.Errorf("価格カテゴリ作成に失敗: %w", err)
+	}
+	return nil
+}
+
+func (r *PriceCategoryRepository) GetByID(ctx context.Context, id string) (*pricecategory.PriceCategory, error) {
+	var row priceCategoryRow
+	if err := r.db.GetContext(ctx, &row, `SELECT `+priceCategoryColumns+` FROM price_categories WHERE id = $1`, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, pricecategory.ErrPriceCategoryNotFound
+		}
+		return nil, fmt.Errorf("価格カテゴリ取得に失敗: %w", err)
+	}
+	return row.toEntity(), nil
+}
+
+func (r *PriceCategoryRepository) GetByEventID(ctx context.Context, eventID string) ([]*pricecategory.PriceCategory, error) {
+	var rows []priceCategoryRow
+	if err := r.db.SelectContext(ctx, &rows, `SELECT `+priceCategoryColumns+` FROM price_categories WHERE event_id = $1 ORDER BY amount DESC, name`, eventID); err != nil {
+		return nil, fmt.Errorf("価格カテゴリ一覧取得に失敗: %w", err)
+	}
+	categories := make([]*pricecategory.PriceCategory, len(rows))
+	for i, row := range rows {
+		categories[i] = row.toEntity()
+	}
+	return categories, nil
+}
+
+// Update は価格カテゴリを更新する
+// 一覧表示用に seats.price へ非正規化している金額も同一トランザクションで更新し、座席の変更として監査ログに記録する
+func (r *PriceCategoryRepository) Update(ctx context.Context, c *pricecategory.PriceCategory) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("トランザクション開始に失敗: %w", err)
+	}
+	defer tx.Rollback()
+
+	c.UpdatedAt = time.Now()
+	result, err := tx.ExecContext(ctx,
+		`UPDATE price_categories SET name = $1, currency = $2, amount = $3, updated_at = $4 WHERE id = $5`,
+		c.Name, c.Currency, c.Amount, c.UpdatedAt, c.ID)
+	if err != nil {
+		if pgErr, ok := err.(*pq.Error); ok && pgErr.Code == "23505" {
+			return pricecategory.ErrDuplicateName
+		}
+		return fmt.Errorf("価格カテゴリ更新に失敗: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("更新結果の確認に失敗: %w", err)
+	}
+	if rows == 0 {
+		return pricecategory.ErrPriceCategoryNotFound
+	}
+	// 金額が変わらない座席は更新しない（version を進めて楽観的ロックを無駄に失敗させない）
+	if _, err := auditedUpdate(ctx, tx, audit.EntitySeat, audit.ActionUpdate, "seats",
+		`price = $1, updated_at = $2, version = version + 1`,
+		`price_category_id = $3 AND price <> $1 AND deleted_at IS NULL`,
+		c.Amount, c.UpdatedAt, c.ID); err != nil {
+		return fmt.Errorf("座席の価格の更新に失敗: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("価格カテゴリ更新のコミットに失敗: %w", err)
+	}
+	return nil
+}
+
+func (r *PriceCategoryRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM price_categories WHERE id = $1`, id)
+	if err != nil {
+		if pgErr, ok := err.(*pq.Error); ok && pgErr.Code == "23503" {
+			return pricecategory.ErrPriceCategoryInUse
+		}
+		return fmt.Errorf("価格カテゴリ削除に失敗: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("削除結果の確認に失敗: %w", err)
+	}
+	if rows == 0 {
+		return pricecategory.ErrPriceCategoryNotFound
+	}
+	return nil
+}
+
+var _ pricecategory.Repository = (*PriceCategoryRepository)(nil)
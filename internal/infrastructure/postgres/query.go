@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/pagination"
+)
+
+// queryBuilder は絞り込み条件に応じた WHERE 句とプレースホルダーの引数を組み立てる
+type queryBuilder struct {
+	conds []string
+	args  []interface{}
+}
+
+// arg は引数を追加し、対応するプレースホルダー（$n）を返す
+func (b *queryBuilder) arg(v interface{}) string {
+	b.args = append(b.args, v)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+// where は AND で結合する条件を追加する
+func (b *queryBuilder) where(cond string) {
+	b.conds = append(b.conds, cond)
+}
+
+// after はキーセットページネーションの条件を追加する（(created_at, id) の降順で cursor より後の行）
+func (b *queryBuilder) after(cursor *pagination.Cursor) {
+	if cursor == nil {
+		return
+	}
+	b.where("(created_at, id) < (" + b.arg(cursor.CreatedAt) + ", " + b.arg(cursor.ID) + ")")
+}
+
+// whereClause は WHERE 句を返す（条件がない場合は空文字）
+func (b *queryBuilder) whereClause() string {
+	if len(b.conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(b.conds, " AND ")
+}
+
+// escapeLike は LIKE のパターンで特別な意味を持つ文字をエスケープする
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/audit"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/reservation"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/transaction"
 )
@@ -23,10 +24,16 @@ type reservationRow struct {
 	TotalAmount    int        `db:"total_amount"`
 	ExpiresAt      time.Time  `db:"expires_at"`
 	ConfirmedAt    *time.Time `db:"confirmed_at"`
+	ExtensionCount int        `db:"extension_count"`
+	RefundedAmount int        `db:"refunded_amount"`
+	RefundedAt     *time.Time `db:"refunded_at"`
 	CreatedAt      time.Time  `db:"created_at"`
 	UpdatedAt      time.Time  `db:"updated_at"`
 }
 
+// reservationColumns はSELECT対象のカラム一覧
+const reservationColumns = `id, event_id, user_id, status, idempotency_key, total_amount, expires_at, confirmed_at, extension_count, refunded_amount, refunded_at, created_at, updated_at`
+
 type ReservationRepository struct{ db *sqlx.DB }
 
 func NewReservationRepository(db *sqlx.DB) *ReservationRepository {
@@ -38,16 +45,18 @@ func (r *ReservationRepository) Create(ctx context.Context, tx transaction.Tx, r
 	if sqlxTx == nil {
 		return fmt.Errorf("無効なトランザクション")
 	}
-	query := `INSERT INTO reservations (event_id, user_id, status, idempotency_key, total_amount, expires_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`
-	if err := sqlxTx.QueryRowContext(ctx, query, res.EventID, res.UserID, string(res.Status), res.IdempotencyKey, res.TotalAmount, res.ExpiresAt, res.CreatedAt, res.UpdatedAt).Scan(&res.ID); err != nil {
+	query := `INSERT INTO reservations (event_id, user_id, status, idempotency_key, total_amount, expires_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	ids, err := auditedInsert(ctx, sqlxTx, audit.EntityReservation, query, res.EventID, res.UserID, string(res.Status), res.IdempotencyKey, res.TotalAmount, res.ExpiresAt, res.CreatedAt, res.UpdatedAt)
+	if err != nil {
 		if pgErr, ok := err.(*pq.Error); ok && pgErr.Code == "23505" {
 			return reservation.ErrIdempotencyKeyAlreadyExists
 		}
 		return fmt.Errorf("予約作成に失敗: %w", err)
 	}
+	res.ID = ids[0]
 	if len(res.SeatIDs) > 0 {
 		for _, seatID := range res.SeatIDs {
-			if _, err := sqlxTx.ExecContext(ctx, `INSERT INTO reservation_seats (reservation_id, seat_id) VALUES ($1, $2)`, res.ID, seatID); err != nil {
+			if _, err := sqlxTx.ExecContext(ctx, `INSERT INTO reservation_seats (reservation_id, seat_id, price) VALUES ($1, $2, $3)`, res.ID, seatID, res.SeatPrices[seatID]); err != nil {
 				return fmt.Errorf("予約座席関連付けに失敗: %w", err)
 			}
 		}
@@ -57,99 +66,271 @@ func (r *ReservationRepository) Create(ctx context.Context, tx transaction.Tx, r
 
 func (r *ReservationRepository) GetByID(ctx context.Context, id string) (*reservation.Reservation, error) {
 	var row reservationRow
-	query := `SELECT id, event_id, user_id, status, idempotency_key, total_amount, expires_at, confirmed_at, created_at, updated_at FROM reservations WHERE id = $1`
+	query := `SELECT ` + reservationColumns + ` FROM reservations WHERE id = $1`
 	if err := r.db.GetContext(ctx, &row, query, id); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, reservation.ErrReservationNotFound
 		}
 		return nil, fmt.Errorf("予約取得に失敗: %w", err)
 	}
-	seatIDs, err := r.getSeatIDs(ctx, id)
+	seats, err := r.getSeats(ctx, r.db, id)
+	if err != nil {
+		return nil, err
+	}
+	return r.toEntity(&row, seats), nil
+}
+
+// GetForUpdate はトランザクション内で予約の行をロックして取得する
+// reservation_seats や payments からの外部キー参照（KEY SHARE）を妨げないよう FOR NO KEY UPDATE を使う
+func (r *ReservationRepository) GetForUpdate(ctx context.Context, tx transaction.Tx, id string) (*reservation.Reservation, error) {
+	sqlxTx := UnwrapTx(tx)
+	if sqlxTx == nil {
+		return nil, fmt.Errorf("無効なトランザクション")
+	}
+	var row reservationRow
+	query := `SELECT ` + reservationColumns + ` FROM reservations WHERE id = $1 FOR NO KEY UPDATE`
+	if err := sqlxTx.GetContext(ctx, &row, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, reservation.ErrReservationNotFound
+		}
+		return nil, fmt.Errorf("予約取得に失敗: %w", err)
+	}
+	seats, err := r.getSeats(ctx, sqlxTx, id)
 	if err != nil {
 		return nil, err
 	}
-	return r.toEntity(&row, seatIDs), nil
+	return r.toEntity(&row, seats), nil
 }
 
 func (r *ReservationRepository) GetByIdempotencyKey(ctx context.Context, key string) (*reservation.Reservation, error) {
 	var row reservationRow
-	if err := r.db.GetContext(ctx, &row, `SELECT id, event_id, user_id, status, idempotency_key, total_amount, expires_at, confirmed_at, created_at, updated_at FROM reservations WHERE idempotency_key = $1`, key); err != nil {
+	if err := r.db.GetContext(ctx, &row, `SELECT `+reservationColumns+` FROM reservations WHERE idempotency_key = $1`, key); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, reservation.ErrReservationNotFound
 		}
 		return nil, fmt.Errorf("予約取得に失敗: %w", err)
 	}
-	seatIDs, err := r.getSeatIDs(ctx, row.ID)
+	seats, err := r.getSeats(ctx, r.db, row.ID)
 	if err != nil {
 		return nil, err
 	}
-	return r.toEntity(&row, seatIDs), nil
+	return r.toEntity(&row, seats), nil
 }
 
-func (r *ReservationRepository) GetByUserID(ctx context.Context, userID string, limit, offset int) ([]*reservation.Reservation, error) {
+// GetByUserID はユーザーの予約一覧を作成日時の新しい順に取得する
+// (created_at, id) のキーセットでページングするため、途中で予約が追加されてもページ間で重複しない
+func (r *ReservationRepository) GetByUserID(ctx context.Context, userID string, filter reservation.ListFilter) ([]*reservation.Reservation, error) {
+	var q queryBuilder
+	q.where("user_id = " + q.arg(userID))
+	if filter.Status != "" {
+		q.where("status = " + q.arg(string(filter.Status)))
+	}
+	if filter.EventID != "" {
+		q.where("event_id = " + q.arg(filter.EventID))
+	}
+	q.after(filter.After)
+	query := `SELECT ` + reservationColumns + ` FROM reservations` + q.whereClause() +
+		` ORDER BY created_at DESC, id DESC LIMIT ` + q.arg(filter.Limit)
+
 	var rows []reservationRow
-	if err := r.db.SelectContext(ctx, &rows, `SELECT id, event_id, user_id, status, idempotency_key, total_amount, expires_at, confirmed_at, created_at, updated_at FROM reservations WHERE user_id = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3`, userID, limit, offset); err != nil {
+	if err := r.db.SelectContext(ctx, &rows, query, q.args...); err != nil {
 		return nil, fmt.Errorf("予約一覧取得に失敗: %w", err)
 	}
 	result := make([]*reservation.Reservation, len(rows))
 	for i, row := range rows {
-		seatIDs, err := r.getSeatIDs(ctx, row.ID)
+		seats, err := r.getSeats(ctx, r.db, row.ID)
 		if err != nil {
 			return nil, err
 		}
-		result[i] = r.toEntity(&row, seatIDs)
+		result[i] = r.toEntity(&row, seats)
 	}
 	return result, nil
 }
 
+func (r *ReservationRepository) GetByEventID(ctx context.Context, eventID string, limit, offset int) ([]*reservation.Reservation, error) {
+	var rows []reservationRow
+	if err := r.db.SelectContext(ctx, &rows, `SELECT `+reservationColumns+` FROM reservations WHERE event_id = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3`, eventID, limit, offset); err != nil {
+		return nil, fmt.Errorf("予約一覧取得に失敗: %w", err)
+	}
+	result := make([]*reservation.Reservation, len(rows))
+	for i, row := range rows {
+		seats, err := r.getSeats(ctx, r.db, row.ID)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = r.toEntity(&row, seats)
+	}
+	return result, nil
+}
+
+// CountSeatsHeldByUser はユーザーがイベントで保持している座席数を数える
+// 同じユーザーの同時リクエストが上限を同時に通過しないよう、(event_id, user_id) 単位の
+// トランザクションスコープのアドバイザリロックを取得してから集計する
+func (r *ReservationRepository) CountSeatsHeldByUser(ctx context.Context, tx transaction.Tx, eventID, userID string) (int, error) {
+	sqlxTx := UnwrapTx(tx)
+	if sqlxTx == nil {
+		return 0, fmt.Errorf("無効なトランザクション")
+	}
+	if _, err := sqlxTx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtextextended($1 || ':' || $2, 0))`, eventID, userID); err != nil {
+		return 0, fmt.Errorf("購入枚数のロック取得に失敗: %w", err)
+	}
+	query := `
+		SELECT COUNT(*)
+		FROM reservation_seats rs
+		JOIN reservations r ON r.id = rs.reservation_id
+		WHERE r.event_id = $1 AND r.user_id = $2 AND r.status IN ($3, $4)
+	`
+	var count int
+	if err := sqlxTx.GetContext(ctx, &count, query, eventID, userID,
+		string(reservation.StatusPending), string(reservation.StatusConfirmed)); err != nil {
+		return 0, fmt.Errorf("保持座席数の取得に失敗: %w", err)
+	}
+	return count, nil
+}
+
 func (r *ReservationRepository) Update(ctx context.Context, tx transaction.Tx, res *reservation.Reservation) error {
 	sqlxTx := UnwrapTx(tx)
 	if sqlxTx == nil {
 		return fmt.Errorf("無効なトランザクション")
 	}
-	query := `UPDATE reservations SET status = $1, confirmed_at = $2, updated_at = $3 WHERE id = $4`
-	result, err := sqlxTx.ExecContext(ctx, query, string(res.Status), res.ConfirmedAt, res.UpdatedAt, res.ID)
+	set := `status = $1, total_amount = $2, confirmed_at = $3, expires_at = $4, extension_count = $5, refunded_amount = $6, refunded_at = $7, updated_at = $8`
+	rows, err := auditedUpdate(ctx, sqlxTx, audit.EntityReservation, audit.ActionUpdate, "reservations", set, `id = $9`,
+		string(res.Status), res.TotalAmount, res.ConfirmedAt, res.ExpiresAt, res.ExtensionCount, res.RefundedAmount, res.RefundedAt, res.UpdatedAt, res.ID)
 	if err != nil {
 		return fmt.Errorf("予約更新に失敗: %w", err)
 	}
-	rows, _ := result.RowsAffected()
 	if rows == 0 {
 		return reservation.ErrReservationNotFound
 	}
 	return nil
 }
 
+// UpdateFromStatus は予約が from の状態のままの場合だけ更新する
+// 読み取ってから書き込むまでに期限切れ・キャンセル等で状態が変わった予約を上書きしない
+func (r *ReservationRepository) UpdateFromStatus(ctx context.Context, tx transaction.Tx, res *reservation.Reservation, from reservation.Status) error {
+	sqlxTx := UnwrapTx(tx)
+	if sqlxTx == nil {
+		return fmt.Errorf("無効なトランザクション")
+	}
+	set := `status = $1, total_amount = $2, confirmed_at = $3, expires_at = $4, extension_count = $5, refunded_amount = $6, refunded_at = $7, updated_at = $8`
+	rows, err := auditedUpdate(ctx, sqlxTx, audit.EntityReservation, audit.ActionUpdate, "reservations", set, `id = $9 AND status = $10`,
+		string(res.Status), res.TotalAmount, res.ConfirmedAt, res.ExpiresAt, res.ExtensionCount, res.RefundedAmount, res.RefundedAt, res.UpdatedAt, res.ID, string(from))
+	if err != nil {
+		return fmt.Errorf("予約更新に失敗: %w", err)
+	}
+	if rows == 0 {
+		var exists bool
+		if err := sqlxTx.GetContext(ctx, &exists, `SELECT EXISTS(SELECT 1 FROM reservations WHERE id = $1)`, res.ID); err != nil {
+			return fmt.Errorf("予約更新に失敗: %w", err)
+		}
+		if !exists {
+			return reservation.ErrReservationNotFound
+		}
+		return reservation.NotInStatusError(from)
+	}
+	return nil
+}
+
+// RemoveSeats は予約と座席の関連付けを削除する
+func (r *ReservationRepository) RemoveSeats(ctx context.Context, tx transaction.Tx, reservationID string, seatIDs []string) error {
+	sqlxTx := UnwrapTx(tx)
+	if sqlxTx == nil {
+		return fmt.Errorf("無効なトランザクション")
+	}
+	query := `DELETE FROM reservation_seats WHERE reservation_id = $1 AND seat_id = ANY($2)`
+	result, err := sqlxTx.ExecContext(ctx, query, reservationID, pq.Array(seatIDs))
+	if err != nil {
+		return fmt.Errorf("予約座席の削除に失敗: %w", err)
+	}
+	rows, _ := result.RowsAffected()
+	if int(rows) != len(seatIDs) {
+		return reservation.ErrSeatNotInReservation
+	}
+	return nil
+}
+
+// GetExpiredPending は有効期限（expires_at）から expireAfter 以上経過した保留中予約を取得する
+// 仮押さえは延長されうるため、作成日時ではなく有効期限を基準に判定する
 func (r *ReservationRepository) GetExpiredPending(ctx context.Context, expireAfter time.Duration) ([]*reservation.Reservation, error) {
 	var rows []reservationRow
 	cutoff := time.Now().Add(-expireAfter)
-	if err := r.db.SelectContext(ctx, &rows, `SELECT id, event_id, user_id, status, idempotency_key, total_amount, expires_at, confirmed_at, created_at, updated_at FROM reservations WHERE status = 'pending' AND created_at < $1`, cutoff); err != nil {
+	if err := r.db.SelectContext(ctx, &rows, `SELECT `+reservationColumns+` FROM reservations WHERE status = 'pending' AND expires_at < $1`, cutoff); err != nil {
 		return nil, fmt.Errorf("期限切れ予約取得に失敗: %w", err)
 	}
 	result := make([]*reservation.Reservation, len(rows))
 	for i, row := range rows {
-		seatIDs, err := r.getSeatIDs(ctx, row.ID)
+		seats, err := r.getSeats(ctx, r.db, row.ID)
 		if err != nil {
 			return nil, err
 		}
-		result[i] = r.toEntity(&row, seatIDs)
+		result[i] = r.toEntity(&row, seats)
 	}
 	return result, nil
 }
 
-func (r *ReservationRepository) getSeatIDs(ctx context.Context, reservationID string) ([]string, error) {
-	var seatIDs []string
-	if err := r.db.SelectContext(ctx, &seatIDs, `SELECT seat_id FROM reservation_seats WHERE reservation_id = $1`, reservationID); err != nil {
+// CountActiveByEventID はイベントの保留中・確定済み・返金待ちの予約数を返す
+func (r *ReservationRepository) CountActiveByEventID(ctx context.Context, eventID string) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM reservations WHERE event_id = $1 AND status IN ($2, $3, $4)`
+	if err := r.db.GetContext(ctx, &count, query, eventID,
+		string(reservation.StatusPending), string(reservation.StatusConfirmed), string(reservation.StatusRefundPending)); err != nil {
+		return 0, fmt.Errorf("予約数の取得に失敗: %w", err)
+	}
+	return count, nil
+}
+
+// LockActiveByEventID はイベントの保留中・確定済みの予約を行ロック付きで取得する
+// 取得した予約は確定・キャンセルなどの同時更新からトランザクション終了まで保護される
+func (r *ReservationRepository) LockActiveByEventID(ctx context.Context, tx transaction.Tx, eventID string, limit int) ([]*reservation.Reservation, error) {
+	sqlxTx := UnwrapTx(tx)
+	if sqlxTx == nil {
+		return nil, fmt.Errorf("無効なトランザクション")
+	}
+	query := `SELECT ` + reservationColumns + ` FROM reservations WHERE event_id = $1 AND status IN ($2, $3)
+		ORDER BY created_at, id LIMIT $4 FOR UPDATE`
+	var rows []reservationRow
+	if err := sqlxTx.SelectContext(ctx, &rows, query, eventID,
+		string(reservation.StatusPending), string(reservation.StatusConfirmed), limit); err != nil {
+		return nil, fmt.Errorf("予約一覧取得に失敗: %w", err)
+	}
+	result := make([]*reservation.Reservation, len(rows))
+	for i, row := range rows {
+		seats, err := r.getSeats(ctx, sqlxTx, row.ID)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = r.toEntity(&row, seats)
+	}
+	return result, nil
+}
+
+// reservationSeatRow は予約に含まれる座席と購入時の価格
+type reservationSeatRow struct {
+	SeatID string `db:"seat_id"`
+	Price  int    `db:"price"`
+}
+
+func (r *ReservationRepository) getSeats(ctx context.Context, q sqlx.QueryerContext, reservationID string) ([]reservationSeatRow, error) {
+	var seats []reservationSeatRow
+	if err := sqlx.SelectContext(ctx, q, &seats, `SELECT seat_id, price FROM reservation_seats WHERE reservation_id = $1`, reservationID); err != nil {
 		return nil, fmt.Errorf("座席ID取得に失敗: %w", err)
 	}
-	return seatIDs, nil
+	return seats, nil
 }
 
-func (r *ReservationRepository) toEntity(row *reservationRow, seatIDs []string) *reservation.Reservation {
+func (r *ReservationRepository) toEntity(row *reservationRow, seats []reservationSeatRow) *reservation.Reservation {
+	seatIDs := make([]string, len(seats))
+	seatPrices := make(map[string]int, len(seats))
+	for i, se := range seats {
+		seatIDs[i] = se.SeatID
+		seatPrices[se.SeatID] = se.Price
+	}
 	return &reservation.Reservation{
 		ID: row.ID, EventID: row.EventID, UserID: row.UserID,
-		SeatIDs: seatIDs, Status: reservation.Status(row.Status),
+		SeatIDs: seatIDs, SeatPrices: seatPrices, Status: reservation.Status(row.Status),
 		IdempotencyKey: row.IdempotencyKey, TotalAmount: row.TotalAmount,
-		ExpiresAt: row.ExpiresAt, ConfirmedAt: row.ConfirmedAt,
+		ExpiresAt: row.ExpiresAt, ConfirmedAt: row.ConfirmedAt, ExtensionCount: row.ExtensionCount,
+		RefundedAmount: row.RefundedAmount, RefundedAt: row.RefundedAt,
 		CreatedAt: row.CreatedAt, UpdatedAt: row.UpdatedAt,
 	}
 }
@@ -11,27 +11,47 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/audit"
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/event"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/seat"
 	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/transaction"
 )
 
 type seatRow struct {
-	ID         string     `db:"id"`
-	EventID    string     `db:"event_id"`
-	SeatNumber string     `db:"seat_number"`
-	Status     string     `db:"status"`
-	Price      int        `db:"price"`
-	ReservedBy *string    `db:"reserved_by"`
-	ReservedAt *time.Time `db:"reserved_at"`
-	CreatedAt  time.Time  `db:"created_at"`
-	UpdatedAt  time.Time  `db:"updated_at"`
-	Version    int        `db:"version"`
+	ID              string     `db:"id"`
+	EventID         string     `db:"event_id"`
+	SeatNumber      string     `db:"seat_number"`
+	Status          string     `db:"status"`
+	Price           int        `db:"price"`
+	PriceCategoryID *string    `db:"price_category_id"`
+	Section         string     `db:"section"`
+	RowLabel        string     `db:"row_label"`
+	SeatIndex       int        `db:"seat_index"`
+	PosX            float64    `db:"pos_x"`
+	PosY            float64    `db:"pos_y"`
+	Accessible      bool       `db:"accessible"`
+	ObstructedView  bool       `db:"obstructed_view"`
+	ReservedBy      *string    `db:"reserved_by"`
+	ReservedAt      *time.Time `db:"reserved_at"`
+	CreatedAt       time.Time  `db:"created_at"`
+	UpdatedAt       time.Time  `db:"updated_at"`
+	Version         int        `db:"version"`
 }
 
+// seatColumns はSELECT対象のカラム一覧
+const seatColumns = `id, event_id, seat_number, status, price, price_category_id,
+	section, row_label, seat_index, pos_x, pos_y, accessible, obstructed_view,
+	reserved_by, reserved_at, created_at, updated_at, version`
+
+// seatOrder は座席一覧の並び順（レイアウト順、レイアウト未使用時は座席番号順）
+const seatOrder = `ORDER BY section, row_label, seat_index, seat_number`
+
 func (r *seatRow) toEntity() *seat.Seat {
 	return &seat.Seat{
 		ID: r.ID, EventID: r.EventID, SeatNumber: r.SeatNumber,
-		Status: seat.Status(r.Status), Price: r.Price,
+		Status: seat.Status(r.Status), Price: r.Price, PriceCategoryID: r.PriceCategoryID,
+		Section: r.Section, Row: r.RowLabel, SeatIndex: r.SeatIndex, X: r.PosX, Y: r.PosY,
+		Accessible: r.Accessible, ObstructedView: r.ObstructedView,
 		ReservedBy: r.ReservedBy, ReservedAt: r.ReservedAt,
 		CreatedAt: r.CreatedAt, UpdatedAt: r.UpdatedAt, Version: r.Version,
 	}
@@ -41,16 +61,33 @@ type SeatRepository struct{ db *sqlx.DB }
 
 func NewSeatRepository(db *sqlx.DB) *SeatRepository { return &SeatRepository{db: db} }
 
+// Create は座席を作成し、監査ログに記録する
+// 作成済みの座席数がイベントの総座席数を超える場合は seat.ErrCapacityExceeded を返す
 func (r *SeatRepository) Create(ctx context.Context, s *seat.Seat) error {
-	query := `INSERT INTO seats (event_id, seat_number, status, price, created_at, updated_at, version) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`
-	return r.db.QueryRowContext(ctx, query, s.EventID, s.SeatNumber, string(s.Status), s.Price, s.CreatedAt, s.UpdatedAt, s.Version).Scan(&s.ID)
+	return r.CreateBulk(ctx, []*seat.Seat{s})
 }
 
+// CreateBulk は同じイベントの座席を1つのトランザクションで一括作成し、監査ログに記録する
+// 並行した作成で総座席数を超えないよう、イベントの行をロックしてから作成済みの座席数を数える
 func (r *SeatRepository) CreateBulk(ctx context.Context, seats []*seat.Seat) error {
 	if len(seats) == 0 {
 		return nil
 	}
 
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("トランザクション開始に失敗: %w", err)
+	}
+	defer tx.Rollback()
+
+	totalSeats, created, err := lockEventSeatCapacity(ctx, tx, seats[0].EventID)
+	if err != nil {
+		return err
+	}
+	if err := seat.CheckCapacity(totalSeats, created, len(seats)); err != nil {
+		return err
+	}
+
 	// バッチサイズごとに分割してマルチバリューINSERTを実行
 	const batchSize = 1000
 	for i := 0; i < len(seats); i += batchSize {
@@ -60,56 +97,81 @@ func (r *SeatRepository) CreateBulk(ctx context.Context, seats []*seat.Seat) err
 		}
 		batch := seats[i:end]
 
-		if err := r.createBulkBatch(ctx, batch); err != nil {
+		if err := createBulkBatch(ctx, tx, batch); err != nil {
 			return err
 		}
 	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("座席作成のコミットに失敗: %w", err)
+	}
 	return nil
 }
 
+// lockEventSeatCapacity はイベントの行をロックし、総座席数と作成済みの座席数を返す
+// 座席の作成と総座席数の変更はこのロックで直列化する（FOR NO KEY UPDATE のため、予約などの外部キーが取る KEY SHARE ロックとは競合しない）
+func lockEventSeatCapacity(ctx context.Context, tx *sqlx.Tx, eventID string) (totalSeats, created int, err error) {
+	if err := tx.GetContext(ctx, &totalSeats,
+		`SELECT total_seats FROM events WHERE id = $1 AND deleted_at IS NULL FOR NO KEY UPDATE`, eventID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, 0, event.ErrEventNotFound
+		}
+		return 0, 0, fmt.Errorf("イベントのロックに失敗: %w", err)
+	}
+	if err := tx.GetContext(ctx, &created,
+		`SELECT COUNT(*) FROM seats WHERE event_id = $1 AND deleted_at IS NULL`, eventID); err != nil {
+		return 0, 0, fmt.Errorf("座席数の取得に失敗: %w", err)
+	}
+	return totalSeats, created, nil
+}
+
 // createBulkBatch はバッチ単位でマルチバリューINSERTを実行
-func (r *SeatRepository) createBulkBatch(ctx context.Context, seats []*seat.Seat) error {
+func createBulkBatch(ctx context.Context, q sqlx.QueryerContext, seats []*seat.Seat) error {
 	if len(seats) == 0 {
 		return nil
 	}
 
 	// マルチバリューINSERTを構築
-	query := `INSERT INTO seats (event_id, seat_number, status, price, created_at, updated_at, version) VALUES `
-	args := make([]interface{}, 0, len(seats)*7)
+	query := `INSERT INTO seats (event_id, seat_number, status, price, price_category_id, section, row_label, seat_index, pos_x, pos_y, accessible, obstructed_view, created_at, updated_at, version) VALUES `
+	args := make([]interface{}, 0, len(seats)*seatInsertColumns)
 	placeholders := make([]string, 0, len(seats))
 
 	for i, s := range seats {
-		base := i * 7
-		placeholders = append(placeholders, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d)",
-			base+1, base+2, base+3, base+4, base+5, base+6, base+7))
-		args = append(args, s.EventID, s.SeatNumber, string(s.Status), s.Price, s.CreatedAt, s.UpdatedAt, s.Version)
+		base := i * seatInsertColumns
+		ph := make([]string, seatInsertColumns)
+		for j := range ph {
+			ph[j] = fmt.Sprintf("$%d", base+j+1)
+		}
+		placeholders = append(placeholders, "("+strings.Join(ph, ", ")+")")
+		args = append(args, seatInsertArgs(s)...)
 	}
+	query += strings.Join(placeholders, ", ")
 
-	query += strings.Join(placeholders, ", ") + " RETURNING id"
-
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	ids, err := auditedInsert(ctx, q, audit.EntitySeat, query, args...)
 	if err != nil {
 		return fmt.Errorf("座席一括作成に失敗: %w", err)
 	}
-	defer rows.Close()
 
 	// 生成されたIDを座席オブジェクトに設定
-	i := 0
-	for rows.Next() {
-		if i >= len(seats) {
-			break
-		}
-		if err := rows.Scan(&seats[i].ID); err != nil {
-			return fmt.Errorf("座席ID取得に失敗: %w", err)
-		}
-		i++
+	for i := 0; i < len(ids) && i < len(seats); i++ {
+		seats[i].ID = ids[i]
 	}
+	return nil
+}
+
+// seatInsertColumns はINSERT時の1座席あたりのカラム数
+const seatInsertColumns = 15
 
-	return rows.Err()
+// seatInsertArgs はINSERT用の引数を組み立てる
+func seatInsertArgs(s *seat.Seat) []interface{} {
+	return []interface{}{
+		s.EventID, s.SeatNumber, string(s.Status), s.Price, s.PriceCategoryID,
+		s.Section, s.Row, s.SeatIndex, s.X, s.Y, s.Accessible, s.ObstructedView,
+		s.CreatedAt, s.UpdatedAt, s.Version,
+	}
 }
 
 func (r *SeatRepository) GetByID(ctx context.Context, id string) (*seat.Seat, error) {
-	query := `SELECT id, event_id, seat_number, status, price, reserved_by, reserved_at, created_at, updated_at, version FROM seats WHERE id = $1`
+	query := `SELECT ` + seatColumns + ` FROM seats WHERE id = $1 AND deleted_at IS NULL`
 	var row seatRow
 	if err := r.db.GetContext(ctx, &row, query, id); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -121,7 +183,7 @@ func (r *SeatRepository) GetByID(ctx context.Context, id string) (*seat.Seat, er
 }
 
 func (r *SeatRepository) GetByEventID(ctx context.Context, eventID string) ([]*seat.Seat, error) {
-	query := `SELECT id, event_id, seat_number, status, price, reserved_by, reserved_at, created_at, updated_at, version FROM seats WHERE event_id = $1 ORDER BY seat_number`
+	query := `SELECT ` + seatColumns + ` FROM seats WHERE event_id = $1 AND deleted_at IS NULL ` + seatOrder
 	var rows []seatRow
 	if err := r.db.SelectContext(ctx, &rows, query, eventID); err != nil {
 		return nil, err
@@ -134,7 +196,7 @@ func (r *SeatRepository) GetByEventID(ctx context.Context, eventID string) ([]*s
 }
 
 func (r *SeatRepository) GetAvailableByEventID(ctx context.Context, eventID string) ([]*seat.Seat, error) {
-	query := `SELECT id, event_id, seat_number, status, price, reserved_by, reserved_at, created_at, updated_at, version FROM seats WHERE event_id = $1 AND status = 'available' ORDER BY seat_number`
+	query := `SELECT ` + seatColumns + ` FROM seats WHERE event_id = $1 AND status = 'available' AND deleted_at IS NULL ` + seatOrder
 	var rows []seatRow
 	if err := r.db.SelectContext(ctx, &rows, query, eventID); err != nil {
 		return nil, err
@@ -154,13 +216,14 @@ func (r *SeatRepository) ReserveSeats(ctx context.Context, tx transaction.Tx, se
 	if sqlxTx == nil {
 		return fmt.Errorf("無効なトランザクション")
 	}
-	query := `UPDATE seats SET status = 'reserved', reserved_by = $1, reserved_at = NOW(), updated_at = NOW(), version = version + 1 WHERE id = ANY($2) AND status = 'available'`
-	result, err := sqlxTx.ExecContext(ctx, query, reservationID, pq.Array(seatIDs))
+	rows, err := auditedUpdate(ctx, sqlxTx, audit.EntitySeat, audit.ActionUpdate, "seats",
+		`status = 'reserved', reserved_by = $1, reserved_at = NOW(), updated_at = NOW(), version = version + 1`,
+		`id = ANY($2) AND status = 'available' AND deleted_at IS NULL`,
+		reservationID, pq.Array(seatIDs))
 	if err != nil {
 		return fmt.Errorf("座席予約に失敗: %w", err)
 	}
-	rows, _ := result.RowsAffected()
-	if int(rows) != len(seatIDs) {
+	if rows != len(seatIDs) {
 		return seat.ErrSeatAlreadyReserved
 	}
 	return nil
@@ -174,19 +237,21 @@ func (r *SeatRepository) ConfirmSeats(ctx context.Context, tx transaction.Tx, se
 	if sqlxTx == nil {
 		return fmt.Errorf("無効なトランザクション")
 	}
-	query := `UPDATE seats SET status = 'confirmed', updated_at = NOW(), version = version + 1 WHERE id = ANY($1) AND status = 'reserved'`
-	result, err := sqlxTx.ExecContext(ctx, query, pq.Array(seatIDs))
+	rows, err := auditedUpdate(ctx, sqlxTx, audit.EntitySeat, audit.ActionUpdate, "seats",
+		`status = 'confirmed', updated_at = NOW(), version = version + 1`,
+		`id = ANY($1) AND status = 'reserved' AND deleted_at IS NULL`,
+		pq.Array(seatIDs))
 	if err != nil {
 		return fmt.Errorf("座席確定に失敗: %w", err)
 	}
-	rows, _ := result.RowsAffected()
-	if int(rows) != len(seatIDs) {
+	if rows != len(seatIDs) {
 		return seat.ErrSeatNotReserved
 	}
 	return nil
 }
 
-func (r *SeatRepository) ReleaseSeats(ctx context.Context, tx transaction.Tx, seatIDs []string) error {
+// ReleaseSeats は reserved_by が予約のままの座席だけを解放する
+func (r *SeatRepository) ReleaseSeats(ctx context.Context, tx transaction.Tx, reservationID string, seatIDs []string) error {
 	if len(seatIDs) == 0 {
 		return nil
 	}
@@ -194,15 +259,83 @@ func (r *SeatRepository) ReleaseSeats(ctx context.Context, tx transaction.Tx, se
 	if sqlxTx == nil {
 		return fmt.Errorf("無効なトランザクション")
 	}
-	query := `UPDATE seats SET status = 'available', reserved_by = NULL, reserved_at = NULL, updated_at = NOW(), version = version + 1 WHERE id = ANY($1)`
-	_, err := sqlxTx.ExecContext(ctx, query, pq.Array(seatIDs))
+	_, err := auditedUpdate(ctx, sqlxTx, audit.EntitySeat, audit.ActionUpdate, "seats",
+		seatReleaseSet, `id = ANY($1) AND reserved_by = $2`, pq.Array(seatIDs), reservationID)
 	return err
 }
 
+// ReleaseByEventID はイベントの空席でない座席を最大 limit 席解放する
+func (r *SeatRepository) ReleaseByEventID(ctx context.Context, tx transaction.Tx, eventID string, limit int) (int, error) {
+	sqlxTx := UnwrapTx(tx)
+	if sqlxTx == nil {
+		return 0, fmt.Errorf("無効なトランザクション")
+	}
+	rows, err := auditedUpdate(ctx, sqlxTx, audit.EntitySeat, audit.ActionUpdate, "seats",
+		seatReleaseSet, `id IN (SELECT id FROM seats WHERE event_id = $1 AND status <> 'available' LIMIT $2)`,
+		eventID, limit)
+	if err != nil {
+		return 0, fmt.Errorf("座席の解放に失敗: %w", err)
+	}
+	return rows, nil
+}
+
+// seatReleaseSet は座席を空席に戻す SET 句
+const seatReleaseSet = `status = 'available', reserved_by = NULL, reserved_at = NULL, updated_at = NOW(), version = version + 1`
+
 func (r *SeatRepository) CountAvailableByEventID(ctx context.Context, eventID string) (int, error) {
 	var count int
-	err := r.db.GetContext(ctx, &count, `SELECT COUNT(*) FROM seats WHERE event_id = $1 AND status = 'available'`, eventID)
+	err := r.db.GetContext(ctx, &count, `SELECT COUNT(*) FROM seats WHERE event_id = $1 AND status = 'available' AND deleted_at IS NULL`, eventID)
+	return count, err
+}
+
+func (r *SeatRepository) CountByEventID(ctx context.Context, eventID string) (int, error) {
+	var count int
+	err := r.db.GetContext(ctx, &count, `SELECT COUNT(*) FROM seats WHERE event_id = $1 AND deleted_at IS NULL`, eventID)
 	return count, err
 }
 
+func (r *SeatRepository) GetInventoryByEventID(ctx context.Context, eventID string) (*seat.Inventory, error) {
+	var row struct {
+		Total     int `db:"total"`
+		Available int `db:"available"`
+		Reserved  int `db:"reserved"`
+		Confirmed int `db:"confirmed"`
+	}
+	err := r.db.GetContext(ctx, &row, `
+		SELECT COUNT(*) AS total,
+		       COUNT(*) FILTER (WHERE status = 'available') AS available,
+		       COUNT(*) FILTER (WHERE status = 'reserved') AS reserved,
+		       COUNT(*) FILTER (WHERE status = 'confirmed') AS confirmed
+		FROM seats WHERE event_id = $1 AND deleted_at IS NULL`, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("座席数の取得に失敗しました: %w", err)
+	}
+	return &seat.Inventory{Total: row.Total, Available: row.Available, Reserved: row.Reserved, Confirmed: row.Confirmed}, nil
+}
+
+func (r *SeatRepository) ListDrift(ctx context.Context) ([]*seat.Drift, error) {
+	var rows []struct {
+		EventID    string `db:"event_id"`
+		EventName  string `db:"event_name"`
+		TotalSeats int    `db:"total_seats"`
+		SeatCount  int    `db:"seat_count"`
+	}
+	err := r.db.SelectContext(ctx, &rows, `
+		SELECT e.id AS event_id, e.name AS event_name, e.total_seats, COUNT(s.id) AS seat_count
+		FROM events e
+		LEFT JOIN seats s ON s.event_id = e.id AND s.deleted_at IS NULL
+		WHERE e.deleted_at IS NULL
+		GROUP BY e.id
+		HAVING COUNT(s.id) <> e.total_seats
+		ORDER BY e.created_at, e.id`)
+	if err != nil {
+		return nil, fmt.Errorf("座席数のずれの取得に失敗しました: %w", err)
+	}
+	drifts := make([]*seat.Drift, len(rows))
+	for i, row := range rows {
+		drifts[i] = &seat.Drift{EventID: row.EventID, EventName: row.EventName, TotalSeats: row.TotalSeats, SeatCount: row.SeatCount}
+	}
+	return drifts, nil
+}
+
 var _ seat.Repository = (*SeatRepository)(nil)
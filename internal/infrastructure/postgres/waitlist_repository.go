@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/waitlist"
+)
+
+type waitlistRow struct {
+	ID            string     `db:"id"`
+	EventID       string     `db:"event_id"`
+	UserID        string     `db:"user_id"`
+	Quantity      int        `db:"quantity"`
+	Status        string     `db:"status"`
+	ReservationID *string    `db:"reservation_id"`
+	OfferedAt     *time.Time `db:"offered_at"`
+	SkipReason    *string    `db:"skip_reason"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+}
+
+// waitlistColumns はSELECT対象のカラム一覧
+const waitlistColumns = `id, event_id, user_id, quantity, status, reservation_id, offered_at, skip_reason, created_at, updated_at`
+
+func (r *waitlistRow) toEntity() *waitlist.Entry {
+	return &waitlist.Entry{
+		ID: r.ID, EventID: r.EventID, UserID: r.UserID,
+		Quantity: r.Quantity, Status: waitlist.Status(r.Status),
+		ReservationID: r.ReservationID, OfferedAt: r.OfferedAt, SkipReason: r.SkipReason,
+		CreatedAt: r.CreatedAt, UpdatedAt: r.UpdatedAt,
+	}
+}
+
+// WaitlistRepository は順番待ちリポジトリのPostgreSQL実装
+type WaitlistRepository struct{ db *sqlx.DB }
+
+// NewWaitlistRepository はWaitlistRepositoryを作成する
+func NewWaitlistRepository(db *sqlx.DB) *WaitlistRepository {
+	return &WaitlistRepository{db: db}
+}
+
+func (r *WaitlistRepository) Create(ctx context.Context, e *waitlist.Entry) error {
+	query := `INSERT INTO waitlist_entries (event_id, user_id, quantity, status, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`
+	if err := r.db.QueryRowContext(ctx, query, e.EventID, e.UserID, e.Quantity, string(e.Status), e.CreatedAt, e.UpdatedAt).Scan(&e.ID); err != nil {
+		if pgErr, ok := err.(*pq.Error); ok && pgErr.Code == "23505" {
+			return waitlist.ErrAlreadyWaiting
+		}
+		return fmt.Errorf("順番待ち登録に失敗: %w", err)
+	}
+	return nil
+}
+
+func (r *WaitlistRepository) GetByID(ctx context.Context, id string) (*waitlist.Entry, error) {
+	return r.getOne(ctx, `SELECT `+waitlistColumns+` FROM waitlist_entries WHERE id = $1`, id)
+}
+
+func (r *WaitlistRepository) GetByReservationID(ctx context.Context, reservationID string) (*waitlist.Entry, error) {
+	return r.getOne(ctx, `SELECT `+waitlistColumns+` FROM waitlist_entries WHERE reservation_id = $1`, reservationID)
+}
+
+func (r *WaitlistRepository) GetNextWaiting(ctx context.Context, eventID string) (*waitlist.Entry, error) {
+	return r.getOne(ctx, `SELECT `+waitlistColumns+` FROM waitlist_entries WHERE event_id = $1 AND status = 'waiting' ORDER BY created_at, id LIMIT 1`, eventID)
+}
+
+func (r *WaitlistRepository) CountWaitingBefore(ctx context.Context, e *waitlist.Entry) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM waitlist_entries WHERE event_id = $1 AND status = 'waiting' AND (created_at, id) < ($2, $3)`
+	if err := r.db.GetContext(ctx, &count, query, e.EventID, e.CreatedAt, e.ID); err != nil {
+		return 0, fmt.Errorf("順番待ち順位の取得に失敗: %w", err)
+	}
+	return count, nil
+}
+
+func (r *WaitlistRepository) Update(ctx context.Context, e *waitlist.Entry) error {
+	query := `UPDATE waitlist_entries SET status = $1, reservation_id = $2, offered_at = $3, skip_reason = $4, updated_at = $5 WHERE id = $6`
+	result, err := r.db.ExecContext(ctx, query, string(e.Status), e.ReservationID, e.OfferedAt, e.SkipReason, e.UpdatedAt, e.ID)
+	if err != nil {
+		return fmt.Errorf("順番待ち更新に失敗: %w", err)
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return waitlist.ErrEntryNotFound
+	}
+	return nil
+}
+
+// UpdateFromStatus はエントリが from の状態のままの場合だけ更新する
+// 読み取ってから書き込むまでにオファー等で状態が変わったエントリを上書きしない
+func (r *WaitlistRepository) UpdateFromStatus(ctx context.Context, e *waitlist.Entry, from waitlist.Status) error {
+	query := `UPDATE waitlist_entries SET status = $1, reservation_id = $2, offered_at = $3, skip_reason = $4, updated_at = $5 WHERE id = $6 AND status = $7`
+	result, err := r.db.ExecContext(ctx, query, string(e.Status), e.ReservationID, e.OfferedAt, e.SkipReason, e.UpdatedAt, e.ID, string(from))
+	if err != nil {
+		return fmt.Errorf("順番待ち更新に失敗: %w", err)
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		var exists bool
+		if err := r.db.GetContext(ctx, &exists, `SELECT EXISTS(SELECT 1 FROM waitlist_entries WHERE id = $1)`, e.ID); err != nil {
+			return fmt.Errorf("順番待ち更新に失敗: %w", err)
+		}
+		if !exists {
+			return waitlist.ErrEntryNotFound
+		}
+		switch from {
+		case waitlist.StatusWaiting:
+			return waitlist.ErrEntryNotWaiting
+		case waitlist.StatusOffered:
+			return waitlist.ErrEntryNotOffered
+		default:
+			return waitlist.ErrEntryClosed
+		}
+	}
+	return nil
+}
+
+func (r *WaitlistRepository) getOne(ctx context.Context, query string, arg string) (*waitlist.Entry, error) {
+	var row waitlistRow
+	if err := r.db.GetContext(ctx, &row, query, arg); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, waitlist.ErrEntryNotFound
+		}
+		return nil, fmt.Errorf("順番待ち取得に失敗: %w", err)
+	}
+	return row.toEntity(), nil
+}
+
+var _ waitlist.Repository = (*WaitlistRepository)(nil)
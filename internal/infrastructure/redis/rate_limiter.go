@@ -0,0 +1,79 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// RateLimitResult はレート制限の判定結果
+type RateLimitResult struct {
+	Allowed    bool
+	Remaining  int           // ウィンドウ内で残っているリクエスト数
+	RetryAfter time.Duration // 拒否された場合、次のリクエストが受け付けられるまでの時間
+}
+
+// RateLimiterInterface はキーごとのレート制限のインターフェース
+type RateLimiterInterface interface {
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (*RateLimitResult, error)
+}
+
+// RateLimiter は Redis を使用したスライディングウィンドウ方式のレート制限
+// 受け付けたリクエストの時刻をスコアに持つ Sorted Set をキーごとに保持し、
+// 複数インスタンスから同じキーに対してアトミックに判定する
+type RateLimiter struct {
+	client *redis.Client
+}
+
+// NewRateLimiter は新しいRateLimiterインスタンスを作成する
+func NewRateLimiter(client *redis.Client) *RateLimiter {
+	return &RateLimiter{client: client}
+}
+
+// slidingWindowScript はウィンドウ外の記録を削除してから件数を数え、上限未満であれば記録する
+// インスタンス間の時計のずれの影響を受けないよう、時刻は Redis の TIME を使う
+// KEYS[1]: キー, ARGV[1]: 上限, ARGV[2]: ウィンドウ（ミリ秒）, ARGV[3]: 記録するメンバー
+// 戻り値: {許可=1/拒否=0, 残り回数, 再試行までのミリ秒}
+var slidingWindowScript = redis.NewScript(`
+local t = redis.call("TIME")
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now - window)
+local count = redis.call("ZCARD", KEYS[1])
+if count < limit then
+	redis.call("ZADD", KEYS[1], now, ARGV[3])
+	redis.call("PEXPIRE", KEYS[1], window)
+	return {1, limit - count - 1, 0}
+end
+local oldest = redis.call("ZRANGE", KEYS[1], 0, 0, "WITHSCORES")
+local retry = window
+if oldest[2] then
+	retry = tonumber(oldest[2]) + window - now
+end
+return {0, 0, retry}
+`)
+
+// Allow は window あたり limit 回までリクエストを許可する
+func (l *RateLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (*RateLimitResult, error) {
+	res, err := slidingWindowScript.Run(ctx, l.client, []string{l.key(key)},
+		limit, window.Milliseconds(), uuid.New().String()).Int64Slice()
+	if err != nil {
+		return nil, fmt.Errorf("レート制限の判定に失敗: %w", err)
+	}
+	if len(res) != 3 {
+		return nil, fmt.Errorf("レート制限の判定結果が不正です: %v", res)
+	}
+	return &RateLimitResult{
+		Allowed:    res[0] == 1,
+		Remaining:  int(res[1]),
+		RetryAfter: time.Duration(res[2]) * time.Millisecond,
+	}, nil
+}
+
+func (l *RateLimiter) key(key string) string {
+	return fmt.Sprintf("ratelimit:%s", key)
+}
@@ -0,0 +1,51 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	client := setupTestRedis(t)
+	limiter := NewRateLimiter(client)
+	ctx := context.Background()
+	key := "test:user:" + uuid.New().String()
+	t.Cleanup(func() { client.Del(ctx, limiter.key(key)) })
+
+	t.Run("上限まではリクエストを許可する", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			res, err := limiter.Allow(ctx, key, 3, time.Second)
+			require.NoError(t, err)
+			assert.True(t, res.Allowed)
+			assert.Equal(t, 2-i, res.Remaining)
+		}
+	})
+
+	t.Run("上限を超えると拒否し再試行までの時間を返す", func(t *testing.T) {
+		res, err := limiter.Allow(ctx, key, 3, time.Second)
+		require.NoError(t, err)
+		assert.False(t, res.Allowed)
+		assert.Greater(t, res.RetryAfter, time.Duration(0))
+		assert.LessOrEqual(t, res.RetryAfter, time.Second)
+	})
+
+	t.Run("ウィンドウが過ぎると再び許可する", func(t *testing.T) {
+		time.Sleep(1100 * time.Millisecond)
+		res, err := limiter.Allow(ctx, key, 3, time.Second)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+	})
+
+	t.Run("キーごとに独立して数える", func(t *testing.T) {
+		other := "test:user:" + uuid.New().String()
+		t.Cleanup(func() { client.Del(ctx, limiter.key(other)) })
+		res, err := limiter.Allow(ctx, other, 1, time.Minute)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+	})
+}
@@ -0,0 +1,179 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/queue"
+)
+
+// queueTicketTTL は待合室チケットの保持期間
+const queueTicketTTL = 6 * time.Hour
+
+// WaitingRoomInterface はイベントごとの待合室（仮想キュー）のインターフェース
+type WaitingRoomInterface interface {
+	Enqueue(ctx context.Context, eventID, userID string) (*queue.Ticket, error)
+	GetTicket(ctx context.Context, eventID, token string) (*queue.Ticket, error)
+	Admit(ctx context.Context, eventID string, count int, ttl time.Duration) (int, error)
+	IsAdmitted(ctx context.Context, eventID, token, userID string) (bool, error)
+	ActiveEventIDs(ctx context.Context) ([]string, error)
+}
+
+// WaitingRoom は Redis を使用した待合室
+// 入場待ちは登録順のスコアを持つ Sorted Set、入場許可は有効期限をスコアに持つ Sorted Set で管理する
+type WaitingRoom struct {
+	client *redis.Client
+}
+
+// NewWaitingRoom は新しいWaitingRoomインスタンスを作成する
+func NewWaitingRoom(client *redis.Client) *WaitingRoom {
+	return &WaitingRoom{client: client}
+}
+
+// Enqueue は待合室の最後尾にユーザーを並ばせる
+// 同じユーザーが有効なチケットを持っている場合はそのチケットを返す（並び直しによる順位の入れ替えを防ぐ）
+func (w *WaitingRoom) Enqueue(ctx context.Context, eventID, userID string) (*queue.Ticket, error) {
+	if token, err := w.client.Get(ctx, w.userKey(eventID, userID)).Result(); err == nil {
+		t, err := w.GetTicket(ctx, eventID, token)
+		if err == nil && t.Status != queue.StatusExpired {
+			return t, nil
+		}
+	}
+
+	seq, err := w.client.Incr(ctx, w.seqKey(eventID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("待合室の採番に失敗: %w", err)
+	}
+	token := uuid.New().String()
+	_, err = w.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, w.ticketKey(token), "event_id", eventID, "user_id", userID)
+		pipe.Expire(ctx, w.ticketKey(token), queueTicketTTL)
+		pipe.ZAdd(ctx, w.waitingKey(eventID), redis.Z{Score: float64(seq), Member: token})
+		pipe.Set(ctx, w.userKey(eventID, userID), token, queueTicketTTL)
+		pipe.SAdd(ctx, w.eventsKey(), eventID)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("待合室への登録に失敗: %w", err)
+	}
+	return w.GetTicket(ctx, eventID, token)
+}
+
+// GetTicket はチケットの状態と入場待ちの順位を取得する
+func (w *WaitingRoom) GetTicket(ctx context.Context, eventID, token string) (*queue.Ticket, error) {
+	fields, err := w.client.HGetAll(ctx, w.ticketKey(token)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("チケット取得に失敗: %w", err)
+	}
+	if fields["event_id"] != eventID {
+		return nil, queue.ErrTicketNotFound
+	}
+	t := &queue.Ticket{Token: token, EventID: eventID, UserID: fields["user_id"]}
+
+	score, err := w.client.ZScore(ctx, w.admittedKey(eventID), token).Result()
+	if err == nil {
+		until := time.UnixMilli(int64(score))
+		t.AdmittedUntil = &until
+		t.Status = queue.StatusAdmitted
+		if !t.IsAdmitted() {
+			t.Status = queue.StatusExpired
+		}
+		return t, nil
+	}
+	if !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("入場許可の確認に失敗: %w", err)
+	}
+
+	rank, err := w.client.ZRank(ctx, w.waitingKey(eventID), token).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			// 入場許可の期限切れ後に掃除されたチケット
+			t.Status = queue.StatusExpired
+			return t, nil
+		}
+		return nil, fmt.Errorf("順位の取得に失敗: %w", err)
+	}
+	t.Status = queue.StatusWaiting
+	t.Position = int(rank) + 1
+	return t, nil
+}
+
+// admitScript は先頭から count 件を入場許可に移し、期限切れの入場許可を削除する
+// 待ち・入場許可がともに空になったイベントは巡回対象から外す
+const admitScript = `
+	local now = tonumber(ARGV[1])
+	local expires = tonumber(ARGV[2])
+	local count = tonumber(ARGV[3])
+	redis.call("ZREMRANGEBYSCORE", KEYS[2], "-inf", now)
+	local popped = redis.call("ZPOPMIN", KEYS[1], count)
+	for i = 1, #popped, 2 do
+		redis.call("ZADD", KEYS[2], expires, popped[i])
+	end
+	if redis.call("ZCARD", KEYS[1]) == 0 and redis.call("ZCARD", KEYS[2]) == 0 then
+		redis.call("SREM", KEYS[3], ARGV[4])
+	end
+	return #popped / 2
+`
+
+// Admit は入場待ちの先頭から count 人に ttl の間有効な入場許可を与える
+func (w *WaitingRoom) Admit(ctx context.Context, eventID string, count int, ttl time.Duration) (int, error) {
+	now := time.Now()
+	keys := []string{w.waitingKey(eventID), w.admittedKey(eventID), w.eventsKey()}
+	admitted, err := w.client.Eval(ctx, admitScript, keys,
+		now.UnixMilli(), now.Add(ttl).UnixMilli(), count, eventID,
+	).Int()
+	if err != nil {
+		return 0, fmt.Errorf("入場許可に失敗: %w", err)
+	}
+	return admitted, nil
+}
+
+// IsAdmitted はユーザーのチケットに有効な入場許可があるかを返す
+func (w *WaitingRoom) IsAdmitted(ctx context.Context, eventID, token, userID string) (bool, error) {
+	t, err := w.GetTicket(ctx, eventID, token)
+	if err != nil {
+		if errors.Is(err, queue.ErrTicketNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return t.UserID == userID && t.IsAdmitted(), nil
+}
+
+// ActiveEventIDs は入場待ちまたは入場許可中のユーザーがいるイベントID一覧を返す
+func (w *WaitingRoom) ActiveEventIDs(ctx context.Context) ([]string, error) {
+	ids, err := w.client.SMembers(ctx, w.eventsKey()).Result()
+	if err != nil {
+		return nil, fmt.Errorf("待合室のイベント一覧取得に失敗: %w", err)
+	}
+	return ids, nil
+}
+
+func (w *WaitingRoom) waitingKey(eventID string) string {
+	return fmt.Sprintf("queue:%s:waiting", eventID)
+}
+
+func (w *WaitingRoom) admittedKey(eventID string) string {
+	return fmt.Sprintf("queue:%s:admitted", eventID)
+}
+
+func (w *WaitingRoom) seqKey(eventID string) string {
+	return fmt.Sprintf("queue:%s:seq", eventID)
+}
+
+func (w *WaitingRoom) userKey(eventID, userID string) string {
+	return fmt.Sprintf("queue:%s:user:%s", eventID, userID)
+}
+
+func (w *WaitingRoom) ticketKey(token string) string {
+	return fmt.Sprintf("queue:ticket:%s", token)
+}
+
+func (w *WaitingRoom) eventsKey() string {
+	return "queue:events"
+}
@@ -0,0 +1,97 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/domain/queue"
+)
+
+func TestWaitingRoom(t *testing.T) {
+	client := setupTestRedis(t)
+	room := NewWaitingRoom(client)
+	ctx := context.Background()
+	eventID := "test-event-" + uuid.New().String()
+
+	var first, second *queue.Ticket
+	t.Cleanup(func() {
+		keys := []string{room.waitingKey(eventID), room.admittedKey(eventID), room.seqKey(eventID),
+			room.userKey(eventID, "user-1"), room.userKey(eventID, "user-2")}
+		for _, tk := range []*queue.Ticket{first, second} {
+			if tk != nil {
+				keys = append(keys, room.ticketKey(tk.Token))
+			}
+		}
+		client.Del(ctx, keys...)
+		client.SRem(ctx, room.eventsKey(), eventID)
+	})
+
+	t.Run("登録順に順位が付く", func(t *testing.T) {
+		var err error
+		first, err = room.Enqueue(ctx, eventID, "user-1")
+		require.NoError(t, err)
+		second, err = room.Enqueue(ctx, eventID, "user-2")
+		require.NoError(t, err)
+
+		assert.Equal(t, queue.StatusWaiting, first.Status)
+		assert.Equal(t, 1, first.Position)
+		assert.Equal(t, 2, second.Position)
+	})
+
+	t.Run("同じユーザーが並び直しても同じチケットが返る", func(t *testing.T) {
+		again, err := room.Enqueue(ctx, eventID, "user-1")
+		require.NoError(t, err)
+		assert.Equal(t, first.Token, again.Token)
+		assert.Equal(t, 1, again.Position)
+	})
+
+	t.Run("先頭から入場許可される", func(t *testing.T) {
+		admitted, err := room.Admit(ctx, eventID, 1, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, 1, admitted)
+
+		ticket, err := room.GetTicket(ctx, eventID, first.Token)
+		require.NoError(t, err)
+		assert.Equal(t, queue.StatusAdmitted, ticket.Status)
+		assert.True(t, ticket.IsAdmitted())
+
+		ticket, err = room.GetTicket(ctx, eventID, second.Token)
+		require.NoError(t, err)
+		assert.Equal(t, queue.StatusWaiting, ticket.Status)
+		assert.Equal(t, 1, ticket.Position)
+	})
+
+	t.Run("入場許可は本人のチケットのみ有効", func(t *testing.T) {
+		ok, err := room.IsAdmitted(ctx, eventID, first.Token, "user-1")
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = room.IsAdmitted(ctx, eventID, first.Token, "user-2")
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		ok, err = room.IsAdmitted(ctx, eventID, second.Token, "user-2")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("別イベントのチケットは見つからない", func(t *testing.T) {
+		_, err := room.GetTicket(ctx, "other-event", first.Token)
+		assert.ErrorIs(t, err, queue.ErrTicketNotFound)
+	})
+
+	t.Run("入場許可の期限切れ", func(t *testing.T) {
+		_, err := room.Admit(ctx, eventID, 1, 50*time.Millisecond)
+		require.NoError(t, err)
+		time.Sleep(100 * time.Millisecond)
+
+		ticket, err := room.GetTicket(ctx, eventID, second.Token)
+		require.NoError(t, err)
+		assert.Equal(t, queue.StatusExpired, ticket.Status)
+	})
+}
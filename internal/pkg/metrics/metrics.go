@@ -12,7 +12,10 @@ type Metrics struct {
 	// HTTPリクエストのレイテンシ（method, path）
 	HTTPRequestDuration *prometheus.HistogramVec
 
-	// 予約の総数（status: success, conflict, lock_failed, error）
+	// レート制限で拒否したリクエストの総数（rule: ルール名, key_type: user/ip）
+	RateLimitedRequestsTotal *prometheus.CounterVec
+
+	// 予約の総数（status: success, conflict, lock_failed, limit_exceeded, error）
 	ReservationsTotal *prometheus.CounterVec
 
 	// 分散ロックの操作時間（operation: acquire/release, status: success/failed）
@@ -45,6 +48,13 @@ func NewWithRegistry(reg prometheus.Registerer) *Metrics {
 			},
 			[]string{"method", "path"},
 		),
+		RateLimitedRequestsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "http_rate_limited_requests_total",
+				Help: "Total number of requests rejected by rate limiting",
+			},
+			[]string{"rule", "key_type"},
+		),
 		ReservationsTotal: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
 				Name: "reservations_total",
@@ -73,6 +83,7 @@ func NewWithRegistry(reg prometheus.Registerer) *Metrics {
 	reg.MustRegister(
 		m.HTTPRequestsTotal,
 		m.HTTPRequestDuration,
+		m.RateLimitedRequestsTotal,
 		m.ReservationsTotal,
 		m.DistributedLockDuration,
 		m.ActiveReservations,
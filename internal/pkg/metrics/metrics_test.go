@@ -16,6 +16,7 @@ func TestNewMetrics(t *testing.T) {
 	require.NotNil(t, m)
 	assert.NotNil(t, m.HTTPRequestsTotal)
 	assert.NotNil(t, m.HTTPRequestDuration)
+	assert.NotNil(t, m.RateLimitedRequestsTotal)
 	assert.NotNil(t, m.ReservationsTotal)
 	assert.NotNil(t, m.DistributedLockDuration)
 	assert.NotNil(t, m.ActiveReservations)
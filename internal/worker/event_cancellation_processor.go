@@ -0,0 +1,80 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/logger"
+)
+
+// CancellationProcessor は完了していないイベントの中止処理を進めるインターフェース
+type CancellationProcessor interface {
+	ProcessPending(ctx context.Context) (int, error)
+}
+
+// EventCancellationProcessor は一定間隔でイベントの中止処理（予約の取り消し・座席の解放）を進めるワーカー
+// 進捗はDBに記録されるため、プロセスが途中で停止しても次の起動時に未処理の予約から再開する
+type EventCancellationProcessor struct {
+	processor CancellationProcessor
+	interval  time.Duration
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+}
+
+// NewEventCancellationProcessor は新しいワーカーを作成
+func NewEventCancellationProcessor(p CancellationProcessor, interval time.Duration) *EventCancellationProcessor {
+	return &EventCancellationProcessor{
+		processor: p,
+		interval:  interval,
+		stopCh:    make(chan struct{}),
+		doneCh:    make(chan struct{}),
+	}
+}
+
+// Start はワーカーを開始
+func (w *EventCancellationProcessor) Start(ctx context.Context) {
+	logger.Info("イベント中止ワーカー開始", zap.Duration("interval", w.interval))
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	defer close(w.doneCh)
+
+	// 起動時に前回停止した中止処理を再開する
+	w.process(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("イベント中止ワーカー停止（コンテキストキャンセル）")
+			return
+		case <-w.stopCh:
+			logger.Info("イベント中止ワーカー停止（シグナル受信）")
+			return
+		case <-ticker.C:
+			w.process(ctx)
+		}
+	}
+}
+
+// Stop はワーカーを停止
+func (w *EventCancellationProcessor) Stop() {
+	close(w.stopCh)
+	<-w.doneCh
+}
+
+// process は完了していない中止処理を進める
+func (w *EventCancellationProcessor) process(ctx context.Context) {
+	log := logger.Get()
+
+	count, err := w.processor.ProcessPending(ctx)
+	if err != nil {
+		log.Error("イベントの中止処理に失敗", zap.Int("processed_reservations", count), zap.Error(err))
+		return
+	}
+
+	if count > 0 {
+		log.Info("イベントの中止に伴い予約を取り消し", zap.Int("count", count))
+	}
+}
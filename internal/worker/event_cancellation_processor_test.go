@@ -0,0 +1,77 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCancellationProcessor はCancellationProcessorのモック
+type MockCancellationProcessor struct {
+	mock.Mock
+}
+
+func (m *MockCancellationProcessor) ProcessPending(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func TestNewEventCancellationProcessor(t *testing.T) {
+	mockProcessor := new(MockCancellationProcessor)
+
+	w := NewEventCancellationProcessor(mockProcessor, time.Second)
+
+	assert.NotNil(t, w)
+	assert.Equal(t, time.Second, w.interval)
+	assert.NotNil(t, w.stopCh)
+	assert.NotNil(t, w.doneCh)
+}
+
+func TestEventCancellationProcessor_Process(t *testing.T) {
+	t.Run("正常に中止処理が実行される", func(t *testing.T) {
+		mockProcessor := new(MockCancellationProcessor)
+		mockProcessor.On("ProcessPending", mock.Anything).Return(5, nil)
+
+		w := NewEventCancellationProcessor(mockProcessor, time.Minute)
+		w.process(context.Background())
+
+		mockProcessor.AssertExpectations(t)
+	})
+
+	t.Run("エラーが発生しても継続する", func(t *testing.T) {
+		mockProcessor := new(MockCancellationProcessor)
+		mockProcessor.On("ProcessPending", mock.Anything).Return(2, assert.AnError)
+
+		w := NewEventCancellationProcessor(mockProcessor, time.Minute)
+		// パニックしないことを確認
+		w.process(context.Background())
+
+		mockProcessor.AssertExpectations(t)
+	})
+}
+
+func TestEventCancellationProcessor_StartStop(t *testing.T) {
+	mockProcessor := new(MockCancellationProcessor)
+	mockProcessor.On("ProcessPending", mock.Anything).Return(0, nil)
+
+	// 間隔より前に停止しても起動時の再開処理は実行される
+	w := NewEventCancellationProcessor(mockProcessor, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go w.Start(ctx)
+	time.Sleep(50 * time.Millisecond)
+	w.Stop()
+
+	select {
+	case <-w.doneCh:
+		// 正常に終了
+	case <-time.After(1 * time.Second):
+		t.Error("processor did not stop in time")
+	}
+	mockProcessor.AssertNumberOfCalls(t, "ProcessPending", 1)
+}
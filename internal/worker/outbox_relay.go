@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/logger"
+)
+
+// OutboxPublisher はアウトボックスの未配信メッセージを配信するインターフェース
+type OutboxPublisher interface {
+	RelayPending(ctx context.Context) (int, error)
+}
+
+// OutboxRelay は一定間隔でアウトボックスのドメインイベントを配信するワーカー
+type OutboxRelay struct {
+	publisher OutboxPublisher
+	interval  time.Duration
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+}
+
+// NewOutboxRelay は新しいリレーを作成
+func NewOutboxRelay(p OutboxPublisher, interval time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		publisher: p,
+		interval:  interval,
+		stopCh:    make(chan struct{}),
+		doneCh:    make(chan struct{}),
+	}
+}
+
+// Start はリレーを開始
+func (r *OutboxRelay) Start(ctx context.Context) {
+	logger.Info("アウトボックスリレー開始", zap.Duration("interval", r.interval))
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	defer close(r.doneCh)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("アウトボックスリレー停止（コンテキストキャンセル）")
+			return
+		case <-r.stopCh:
+			logger.Info("アウトボックスリレー停止（シグナル受信）")
+			return
+		case <-ticker.C:
+			r.relay(ctx)
+		}
+	}
+}
+
+// Stop はリレーを停止
+func (r *OutboxRelay) Stop() {
+	close(r.stopCh)
+	<-r.doneCh
+}
+
+// relay は未配信のドメインイベントを配信
+func (r *OutboxRelay) relay(ctx context.Context) {
+	log := logger.Get()
+
+	count, err := r.publisher.RelayPending(ctx)
+	if err != nil {
+		log.Error("ドメインイベントの配信失敗", zap.Error(err))
+		return
+	}
+
+	if count > 0 {
+		log.Debug("ドメインイベントを配信", zap.Int("count", count))
+	}
+}
@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockOutboxPublisher はOutboxPublisherのモック
+type MockOutboxPublisher struct {
+	mock.Mock
+}
+
+func (m *MockOutboxPublisher) RelayPending(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func TestNewOutboxRelay(t *testing.T) {
+	mockPublisher := new(MockOutboxPublisher)
+
+	relay := NewOutboxRelay(mockPublisher, time.Second)
+
+	assert.NotNil(t, relay)
+	assert.Equal(t, time.Second, relay.interval)
+	assert.NotNil(t, relay.stopCh)
+	assert.NotNil(t, relay.doneCh)
+}
+
+func TestOutboxRelay_Relay(t *testing.T) {
+	t.Run("正常に配信が実行される", func(t *testing.T) {
+		mockPublisher := new(MockOutboxPublisher)
+		mockPublisher.On("RelayPending", mock.Anything).Return(3, nil)
+
+		relay := NewOutboxRelay(mockPublisher, time.Minute)
+		relay.relay(context.Background())
+
+		mockPublisher.AssertExpectations(t)
+	})
+
+	t.Run("エラーが発生しても継続する", func(t *testing.T) {
+		mockPublisher := new(MockOutboxPublisher)
+		mockPublisher.On("RelayPending", mock.Anything).Return(0, assert.AnError)
+
+		relay := NewOutboxRelay(mockPublisher, time.Minute)
+		// パニックしないことを確認
+		relay.relay(context.Background())
+
+		mockPublisher.AssertExpectations(t)
+	})
+}
+
+func TestOutboxRelay_StartStop(t *testing.T) {
+	mockPublisher := new(MockOutboxPublisher)
+	mockPublisher.On("RelayPending", mock.Anything).Return(0, nil)
+
+	relay := NewOutboxRelay(mockPublisher, 20*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go relay.Start(ctx)
+	time.Sleep(70 * time.Millisecond)
+	relay.Stop()
+
+	select {
+	case <-relay.doneCh:
+		// 正常に終了
+	case <-time.After(1 * time.Second):
+		t.Error("relay did not stop in time")
+	}
+	mockPublisher.AssertCalled(t, "RelayPending", mock.Anything)
+}
@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/sanosuguru/go-event-ticket-reservation/internal/pkg/logger"
+)
+
+// QueueAdmitter は待合室から入場を許可するインターフェース
+type QueueAdmitter interface {
+	AdmitWaiting(ctx context.Context) (int, error)
+}
+
+// WaitingRoomAdmitter は一定間隔で待合室の先頭から入場を許可するワーカー
+type WaitingRoomAdmitter struct {
+	queueService QueueAdmitter
+	interval     time.Duration
+	stopCh       chan struct{}
+	doneCh       chan struct{}
+}
+
+// NewWaitingRoomAdmitter は新しいアドミッターを作成
+func NewWaitingRoomAdmitter(qs QueueAdmitter, interval time.Duration) *WaitingRoomAdmitter {
+	return &WaitingRoomAdmitter{
+		queueService: qs,
+		interval:     interval,
+		stopCh:       make(chan struct{}),
+		doneCh:       make(chan struct{}),
+	}
+}
+
+// Start はアドミッターを開始
+func (a *WaitingRoomAdmitter) Start(ctx context.Context) {
+	logger.Info("待合室アドミッター開始", zap.Duration("interval", a.interval))
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	defer close(a.doneCh)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("待合室アドミッター停止（コンテキストキャンセル）")
+			return
+		case <-a.stopCh:
+			logger.Info("待合室アドミッター停止（シグナル受信）")
+			return
+		case <-ticker.C:
+			a.admit(ctx)
+		}
+	}
+}
+
+// Stop はアドミッターを停止
+func (a *WaitingRoomAdmitter) Stop() {
+	close(a.stopCh)
+	<-a.doneCh
+}
+
+// admit は待合室から入場を許可
+func (a *WaitingRoomAdmitter) admit(ctx context.Context) {
+	log := logger.Get()
+
+	count, err := a.queueService.AdmitWaiting(ctx)
+	if err != nil {
+		log.Error("待合室の入場許可失敗", zap.Error(err))
+		return
+	}
+
+	if count > 0 {
+		log.Info("待合室から入場許可", zap.Int("count", count))
+	}
+}
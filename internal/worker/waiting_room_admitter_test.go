@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockQueueAdmitter はQueueAdmitterのモック
+type MockQueueAdmitter struct {
+	mock.Mock
+}
+
+func (m *MockQueueAdmitter) AdmitWaiting(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func TestNewWaitingRoomAdmitter(t *testing.T) {
+	mockService := new(MockQueueAdmitter)
+
+	admitter := NewWaitingRoomAdmitter(mockService, 5*time.Second)
+
+	assert.NotNil(t, admitter)
+	assert.Equal(t, 5*time.Second, admitter.interval)
+	assert.NotNil(t, admitter.stopCh)
+	assert.NotNil(t, admitter.doneCh)
+}
+
+func TestWaitingRoomAdmitter_Admit(t *testing.T) {
+	t.Run("正常に入場許可が実行される", func(t *testing.T) {
+		mockService := new(MockQueueAdmitter)
+		mockService.On("AdmitWaiting", mock.Anything).Return(100, nil)
+
+		admitter := NewWaitingRoomAdmitter(mockService, time.Minute)
+		admitter.admit(context.Background())
+
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("エラーが発生しても継続する", func(t *testing.T) {
+		mockService := new(MockQueueAdmitter)
+		mockService.On("AdmitWaiting", mock.Anything).Return(0, assert.AnError)
+
+		admitter := NewWaitingRoomAdmitter(mockService, time.Minute)
+		// パニックしないことを確認
+		admitter.admit(context.Background())
+
+		mockService.AssertExpectations(t)
+	})
+}
+
+func TestWaitingRoomAdmitter_StartStop(t *testing.T) {
+	mockService := new(MockQueueAdmitter)
+	mockService.On("AdmitWaiting", mock.Anything).Return(0, nil)
+
+	admitter := NewWaitingRoomAdmitter(mockService, 20*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go admitter.Start(ctx)
+	time.Sleep(70 * time.Millisecond)
+	admitter.Stop()
+
+	select {
+	case <-admitter.doneCh:
+		// 正常に終了
+	case <-time.After(1 * time.Second):
+		t.Error("admitter did not stop in time")
+	}
+	mockService.AssertCalled(t, "AdmitWaiting", mock.Anything)
+}
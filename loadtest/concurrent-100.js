@@ -9,6 +9,8 @@ const reservationError = new Counter('reservation_error');
 const reservationDuration = new Trend('reservation_duration_ms');
 
 const BASE_URL = __ENV.BASE_URL || 'http://localhost:8080';
+// イベント・座席の作成は主催者のみ（サーバーは AUTH_ALLOW_USER_ID_HEADER=true で起動する）
+const ORGANIZER_HEADERS = { 'X-User-ID': 'loadtest-organizer', 'X-User-Roles': 'organizer' };
 const CONCURRENT_USERS = parseInt(__ENV.CONCURRENT_USERS) || 100;
 
 // 100人が同時に同じ座席を予約
@@ -39,7 +41,7 @@ export function setup() {
     end_at: new Date(Date.now() + 25 * 60 * 60 * 1000).toISOString(),
     total_seats: 1,
   }), {
-    headers: { 'Content-Type': 'application/json' },
+    headers: { 'Content-Type': 'application/json', ...ORGANIZER_HEADERS },
   });
 
   const event = JSON.parse(eventRes.body);
@@ -51,7 +53,7 @@ export function setup() {
     section: 'A',
     price: 50000,
   }), {
-    headers: { 'Content-Type': 'application/json' },
+    headers: { 'Content-Type': 'application/json', ...ORGANIZER_HEADERS },
   });
 
   const seat = JSON.parse(seatRes.body);
@@ -94,6 +96,6 @@ export default function(data) {
 }
 
 export function teardown(data) {
-  http.del(`${BASE_URL}/api/v1/events/${data.eventId}`);
+  http.del(`${BASE_URL}/api/v1/events/${data.eventId}`, null, { headers: ORGANIZER_HEADERS });
   console.log('クリーンアップ完了');
 }
@@ -11,6 +11,8 @@ const reservationDuration = new Trend('reservation_duration_ms');
 
 // 設定
 const BASE_URL = __ENV.BASE_URL || 'http://localhost:8081';
+// イベント・座席の作成は主催者のみ（サーバーは AUTH_ALLOW_USER_ID_HEADER=true で起動する）
+const ORGANIZER_HEADERS = { 'X-User-ID': 'loadtest-organizer', 'X-User-Roles': 'organizer' };
 
 // テストシナリオ
 export const options = {
@@ -55,7 +57,7 @@ export function setup() {
     end_at: new Date(Date.now() + 25 * 60 * 60 * 1000).toISOString(),
     total_seats: 100,
   }), {
-    headers: { 'Content-Type': 'application/json' },
+    headers: { 'Content-Type': 'application/json', ...ORGANIZER_HEADERS },
   });
 
   check(eventRes, {
@@ -73,7 +75,7 @@ export function setup() {
       section: 'A',
       price: 5000,
     }), {
-      headers: { 'Content-Type': 'application/json' },
+      headers: { 'Content-Type': 'application/json', ...ORGANIZER_HEADERS },
     });
     if (seatRes.status === 201) {
       seats.push(JSON.parse(seatRes.body));
@@ -159,6 +161,6 @@ export function concurrentReservation(data) {
 // テスト後のクリーンアップ
 export function teardown(data) {
   // イベントを削除
-  http.del(`${BASE_URL}/api/v1/events/${data.event.id}`);
+  http.del(`${BASE_URL}/api/v1/events/${data.event.id}`, null, { headers: ORGANIZER_HEADERS });
   console.log('クリーンアップ完了');
 }
@@ -10,6 +10,8 @@ const reservationDuration = new Trend('reservation_duration_ms');
 
 // 設定
 const BASE_URL = __ENV.BASE_URL || 'http://localhost:8080';
+// イベント・座席の作成は主催者のみ（サーバーは AUTH_ALLOW_USER_ID_HEADER=true で起動する）
+const ORGANIZER_HEADERS = { 'X-User-ID': 'loadtest-organizer', 'X-User-Roles': 'organizer' };
 
 // 簡略化されたストレステスト
 export const options = {
@@ -44,7 +46,7 @@ export function setup() {
     end_at: new Date(Date.now() + 25 * 60 * 60 * 1000).toISOString(),
     total_seats: 500,
   }), {
-    headers: { 'Content-Type': 'application/json' },
+    headers: { 'Content-Type': 'application/json', ...ORGANIZER_HEADERS },
   });
 
   if (eventRes.status !== 201) {
@@ -73,7 +75,7 @@ export function setup() {
     const bulkRes = http.post(
       `${BASE_URL}/api/v1/events/${event.id}/seats/bulk`,
       JSON.stringify({ seats: batch }),
-      { headers: { 'Content-Type': 'application/json' } }
+      { headers: { 'Content-Type': 'application/json', ...ORGANIZER_HEADERS } }
     );
     if (bulkRes.status === 201) {
       try {
@@ -164,7 +166,7 @@ function createReservation(data, userId) {
 // クリーンアップ
 export function teardown(data) {
   if (data && data.eventId) {
-    http.del(`${BASE_URL}/api/v1/events/${data.eventId}`);
+    http.del(`${BASE_URL}/api/v1/events/${data.eventId}`, null, { headers: ORGANIZER_HEADERS });
     console.log('クリーンアップ完了');
   }
 }
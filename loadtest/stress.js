@@ -12,6 +12,8 @@ const apiLatency = new Trend('api_latency_ms');
 
 // 設定
 const BASE_URL = __ENV.BASE_URL || 'http://localhost:8081';
+// イベント・座席の作成は主催者のみ（サーバーは AUTH_ALLOW_USER_ID_HEADER=true で起動する）
+const ORGANIZER_HEADERS = { 'X-User-ID': 'loadtest-organizer', 'X-User-Roles': 'organizer' };
 const MAX_VUS = parseInt(__ENV.MAX_VUS) || 200;
 
 // ストレステストシナリオ
@@ -65,7 +67,7 @@ export function setup() {
     end_at: new Date(Date.now() + 25 * 60 * 60 * 1000).toISOString(),
     total_seats: 1000,  // 大規模イベント
   }), {
-    headers: { 'Content-Type': 'application/json' },
+    headers: { 'Content-Type': 'application/json', ...ORGANIZER_HEADERS },
   });
 
   check(eventRes, {
@@ -92,7 +94,7 @@ export function setup() {
     const bulkRes = http.post(
       `${BASE_URL}/api/v1/events/${event.id}/seats/bulk`,
       JSON.stringify({ seats: batch }),
-      { headers: { 'Content-Type': 'application/json' } }
+      { headers: { 'Content-Type': 'application/json', ...ORGANIZER_HEADERS } }
     );
     if (bulkRes.status === 201) {
       const created = JSON.parse(bulkRes.body);
@@ -221,6 +223,6 @@ export function concurrentReservation(data) {
 
 // テスト後のクリーンアップ
 export function teardown(data) {
-  http.del(`${BASE_URL}/api/v1/events/${data.event.id}`);
+  http.del(`${BASE_URL}/api/v1/events/${data.event.id}`, null, { headers: ORGANIZER_HEADERS });
   console.log('クリーンアップ完了');
 }